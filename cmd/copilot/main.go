@@ -81,7 +81,10 @@ func buildRootCmd() *cobra.Command {
 	cmd.AddCommand(cli.BuildSvcCmd())
 	cmd.AddCommand(cli.BuildJobCmd())
 	cmd.AddCommand(cli.BuildTaskCmd())
-	cmd.AddCommand(cli.BuildRunLocalCmd())
+	cmd.AddCommand(cli.BuildRunCmd())
+	cmd.AddCommand(cli.BuildManifestCmd())
+	cmd.AddCommand(cli.BuildValidateCmd())
+	cmd.AddCommand(cli.BuildLSPCmd())
 
 	// "Extend" command group
 	cmd.AddCommand(cli.BuildStorageCmd())
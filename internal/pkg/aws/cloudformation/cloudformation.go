@@ -373,6 +373,29 @@ func (c *CloudFormation) ListStacksWithTags(tags map[string]string) ([]StackDesc
 	return summaries, nil
 }
 
+// ExportValue returns the value of the CloudFormation export with the given name in the current AWS account and region.
+func (c *CloudFormation) ExportValue(name string) (string, error) {
+	var nextToken *string
+	for {
+		out, err := c.client.ListExports(&cloudformation.ListExportsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return "", fmt.Errorf("list exports: %w", err)
+		}
+		for _, export := range out.Exports {
+			if aws.StringValue(export.Name) == name {
+				return aws.StringValue(export.Value), nil
+			}
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return "", fmt.Errorf("export %q does not exist", name)
+}
+
 // CancelUpdateStack attempts to cancel the update for a CloudFormation stack specified by the stackName.
 // Returns an error if failed to cancel CloudFormation stack update.
 func (c *CloudFormation) CancelUpdateStack(stackName string) error {
@@ -1496,6 +1496,71 @@ func TestCloudFormation_ListStacksWithTags(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_ExportValue(t *testing.T) {
+	testCases := map[string]struct {
+		mockCf func(*mocks.Mockclient)
+
+		wantedValue string
+		wantedErr   string
+	}{
+		"returns the value of the export in a later page": {
+			mockCf: func(m *mocks.Mockclient) {
+				m.EXPECT().ListExports(&cloudformation.ListExportsInput{}).Return(&cloudformation.ListExportsOutput{
+					NextToken: aws.String("abc"),
+					Exports: []*cloudformation.Export{
+						{Name: aws.String("phonetool-test-VpcId"), Value: aws.String("vpc-1234")},
+					},
+				}, nil)
+				m.EXPECT().ListExports(&cloudformation.ListExportsInput{
+					NextToken: aws.String("abc"),
+				}).Return(&cloudformation.ListExportsOutput{
+					Exports: []*cloudformation.Export{
+						{Name: aws.String("shared-TableName"), Value: aws.String("orders")},
+					},
+				}, nil)
+			},
+			wantedValue: "orders",
+		},
+		"error if the export does not exist": {
+			mockCf: func(m *mocks.Mockclient) {
+				m.EXPECT().ListExports(&cloudformation.ListExportsInput{}).Return(&cloudformation.ListExportsOutput{}, nil)
+			},
+			wantedErr: `export "shared-TableName" does not exist`,
+		},
+		"error listing exports": {
+			mockCf: func(m *mocks.Mockclient) {
+				m.EXPECT().ListExports(&cloudformation.ListExportsInput{}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list exports: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockclient(ctrl)
+			tc.mockCf(mockClient)
+
+			c := CloudFormation{
+				client: mockClient,
+			}
+
+			// WHEN
+			value, err := c.ExportValue("shared-TableName")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedValue, value)
+			}
+		})
+	}
+}
+
 func TestCloudformation_CancelUpdateStack(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
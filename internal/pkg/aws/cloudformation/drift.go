@@ -0,0 +1,77 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// driftDetectionPollInterval is how long to wait in between polls of the status of a drift detection.
+var driftDetectionPollInterval = 5 * time.Second
+
+// DetectDrift runs the drift detection of a stack, and returns the resources that were modified or deleted outside of CloudFormation.
+// Resources that don't support drift detection are not included.
+func (c *CloudFormation) DetectDrift(ctx context.Context, stackName string) ([]*StackResourceDrift, error) {
+	out, err := c.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("detect drift of stack %s: %w", stackName, err)
+	}
+	if err := c.waitForDriftDetection(ctx, stackName, aws.StringValue(out.StackDriftDetectionId)); err != nil {
+		return nil, err
+	}
+	var drifts []*StackResourceDrift
+	var nextToken *string
+	for {
+		out, err := c.DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+			StackName: aws.String(stackName),
+			StackResourceDriftStatusFilters: aws.StringSlice([]string{
+				cloudformation.StackResourceDriftStatusModified,
+				cloudformation.StackResourceDriftStatusDeleted,
+			}),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe resource drifts of stack %s: %w", stackName, err)
+		}
+		for _, d := range out.StackResourceDrifts {
+			if d == nil {
+				continue
+			}
+			drift := StackResourceDrift(*d)
+			drifts = append(drifts, &drift)
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			return drifts, nil
+		}
+	}
+}
+
+// waitForDriftDetection waits until the drift detection is complete.
+// A detection that failed for some of the resources is considered complete, as the results of the other resources are available.
+func (c *CloudFormation) waitForDriftDetection(ctx context.Context, stackName, detectionID string) error {
+	for {
+		out, err := c.DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(detectionID),
+		})
+		if err != nil {
+			return fmt.Errorf("describe drift detection status of stack %s: %w", stackName, err)
+		}
+		if aws.StringValue(out.DetectionStatus) != cloudformation.StackDriftDetectionStatusDetectionInProgress {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for drift detection of stack %s: %w", stackName, ctx.Err())
+		case <-time.After(driftDetectionPollInterval):
+		}
+	}
+}
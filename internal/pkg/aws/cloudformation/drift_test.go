@@ -0,0 +1,122 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFormation_DetectDrift(t *testing.T) {
+	driftDetectionPollInterval = 0
+	modified := &cloudformation.StackResourceDrift{
+		LogicalResourceId:        aws.String("Service"),
+		StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusModified),
+	}
+	deleted := &cloudformation.StackResourceDrift{
+		LogicalResourceId:        aws.String("Queue"),
+		StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusDeleted),
+	}
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+
+		wanted      []*StackResourceDrift
+		wantedError error
+	}{
+		"error if the detection cannot be started": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("detect drift of stack phonetool-test-api: some error"),
+		},
+		"error if the detection status cannot be described": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("describe drift detection status of stack phonetool-test-api: some error"),
+		},
+		"wait for the detection and return the drifted resources of all pages": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(&cloudformation.DetectStackDriftInput{
+					StackName: aws.String("phonetool-test-api"),
+				}).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+						StackDriftDetectionId: aws.String("1234"),
+					}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionInProgress),
+					}, nil),
+					m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionFailed),
+					}, nil),
+				)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						StackName: aws.String("phonetool-test-api"),
+						StackResourceDriftStatusFilters: aws.StringSlice([]string{
+							cloudformation.StackResourceDriftStatusModified,
+							cloudformation.StackResourceDriftStatusDeleted,
+						}),
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{modified},
+						NextToken:           aws.String("next"),
+					}, nil),
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						StackName: aws.String("phonetool-test-api"),
+						StackResourceDriftStatusFilters: aws.StringSlice([]string{
+							cloudformation.StackResourceDriftStatusModified,
+							cloudformation.StackResourceDriftStatusDeleted,
+						}),
+						NextToken: aws.String("next"),
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{deleted},
+					}, nil),
+				)
+				return m
+			},
+			wanted: []*StackResourceDrift{
+				(*StackResourceDrift)(modified),
+				(*StackResourceDrift)(deleted),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			got, err := c.DetectDrift(context.Background(), "phonetool-test-api")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -213,6 +213,21 @@ func (mr *MockclientMockRecorder) DescribeChangeSet(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeChangeSet", reflect.TypeOf((*Mockclient)(nil).DescribeChangeSet), arg0)
 }
 
+// DescribeStackDriftDetectionStatus mocks base method.
+func (m *Mockclient) DescribeStackDriftDetectionStatus(in *cloudformation.DescribeStackDriftDetectionStatusInput) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeStackDriftDetectionStatus", in)
+	ret0, _ := ret[0].(*cloudformation.DescribeStackDriftDetectionStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStackDriftDetectionStatus indicates an expected call of DescribeStackDriftDetectionStatus.
+func (mr *MockclientMockRecorder) DescribeStackDriftDetectionStatus(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStackDriftDetectionStatus", reflect.TypeOf((*Mockclient)(nil).DescribeStackDriftDetectionStatus), in)
+}
+
 // DescribeStackEvents mocks base method.
 func (m *Mockclient) DescribeStackEvents(arg0 *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error) {
 	m.ctrl.T.Helper()
@@ -228,6 +243,21 @@ func (mr *MockclientMockRecorder) DescribeStackEvents(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStackEvents", reflect.TypeOf((*Mockclient)(nil).DescribeStackEvents), arg0)
 }
 
+// DescribeStackResourceDrifts mocks base method.
+func (m *Mockclient) DescribeStackResourceDrifts(in *cloudformation.DescribeStackResourceDriftsInput) (*cloudformation.DescribeStackResourceDriftsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeStackResourceDrifts", in)
+	ret0, _ := ret[0].(*cloudformation.DescribeStackResourceDriftsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStackResourceDrifts indicates an expected call of DescribeStackResourceDrifts.
+func (mr *MockclientMockRecorder) DescribeStackResourceDrifts(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStackResourceDrifts", reflect.TypeOf((*Mockclient)(nil).DescribeStackResourceDrifts), in)
+}
+
 // DescribeStackResources mocks base method.
 func (m *Mockclient) DescribeStackResources(input *cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +288,21 @@ func (mr *MockclientMockRecorder) DescribeStacks(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStacks", reflect.TypeOf((*Mockclient)(nil).DescribeStacks), arg0)
 }
 
+// DetectStackDrift mocks base method.
+func (m *Mockclient) DetectStackDrift(in *cloudformation.DetectStackDriftInput) (*cloudformation.DetectStackDriftOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectStackDrift", in)
+	ret0, _ := ret[0].(*cloudformation.DetectStackDriftOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectStackDrift indicates an expected call of DetectStackDrift.
+func (mr *MockclientMockRecorder) DetectStackDrift(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectStackDrift", reflect.TypeOf((*Mockclient)(nil).DetectStackDrift), in)
+}
+
 // ExecuteChangeSet mocks base method.
 func (m *Mockclient) ExecuteChangeSet(arg0 *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +348,21 @@ func (mr *MockclientMockRecorder) GetTemplateSummary(in interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateSummary", reflect.TypeOf((*Mockclient)(nil).GetTemplateSummary), in)
 }
 
+// ListExports mocks base method.
+func (m *Mockclient) ListExports(in *cloudformation.ListExportsInput) (*cloudformation.ListExportsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExports", in)
+	ret0, _ := ret[0].(*cloudformation.ListExportsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExports indicates an expected call of ListExports.
+func (mr *MockclientMockRecorder) ListExports(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExports", reflect.TypeOf((*Mockclient)(nil).ListExports), in)
+}
+
 // WaitUntilChangeSetCreateCompleteWithContext mocks base method.
 func (m *Mockclient) WaitUntilChangeSetCreateCompleteWithContext(arg0 aws.Context, arg1 *cloudformation.DescribeChangeSetInput, arg2 ...request.WaiterOption) error {
 	m.ctrl.T.Helper()
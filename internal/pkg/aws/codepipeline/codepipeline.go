@@ -24,6 +24,7 @@ type api interface {
 	GetPipelineState(*cp.GetPipelineStateInput) (*cp.GetPipelineStateOutput, error)
 	ListPipelineExecutions(input *cp.ListPipelineExecutionsInput) (*cp.ListPipelineExecutionsOutput, error)
 	RetryStageExecution(input *cp.RetryStageExecutionInput) (*cp.RetryStageExecutionOutput, error)
+	StartPipelineExecution(input *cp.StartPipelineExecutionInput) (*cp.StartPipelineExecutionOutput, error)
 }
 
 type resourceGetter interface {
@@ -176,6 +177,32 @@ func (c *CodePipeline) RetryStageExecution(pipelineName, stageName string) error
 	return nil
 }
 
+// StartExecution starts a new execution of the pipeline and returns its execution ID.
+// If commitID is not empty, the source action of the pipeline releases that commit instead of the latest one on the branch.
+func (c *CodePipeline) StartExecution(pipelineName, commitID string) (string, error) {
+	input := &cp.StartPipelineExecutionInput{
+		Name: aws.String(pipelineName),
+	}
+	if commitID != "" {
+		actionName, err := c.sourceActionName(pipelineName)
+		if err != nil {
+			return "", err
+		}
+		input.SourceRevisions = []*cp.SourceRevisionOverride{
+			{
+				ActionName:    aws.String(actionName),
+				RevisionType:  aws.String(cp.SourceRevisionTypeCommitId),
+				RevisionValue: aws.String(commitID),
+			},
+		}
+	}
+	out, err := c.client.StartPipelineExecution(input)
+	if err != nil {
+		return "", fmt.Errorf("start execution of pipeline %s: %w", pipelineName, err)
+	}
+	return aws.StringValue(out.PipelineExecutionId), nil
+}
+
 // GetPipelineState retrieves status information from a given pipeline.
 func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 	input := &cp.GetPipelineStateInput{
@@ -292,6 +319,24 @@ func (c *CodePipeline) pipelineExecutionID(pipelineName string) (string, error)
 	return aws.StringValue(output.PipelineExecutionSummaries[0].PipelineExecutionId), nil
 }
 
+// sourceActionName returns the name of the action that checks out the source code of a pipeline.
+func (c *CodePipeline) sourceActionName(pipelineName string) (string, error) {
+	resp, err := c.client.GetPipeline(&cp.GetPipelineInput{
+		Name: aws.String(pipelineName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get pipeline %s: %w", pipelineName, err)
+	}
+	for _, stage := range resp.Pipeline.Stages {
+		for _, action := range stage.Actions {
+			if aws.StringValue(action.ActionTypeId.Category) == cp.ActionCategorySource {
+				return aws.StringValue(action.Name), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no source action found in pipeline %s", pipelineName)
+}
+
 func (sa StageAction) humanString() string {
 	return sa.Name + "\t\t" + fmtStatus(sa.Status)
 }
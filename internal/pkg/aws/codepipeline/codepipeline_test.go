@@ -579,3 +579,116 @@ func TestCodePipeline_RetryStageExecution(t *testing.T) {
 		})
 	}
 }
+
+func TestCodePipeline_StartExecution(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockErr := errors.New("some error")
+	mockPipelineOutput := &codepipeline.GetPipelineOutput{
+		Pipeline: &codepipeline.PipelineDeclaration{
+			Stages: []*codepipeline.StageDeclaration{
+				{
+					Name: aws.String("Source"),
+					Actions: []*codepipeline.ActionDeclaration{
+						{
+							Name: aws.String("SourceCodeFor-dinder"),
+							ActionTypeId: &codepipeline.ActionTypeId{
+								Category: aws.String("Source"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		inCommitID string
+		callMocks  func(m codepipelineMocks)
+
+		expectedOut   string
+		expectedError error
+	}{
+		"starts an execution on the latest commit": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(&codepipeline.StartPipelineExecutionInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(&codepipeline.StartPipelineExecutionOutput{
+					PipelineExecutionId: aws.String("12345678-fake-exec-utio-nid987654321"),
+				}, nil)
+			},
+			expectedOut: "12345678-fake-exec-utio-nid987654321",
+		},
+		"overrides the revision of the source action": {
+			inCommitID: "4c1d2e3",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(&codepipeline.GetPipelineInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(mockPipelineOutput, nil)
+				m.cp.EXPECT().StartPipelineExecution(&codepipeline.StartPipelineExecutionInput{
+					Name: aws.String(mockPipelineName),
+					SourceRevisions: []*codepipeline.SourceRevisionOverride{
+						{
+							ActionName:    aws.String("SourceCodeFor-dinder"),
+							RevisionType:  aws.String("COMMIT_ID"),
+							RevisionValue: aws.String("4c1d2e3"),
+						},
+					},
+				}).Return(&codepipeline.StartPipelineExecutionOutput{
+					PipelineExecutionId: aws.String("12345678-fake-exec-utio-nid987654321"),
+				}, nil)
+			},
+			expectedOut: "12345678-fake-exec-utio-nid987654321",
+		},
+		"returns wrapped error if GetPipeline fails": {
+			inCommitID: "4c1d2e3",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(nil, mockErr)
+			},
+			expectedError: fmt.Errorf("get pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns error if the pipeline has no source action": {
+			inCommitID: "4c1d2e3",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(&codepipeline.GetPipelineOutput{
+					Pipeline: &codepipeline.PipelineDeclaration{},
+				}, nil)
+			},
+			expectedError: fmt.Errorf("no source action found in pipeline pipeline-dinder-badgoose-repo"),
+		},
+		"returns wrapped error if StartPipelineExecution fails": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(gomock.Any()).Return(nil, mockErr)
+			},
+			expectedError: fmt.Errorf("start execution of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			mocks := codepipelineMocks{
+				cp: mockClient,
+			}
+			tc.callMocks(mocks)
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			out, err := cp.StartExecution(mockPipelineName, tc.inCommitID)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, out)
+			}
+		})
+	}
+}
@@ -95,6 +95,21 @@ func (mr *MockapiMockRecorder) RetryStageExecution(input interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryStageExecution", reflect.TypeOf((*Mockapi)(nil).RetryStageExecution), input)
 }
 
+// StartPipelineExecution mocks base method.
+func (m *Mockapi) StartPipelineExecution(input *codepipeline.StartPipelineExecutionInput) (*codepipeline.StartPipelineExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPipelineExecution", input)
+	ret0, _ := ret[0].(*codepipeline.StartPipelineExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPipelineExecution indicates an expected call of StartPipelineExecution.
+func (mr *MockapiMockRecorder) StartPipelineExecution(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPipelineExecution", reflect.TypeOf((*Mockapi)(nil).StartPipelineExecution), input)
+}
+
 // MockresourceGetter is a mock of resourceGetter interface.
 type MockresourceGetter struct {
 	ctrl     *gomock.Controller
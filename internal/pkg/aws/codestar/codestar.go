@@ -17,6 +17,7 @@ import (
 type api interface {
 	GetConnection(input *codestarconnections.GetConnectionInput) (*codestarconnections.GetConnectionOutput, error)
 	ListConnections(input *codestarconnections.ListConnectionsInput) (*codestarconnections.ListConnectionsOutput, error)
+	GetHost(input *codestarconnections.GetHostInput) (*codestarconnections.GetHostOutput, error)
 }
 
 // CodeStar represents a client to make requests to AWS CodeStarConnections.
@@ -77,3 +78,14 @@ func (c *CodeStar) GetConnectionARN(connectionName string) (connectionARN string
 	}
 	return "", fmt.Errorf("cannot find a connectionARN associated with %s", connectionName)
 }
+
+// HostProviderType returns the type of the provider installed on the host, such as "GitHubEnterpriseServer" or "GitLabSelfManaged".
+func (c *CodeStar) HostProviderType(hostARN string) (string, error) {
+	output, err := c.client.GetHost(&codestarconnections.GetHostInput{
+		HostArn: aws.String(hostARN),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get host details for %s: %w", hostARN, err)
+	}
+	return aws.StringValue(output.ProviderType), nil
+}
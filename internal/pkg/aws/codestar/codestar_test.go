@@ -214,3 +214,45 @@ func TestCodeStar_GetConnectionARN(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestCodeStar_HostProviderType(t *testing.T) {
+	t.Run("returns wrapped error if GetHost is unsuccessful", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		m := mocks.NewMockapi(ctrl)
+		m.EXPECT().GetHost(gomock.Any()).Return(nil, errors.New("some error"))
+
+		connection := &CodeStar{
+			client: m,
+		}
+
+		// WHEN
+		providerType, err := connection.HostProviderType("mockHostARN")
+
+		// THEN
+		require.EqualError(t, err, "get host details for mockHostARN: some error")
+		require.Equal(t, "", providerType)
+	})
+
+	t.Run("returns the provider type of the host", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		m := mocks.NewMockapi(ctrl)
+		m.EXPECT().GetHost(&codestarconnections.GetHostInput{
+			HostArn: aws.String("mockHostARN"),
+		}).Return(&codestarconnections.GetHostOutput{
+			ProviderType: aws.String(codestarconnections.ProviderTypeGitLabSelfManaged),
+		}, nil)
+
+		connection := &CodeStar{
+			client: m,
+		}
+
+		// WHEN
+		providerType, err := connection.HostProviderType("mockHostARN")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "GitLabSelfManaged", providerType)
+	})
+}
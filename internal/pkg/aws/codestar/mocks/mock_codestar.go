@@ -49,6 +49,21 @@ func (mr *MockapiMockRecorder) GetConnection(input interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnection", reflect.TypeOf((*Mockapi)(nil).GetConnection), input)
 }
 
+// GetHost mocks base method.
+func (m *Mockapi) GetHost(input *codestarconnections.GetHostInput) (*codestarconnections.GetHostOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHost", input)
+	ret0, _ := ret[0].(*codestarconnections.GetHostOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHost indicates an expected call of GetHost.
+func (mr *MockapiMockRecorder) GetHost(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHost", reflect.TypeOf((*Mockapi)(nil).GetHost), input)
+}
+
 // ListConnections mocks base method.
 func (m *Mockapi) ListConnections(input *codestarconnections.ListConnectionsInput) (*codestarconnections.ListConnectionsOutput, error) {
 	m.ctrl.T.Helper()
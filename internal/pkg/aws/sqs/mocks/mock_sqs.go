@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/sqs/sqs.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	request "github.com/aws/aws-sdk-go/aws/request"
+	sqs "github.com/aws/aws-sdk-go/service/sqs"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockapi is a mock of api interface.
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi.
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance.
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// CreateQueueWithContext mocks base method.
+func (m *Mockapi) CreateQueueWithContext(arg0 context.Context, arg1 *sqs.CreateQueueInput, arg2 ...request.Option) (*sqs.CreateQueueOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateQueueWithContext", varargs...)
+	ret0, _ := ret[0].(*sqs.CreateQueueOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQueueWithContext indicates an expected call of CreateQueueWithContext.
+func (mr *MockapiMockRecorder) CreateQueueWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQueueWithContext", reflect.TypeOf((*Mockapi)(nil).CreateQueueWithContext), varargs...)
+}
+
+// SendMessageWithContext mocks base method.
+func (m *Mockapi) SendMessageWithContext(arg0 context.Context, arg1 *sqs.SendMessageInput, arg2 ...request.Option) (*sqs.SendMessageOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendMessageWithContext", varargs...)
+	ret0, _ := ret[0].(*sqs.SendMessageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendMessageWithContext indicates an expected call of SendMessageWithContext.
+func (mr *MockapiMockRecorder) SendMessageWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessageWithContext", reflect.TypeOf((*Mockapi)(nil).SendMessageWithContext), varargs...)
+}
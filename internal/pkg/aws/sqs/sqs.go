@@ -0,0 +1,71 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sqs provides a client to make API requests to Amazon Simple Queue Service.
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+type api interface {
+	CreateQueueWithContext(context.Context, *sqs.CreateQueueInput, ...request.Option) (*sqs.CreateQueueOutput, error)
+	SendMessageWithContext(context.Context, *sqs.SendMessageInput, ...request.Option) (*sqs.SendMessageOutput, error)
+}
+
+// SQS wraps an Amazon Simple Queue Service client.
+type SQS struct {
+	client api
+}
+
+// New returns a SQS service configured against the input session.
+func New(s *session.Session) *SQS {
+	return &SQS{
+		client: sqs.New(s),
+	}
+}
+
+// CreateQueue creates a queue with the given name if it doesn't exist yet, and returns its URL.
+// A FIFO queue is created if the name ends with ".fifo".
+func (s *SQS) CreateQueue(ctx context.Context, name string) (string, error) {
+	in := &sqs.CreateQueueInput{
+		QueueName: aws.String(name),
+	}
+	if isFIFO(name) {
+		in.Attributes = map[string]*string{
+			sqs.QueueAttributeNameFifoQueue:                 aws.String("true"),
+			sqs.QueueAttributeNameContentBasedDeduplication: aws.String("true"),
+		}
+	}
+	out, err := s.client.CreateQueueWithContext(ctx, in)
+	if err != nil {
+		return "", fmt.Errorf("create queue %q: %w", name, err)
+	}
+	return aws.StringValue(out.QueueUrl), nil
+}
+
+// SendMessage sends a message with the given body to the queue at queueURL.
+func (s *SQS) SendMessage(ctx context.Context, queueURL, body string) error {
+	in := &sqs.SendMessageInput{
+		QueueUrl:    aws.String(queueURL),
+		MessageBody: aws.String(body),
+	}
+	if isFIFO(queueURL) {
+		in.MessageGroupId = aws.String("default")
+	}
+	if _, err := s.client.SendMessageWithContext(ctx, in); err != nil {
+		return fmt.Errorf("send message to queue %q: %w", queueURL, err)
+	}
+	return nil
+}
+
+func isFIFO(name string) bool {
+	return strings.HasSuffix(name, ".fifo")
+}
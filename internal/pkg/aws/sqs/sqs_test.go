@@ -0,0 +1,137 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sqs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQS_CreateQueue(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		mockClient func(m *mocks.Mockapi)
+
+		wantedURL   string
+		wantedError error
+	}{
+		"creates a standard queue": {
+			inName: "events",
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateQueueWithContext(gomock.Any(), &sqs.CreateQueueInput{
+					QueueName: aws.String("events"),
+				}).Return(&sqs.CreateQueueOutput{
+					QueueUrl: aws.String("http://localhost:9324/000000000000/events"),
+				}, nil)
+			},
+			wantedURL: "http://localhost:9324/000000000000/events",
+		},
+		"creates a FIFO queue": {
+			inName: "events.fifo",
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateQueueWithContext(gomock.Any(), &sqs.CreateQueueInput{
+					QueueName: aws.String("events.fifo"),
+					Attributes: map[string]*string{
+						"FifoQueue":                 aws.String("true"),
+						"ContentBasedDeduplication": aws.String("true"),
+					},
+				}).Return(&sqs.CreateQueueOutput{
+					QueueUrl: aws.String("http://localhost:9324/000000000000/events.fifo"),
+				}, nil)
+			},
+			wantedURL: "http://localhost:9324/000000000000/events.fifo",
+		},
+		"wraps the error": {
+			inName: "events",
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateQueueWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`create queue "events": some error`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			s := SQS{client: m}
+
+			// WHEN
+			url, err := s.CreateQueue(context.Background(), tc.inName)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedURL, url)
+		})
+	}
+}
+
+func TestSQS_SendMessage(t *testing.T) {
+	testCases := map[string]struct {
+		inQueueURL string
+		mockClient func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"sends a message to a standard queue": {
+			inQueueURL: "http://localhost:9324/000000000000/events",
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().SendMessageWithContext(gomock.Any(), &sqs.SendMessageInput{
+					QueueUrl:    aws.String("http://localhost:9324/000000000000/events"),
+					MessageBody: aws.String("hello"),
+				}).Return(&sqs.SendMessageOutput{}, nil)
+			},
+		},
+		"sets a message group for a FIFO queue": {
+			inQueueURL: "http://localhost:9324/000000000000/events.fifo",
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().SendMessageWithContext(gomock.Any(), &sqs.SendMessageInput{
+					QueueUrl:       aws.String("http://localhost:9324/000000000000/events.fifo"),
+					MessageBody:    aws.String("hello"),
+					MessageGroupId: aws.String("default"),
+				}).Return(&sqs.SendMessageOutput{}, nil)
+			},
+		},
+		"wraps the error": {
+			inQueueURL: "http://localhost:9324/000000000000/events",
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().SendMessageWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`send message to queue "http://localhost:9324/000000000000/events": some error`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			s := SQS{client: m}
+
+			// WHEN
+			err := s.SendMessage(context.Background(), tc.inQueueURL, "hello")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
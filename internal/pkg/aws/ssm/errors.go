@@ -13,3 +13,12 @@ type ErrParameterAlreadyExists struct {
 func (e *ErrParameterAlreadyExists) Error() string {
 	return fmt.Sprintf("parameter %s already exists", e.name)
 }
+
+// ErrSecureStringParameter occurs when the value of a SecureString parameter is requested as plaintext.
+type ErrSecureStringParameter struct {
+	Name string
+}
+
+func (e *ErrSecureStringParameter) Error() string {
+	return fmt.Sprintf("parameter %s is a SecureString", e.Name)
+}
@@ -77,6 +77,21 @@ func (s *SSM) GetSecretValue(ctx context.Context, name string) (string, error) {
 	return aws.StringValue(resp.Parameter.Value), nil
 }
 
+// GetParameterValue retrieves the value of a plaintext parameter from AWS Systems Manager Parameter Store.
+// The parameter is fetched without decryption, and ErrSecureStringParameter is returned if it's a SecureString.
+func (s *SSM) GetParameterValue(ctx context.Context, name string) (string, error) {
+	resp, err := s.client.GetParameterWithContext(ctx, &ssm.GetParameterInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get parameter %q from SSM: %w", name, err)
+	}
+	if aws.StringValue(resp.Parameter.Type) == ssm.ParameterTypeSecureString {
+		return "", &ErrSecureStringParameter{Name: name}
+	}
+	return aws.StringValue(resp.Parameter.Value), nil
+}
+
 func (s *SSM) createSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	// Create a secret while adding the tags in a single call instead of separate calls to `PutParameter` and
 	// `AddTagsToResource` so that there won't be a case where the parameter is created while the tags are not added.
@@ -413,3 +413,71 @@ func TestSSM_GetSecretValue(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_GetParameterValue(t *testing.T) {
+	tests := map[string]struct {
+		paramName string
+		setupMock func(m *mocks.Mockapi)
+
+		want      string
+		wantError string
+	}{
+		"error": {
+			paramName: "/phonetool/test/url",
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameterWithContext(gomock.Any(), &ssm.GetParameterInput{
+					Name: aws.String("/phonetool/test/url"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantError: `get parameter "/phonetool/test/url" from SSM: some error`,
+		},
+		"error if the parameter is a SecureString": {
+			paramName: "/phonetool/test/password",
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameterWithContext(gomock.Any(), &ssm.GetParameterInput{
+					Name: aws.String("/phonetool/test/password"),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Type:  aws.String(ssm.ParameterTypeSecureString),
+						Value: aws.String("AQICAHh...encrypted"),
+					},
+				}, nil)
+			},
+			wantError: "parameter /phonetool/test/password is a SecureString",
+		},
+		"success": {
+			paramName: "/phonetool/test/url",
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameterWithContext(gomock.Any(), &ssm.GetParameterInput{
+					Name: aws.String("/phonetool/test/url"),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Type:  aws.String(ssm.ParameterTypeString),
+						Value: aws.String("https://example.com"),
+					},
+				}, nil)
+			},
+			want: "https://example.com",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			api := mocks.NewMockapi(ctrl)
+			tc.setupMock(api)
+
+			ssm := SSM{
+				client: api,
+			}
+
+			got, err := ssm.GetParameterValue(context.Background(), tc.paramName)
+			if tc.wantError != "" {
+				require.EqualError(t, err, tc.wantError)
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
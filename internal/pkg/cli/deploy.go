@@ -15,6 +15,7 @@ import (
 	"github.com/dustin/go-humanize"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
@@ -177,13 +178,15 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 
 					store:           o.store,
 					ws:              o.ws,
-					newInterpolator: newManifestInterpolator,
 					unmarshal:       manifest.UnmarshalWorkload,
 					sel:             selector.NewLocalWorkloadSelector(o.prompt, o.store, ws),
 					cmd:             exec.NewCmd(),
 					templateVersion: version.LatestTemplateVersion(),
 					sessProvider:    sessProvider,
 				}
+				opts.newInterpolator = newResolvingManifestInterpolator(func() (*session.Session, error) {
+					return opts.envSess, nil
+				})
 				opts.newJobDeployer = func() (workloadDeployer, error) {
 					return newJobDeployer(opts)
 				}
@@ -195,7 +198,6 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 
 					store:           o.store,
 					ws:              o.ws,
-					newInterpolator: newManifestInterpolator,
 					unmarshal:       manifest.UnmarshalWorkload,
 					spinner:         termprogress.NewSpinner(log.DiagnosticWriter),
 					sel:             selector.NewLocalWorkloadSelector(o.prompt, o.store, ws),
@@ -204,6 +206,9 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 					sessProvider:    sessProvider,
 					templateVersion: version.LatestTemplateVersion(),
 				}
+				opts.newInterpolator = newResolvingManifestInterpolator(func() (*session.Session, error) {
+					return opts.envSess, nil
+				})
 				opts.newSvcDeployer = func() (workloadDeployer, error) {
 					return newSvcDeployer(opts)
 				}
@@ -0,0 +1,36 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/spf13/afero"
+)
+
+// NewDiffIgnoreRules reads the rules of the diff ignore files at the paths.
+// Files that don't exist are skipped, so no rules are returned if none of the files exist.
+func NewDiffIgnoreRules(fs afero.Fs, paths ...string) ([]diff.IgnoreRule, error) {
+	var rules []diff.IgnoreRule
+	for _, path := range paths {
+		exists, err := afero.Exists(fs, path)
+		if err != nil {
+			return nil, fmt.Errorf("check if diff ignore file %q exists: %w", path, err)
+		}
+		if !exists {
+			continue
+		}
+		content, err := afero.ReadFile(fs, path)
+		if err != nil {
+			return nil, fmt.Errorf("read diff ignore file %q: %w", path, err)
+		}
+		fileRules, err := diff.ParseIgnoreRules(content)
+		if err != nil {
+			return nil, fmt.Errorf("parse diff ignore file %q: %w", path, err)
+		}
+		rules = append(rules, fileRules...)
+	}
+	return rules, nil
+}
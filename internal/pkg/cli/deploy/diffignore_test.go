@@ -0,0 +1,59 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDiffIgnoreRules(t *testing.T) {
+	testCases := map[string]struct {
+		setupFS func(fs afero.Fs)
+
+		wantedRules []diff.IgnoreRule
+		wantedError string
+	}{
+		"no rules if the files don't exist": {
+			setupFS: func(fs afero.Fs) {},
+		},
+		"read the rules of all the files": {
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "copilot/diffignore.yml", []byte("paths: [/Outputs]"), 0644)
+				_ = afero.WriteFile(fs, "copilot/api/diffignore.yml", []byte("paths: [/Resources/*/Properties/TemplateURL]"), 0644)
+			},
+			wantedRules: []diff.IgnoreRule{
+				diff.IgnorePath("/Outputs"),
+				diff.IgnorePath("/Resources/*/Properties/TemplateURL"),
+			},
+		},
+		"error if a file is invalid": {
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "copilot/api/diffignore.yml", []byte("regexes: ['[a-z']"), 0644)
+			},
+			wantedError: `parse diff ignore file "copilot/api/diffignore.yml": compile regular expression "[a-z": error parsing regexp: missing closing ]: ` + "`[a-z`",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			tc.setupFS(fs)
+
+			// WHEN
+			rules, err := NewDiffIgnoreRules(fs, "copilot/diffignore.yml", "copilot/api/diffignore.yml")
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRules, rules)
+		})
+	}
+}
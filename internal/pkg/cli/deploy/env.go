@@ -101,6 +101,10 @@ type envDeployer struct {
 	ws          WorkspaceAddonsReaderPathGetter
 	parseAddons func() (stackBuilder, error)
 
+	// Rules of the differences to hide from the diff.
+	diffIgnoreRules []diff.IgnoreRule
+	overrider       Overrider
+
 	// Cached variables.
 	appRegionalResources *cfnstack.AppRegionalResources
 }
@@ -113,6 +117,7 @@ type NewEnvDeployerInput struct {
 	ConfigStore     describe.ConfigStoreSvc
 	Workspace       WorkspaceAddonsReaderPathGetter
 	Overrider       Overrider
+	DiffIgnoreRules []diff.IgnoreRule
 }
 
 // NewEnvDeployer constructs an environment deployer.
@@ -173,7 +178,9 @@ func NewEnvDeployer(in *NewEnvDeployerInput) (*envDeployer, error) {
 		parseAddons: sync.OnceValues(func() (stackBuilder, error) {
 			return addon.ParseFromEnv(in.Workspace)
 		}),
-		ws: in.Workspace,
+		ws:              in.Workspace,
+		diffIgnoreRules: in.DiffIgnoreRules,
+		overrider:       overrider,
 	}
 	return deployer, nil
 }
@@ -212,8 +219,9 @@ func (d *envDeployer) UploadArtifacts() (*UploadEnvArtifactsOutput, error) {
 	}, nil
 }
 
-// DeployDiff returns the stringified diff of the template against the deployed template of the environment.
-func (d *envDeployer) DeployDiff(template string) (string, error) {
+// DeployDiff returns the stringified diff of the template against the deployed template of the environment,
+// written with the given options.
+func (d *envDeployer) DeployDiff(template string, opts ...diff.WriteOption) (string, error) {
 	tmpl, err := d.tmplGetter.Template(cfnstack.NameForEnv(d.app.Name, d.env.Name))
 	if err != nil {
 		var errNotFound *awscloudformation.ErrStackNotFound
@@ -222,12 +230,16 @@ func (d *envDeployer) DeployDiff(template string) (string, error) {
 		}
 		tmpl = ""
 	}
-	diffTree, err := diff.From(tmpl).ParseWithCFNOverriders([]byte(template))
+	diffTree, err := diff.From(tmpl).ParseWithCFNOverriders([]byte(template), d.diffIgnoreRules...)
 	if err != nil {
 		return "", fmt.Errorf("parse the diff against the deployed env stack %q: %w", d.env.Name, err)
 	}
+	opts, err = withOverrideLayers(d.overrider, opts)
+	if err != nil {
+		return "", err
+	}
 	buf := strings.Builder{}
-	if err := diffTree.Write(&buf); err != nil {
+	if err := diffTree.Write(&buf, opts...); err != nil {
 		return "", err
 	}
 	return buf.String(), nil
@@ -0,0 +1,349 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+const fmtSvcDiscoveryEndpoint = "%s.%s.local"
+
+// OfflineFixture holds the configuration of an application and an environment that is otherwise read from AWS,
+// so that the CloudFormation template of a workload can be generated without AWS credentials.
+type OfflineFixture struct {
+	App      OfflineApplication `yaml:"application"`
+	Env      OfflineEnvironment `yaml:"environment"`
+	RootUser string             `yaml:"root_user_arn"` // Defaults to the root user of the account of the environment.
+}
+
+// OfflineApplication holds the configuration of an application and of its regional resources.
+type OfflineApplication struct {
+	Name                string            `yaml:"name"`
+	AccountID           string            `yaml:"account"`
+	Domain              string            `yaml:"domain"`
+	PermissionsBoundary string            `yaml:"permissions_boundary"`
+	TemplateVersion     string            `yaml:"version"` // Defaults to the latest version.
+	Tags                map[string]string `yaml:"tags"`
+	ArtifactBucket      string            `yaml:"artifact_bucket"`
+	ArtifactKeyARN      string            `yaml:"artifact_key_arn"`
+	Repositories        map[string]string `yaml:"repositories"` // ECR repository URLs by workload name.
+}
+
+// OfflineEnvironment holds the configuration of an environment and the values read from its stack.
+type OfflineEnvironment struct {
+	Name                 string         `yaml:"name"`
+	Region               string         `yaml:"region"`
+	AccountID            string         `yaml:"account"` // Defaults to the account of the application.
+	TemplateVersion      string         `yaml:"version"` // Defaults to the latest version.
+	Features             []string       `yaml:"features"`
+	SvcDiscoveryEndpoint string         `yaml:"service_discovery_endpoint"` // Defaults to "env.app.local".
+	Subnets              []string       `yaml:"subnets"`                    // Subnet IDs for the subnets that workloads select by tags.
+	Topics               []OfflineTopic `yaml:"topics"`
+	Manifest             string         `yaml:"manifest"`
+}
+
+// OfflineTopic is an SNS topic published by a workload of the environment.
+type OfflineTopic struct {
+	ARN      string `yaml:"arn"`
+	Workload string `yaml:"workload"`
+}
+
+// ReadOfflineFixture reads and validates the offline fixture at the given path.
+func ReadOfflineFixture(fs afero.Fs, path string) (*OfflineFixture, error) {
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("read offline fixture %s: %w", path, err)
+	}
+	var fixture OfflineFixture
+	if err := yaml.Unmarshal(content, &fixture); err != nil {
+		return nil, fmt.Errorf("unmarshal offline fixture %s: %w", path, err)
+	}
+	if fixture.Env.Region == "" {
+		return nil, fmt.Errorf(`offline fixture %s: "environment.region" is required`, path)
+	}
+	if fixture.App.AccountID == "" && fixture.Env.AccountID == "" {
+		return nil, fmt.Errorf(`offline fixture %s: "application.account" or "environment.account" is required`, path)
+	}
+	if fixture.Env.AccountID == "" {
+		fixture.Env.AccountID = fixture.App.AccountID
+	}
+	if fixture.App.AccountID == "" {
+		fixture.App.AccountID = fixture.Env.AccountID
+	}
+	return &fixture, nil
+}
+
+// Config returns the configuration of the application as stored in the config store.
+func (a *OfflineApplication) Config() *config.Application {
+	return &config.Application{
+		Name:                a.Name,
+		AccountID:           a.AccountID,
+		Domain:              a.Domain,
+		PermissionsBoundary: a.PermissionsBoundary,
+		Version:             a.TemplateVersion,
+		Tags:                a.Tags,
+	}
+}
+
+// Version returns the template version of the application.
+func (a *OfflineApplication) Version() (string, error) {
+	if a.TemplateVersion == "" {
+		return version.LatestTemplateVersion(), nil
+	}
+	return a.TemplateVersion, nil
+}
+
+func (a *OfflineApplication) resources(region string) *stack.AppRegionalResources {
+	return &stack.AppRegionalResources{
+		Region:         region,
+		KMSKeyARN:      a.ArtifactKeyARN,
+		S3Bucket:       a.ArtifactBucket,
+		RepositoryURLs: a.Repositories,
+	}
+}
+
+// Config returns the configuration of the environment as stored in the config store.
+func (e *OfflineEnvironment) Config(app string) *config.Environment {
+	return &config.Environment{
+		App:       app,
+		Name:      e.Name,
+		Region:    e.Region,
+		AccountID: e.AccountID,
+	}
+}
+
+// Version returns the template version of the environment.
+func (e *OfflineEnvironment) Version() (string, error) {
+	if e.TemplateVersion == "" {
+		return version.LatestTemplateVersion(), nil
+	}
+	return e.TemplateVersion, nil
+}
+
+// AvailableFeatures returns the features available in the environment.
+// All the features are available if the fixture doesn't list any.
+func (e *OfflineEnvironment) AvailableFeatures() ([]string, error) {
+	if len(e.Features) == 0 {
+		return template.AvailableEnvFeatures(), nil
+	}
+	return e.Features, nil
+}
+
+// ServiceDiscoveryEndpoint returns the endpoint of the environment namespace.
+func (e *OfflineEnvironment) ServiceDiscoveryEndpoint() (string, error) {
+	return e.SvcDiscoveryEndpoint, nil
+}
+
+// ListSNSTopics returns the SNS topics of the environment.
+func (e *OfflineEnvironment) ListSNSTopics(appName, envName string) ([]deploy.Topic, error) {
+	topics := make([]deploy.Topic, 0, len(e.Topics))
+	for _, t := range e.Topics {
+		topic, err := deploy.NewTopic(t.ARN, appName, envName, t.Workload)
+		if err != nil {
+			return nil, fmt.Errorf("parse topic %s: %w", t.ARN, err)
+		}
+		topics = append(topics, *topic)
+	}
+	return topics, nil
+}
+
+// RootUserARN returns the ARN of the root user of the account of the environment,
+// unless the fixture has a different one.
+func (f *OfflineFixture) RootUserARN() (string, error) {
+	if f.RootUser != "" {
+		return f.RootUser, nil
+	}
+	partition, err := partitions.Region(f.Env.Region).Partition()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("arn:%s:iam::%s:root", partition.ID(), f.Env.AccountID), nil
+}
+
+var errOffline = errors.New("not available offline")
+
+type offlineELBGetter struct{}
+
+// LoadBalancer returns an error as imported load balancers can't be described offline.
+func (offlineELBGetter) LoadBalancer(nameOrARN string) (*elbv2.LoadBalancer, error) {
+	return nil, fmt.Errorf("describe imported load balancer %q: %w", nameOrARN, errOffline)
+}
+
+type offlineCertValidator struct{}
+
+// ValidateCertAliases is a no-op as imported certificates can't be described offline.
+func (offlineCertValidator) ValidateCertAliases(_ []string, _ []string) error {
+	return nil
+}
+
+type offlineStackGenerator interface {
+	GenerateCloudFormationTemplate(in *GenerateCloudFormationTemplateInput) (*GenerateCloudFormationTemplateOutput, error)
+	AddonsTemplate() (string, error)
+}
+
+// OfflineWorkloadStackGenerator generates the CloudFormation template of a workload without AWS access.
+type OfflineWorkloadStackGenerator struct {
+	offlineStackGenerator
+}
+
+// NewOfflineWorkloadStackGenerator is the constructor for OfflineWorkloadStackGenerator.
+// The values that are read from AWS when deploying the workload are read from the fixture instead.
+func NewOfflineWorkloadStackGenerator(in *WorkloadDeployerInput, fixture *OfflineFixture) (*OfflineWorkloadStackGenerator, error) {
+	wkldDeployer, err := newOfflineWorkloadDeployer(in, fixture)
+	if err != nil {
+		return nil, err
+	}
+	svcDeployer := &svcDeployer{
+		workloadDeployer: wkldDeployer,
+		newSvcUpdater: func(func(*session.Session) serviceForceUpdater) serviceForceUpdater {
+			return nil
+		},
+		now: time.Now,
+	}
+	var gen offlineStackGenerator
+	switch mft := in.Mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		gen = &lbWebSvcDeployer{
+			svcDeployer:      svcDeployer,
+			appVersionGetter: &fixture.App,
+			elbGetter:        offlineELBGetter{},
+			lbMft:            mft,
+			newAliasCertValidator: func(_ *string) aliasCertValidator {
+				return offlineCertValidator{}
+			},
+		}
+	case *manifest.BackendService:
+		gen = &backendSvcDeployer{
+			svcDeployer:        svcDeployer,
+			elbGetter:          offlineELBGetter{},
+			backendMft:         mft,
+			aliasCertValidator: offlineCertValidator{},
+		}
+	case *manifest.RequestDrivenWebService:
+		gen = &rdwsDeployer{
+			svcDeployer:      svcDeployer,
+			rdwsMft:          mft,
+			appVersionGetter: &fixture.App,
+		}
+	case *manifest.WorkerService:
+		gen = &workerSvcDeployer{
+			svcDeployer: svcDeployer,
+			wsMft:       mft,
+			topicLister: &fixture.Env,
+		}
+	case *manifest.ScheduledJob:
+		gen = &jobDeployer{
+			workloadDeployer: wkldDeployer,
+			jobMft:           mft,
+		}
+	case *manifest.StaticSite:
+		gen = &staticSiteDeployer{
+			svcDeployer:      svcDeployer,
+			appVersionGetter: &fixture.App,
+			staticSiteMft:    mft,
+			fs:               wkldDeployer.fs,
+			newStack: func(config *stack.StaticSiteConfig) (cloudformation.StackConfiguration, error) {
+				return stack.NewStaticSite(config)
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", mft)
+	}
+	return &OfflineWorkloadStackGenerator{
+		offlineStackGenerator: gen,
+	}, nil
+}
+
+// UploadArtifacts returns an error as artifacts can't be uploaded offline.
+func (g *OfflineWorkloadStackGenerator) UploadArtifacts() (*UploadArtifactsOutput, error) {
+	return nil, fmt.Errorf("upload artifacts: %w", errOffline)
+}
+
+// DeployDiff returns an error as the deployed template can't be retrieved offline.
+func (g *OfflineWorkloadStackGenerator) DeployDiff(_ string, _ ...diff.WriteOption) (string, error) {
+	return "", fmt.Errorf("retrieve the deployed template: %w", errOffline)
+}
+
+func newOfflineWorkloadDeployer(in *WorkloadDeployerInput, fixture *OfflineFixture) (*workloadDeployer, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+	var addons stackBuilder
+	addons, err = addon.ParseFromWorkload(in.Name, ws)
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if !errors.As(err, &notFoundErr) {
+			return nil, fmt.Errorf("parse addons stack for workload %s: %w", in.Name, err)
+		}
+		addons = nil // so that we can check for no addons with nil comparison
+	}
+	envConfig, err := offlineEnvManifest(ws, in.App.Name, fixture.Env)
+	if err != nil {
+		return nil, err
+	}
+	if fixture.Env.SvcDiscoveryEndpoint == "" {
+		fixture.Env.SvcDiscoveryEndpoint = fmt.Sprintf(fmtSvcDiscoveryEndpoint, in.Env.Name, in.App.Name)
+	}
+	return &workloadDeployer{
+		name:             in.Name,
+		app:              in.App,
+		env:              in.Env,
+		image:            in.Image,
+		resources:        fixture.App.resources(in.Env.Region),
+		workspacePath:    ws.Path(),
+		fs:               fs,
+		addons:           addons,
+		endpointGetter:   &fixture.Env,
+		templateFS:       template.New(),
+		envVersionGetter: &fixture.Env,
+		overrider:        in.Overrider,
+		diffIgnoreRules:  in.DiffIgnoreRules,
+		envConfig:        envConfig,
+
+		mft:    in.Mft,
+		rawMft: in.RawMft,
+	}, nil
+}
+
+// offlineEnvManifest returns the manifest of the environment in the fixture,
+// or the interpolated manifest of the environment in the workspace if the fixture doesn't have one.
+func offlineEnvManifest(ws *workspace.Workspace, app string, env OfflineEnvironment) (*manifest.Environment, error) {
+	mft := env.Manifest
+	if mft == "" {
+		raw, err := ws.ReadEnvironmentManifest(env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest of environment %s: %w", env.Name, err)
+		}
+		interpolated, err := manifest.NewInterpolator(app, env.Name).Interpolate(string(raw))
+		if err != nil {
+			return nil, fmt.Errorf("interpolate environment variables for the manifest of environment %s: %w", env.Name, err)
+		}
+		mft = interpolated
+	}
+	envConfig, err := manifest.UnmarshalEnvironment([]byte(mft))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal the manifest of environment %s: %w", env.Name, err)
+	}
+	return envConfig, nil
+}
@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOfflineFixture(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedFixture *OfflineFixture
+		wantedError   error
+	}{
+		"error if the region is missing": {
+			inContent: `
+application:
+  account: "123456789012"`,
+			wantedError: errors.New(`offline fixture fixture.yml: "environment.region" is required`),
+		},
+		"error if the account is missing": {
+			inContent: `
+environment:
+  region: us-west-2`,
+			wantedError: errors.New(`offline fixture fixture.yml: "application.account" or "environment.account" is required`),
+		},
+		"the environment is in the account of the application by default": {
+			inContent: `
+application:
+  name: phonetool
+  account: "123456789012"
+  artifact_bucket: stackset-bucket
+  repositories:
+    api: 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api
+environment:
+  name: test
+  region: us-west-2
+  subnets: [subnet-1, subnet-2]
+  topics:
+    - arn: arn:aws:sns:us-west-2:123456789012:phonetool-test-api-events
+      workload: api`,
+			wantedFixture: &OfflineFixture{
+				App: OfflineApplication{
+					Name:           "phonetool",
+					AccountID:      "123456789012",
+					ArtifactBucket: "stackset-bucket",
+					Repositories: map[string]string{
+						"api": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api",
+					},
+				},
+				Env: OfflineEnvironment{
+					Name:      "test",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+					Subnets:   []string{"subnet-1", "subnet-2"},
+					Topics: []OfflineTopic{
+						{
+							ARN:      "arn:aws:sns:us-west-2:123456789012:phonetool-test-api-events",
+							Workload: "api",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "fixture.yml", []byte(tc.inContent), 0644))
+
+			// WHEN
+			fixture, err := ReadOfflineFixture(fs, "fixture.yml")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedFixture, fixture)
+		})
+	}
+}
+
+func TestOfflineFixture_RootUserARN(t *testing.T) {
+	testCases := map[string]struct {
+		inFixture OfflineFixture
+
+		wantedARN string
+	}{
+		"root user of the account of the environment": {
+			inFixture: OfflineFixture{
+				Env: OfflineEnvironment{
+					Region:    "cn-north-1",
+					AccountID: "123456789012",
+				},
+			},
+			wantedARN: "arn:aws-cn:iam::123456789012:root",
+		},
+		"root user of the fixture": {
+			inFixture: OfflineFixture{
+				Env: OfflineEnvironment{
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				},
+				RootUser: "arn:aws:iam::210987654321:root",
+			},
+			wantedARN: "arn:aws:iam::210987654321:root",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			arn, err := tc.inFixture.RootUserARN()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, arn)
+		})
+	}
+}
+
+func TestOfflineEnvironment_ListSNSTopics(t *testing.T) {
+	t.Run("returns the topics of the fixture", func(t *testing.T) {
+		env := OfflineEnvironment{
+			Topics: []OfflineTopic{
+				{
+					ARN:      "arn:aws:sns:us-west-2:123456789012:phonetool-test-api-events",
+					Workload: "api",
+				},
+			},
+		}
+
+		// WHEN
+		topics, err := env.ListSNSTopics("phonetool", "test")
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, topics, 1)
+		require.Equal(t, "arn:aws:sns:us-west-2:123456789012:phonetool-test-api-events", topics[0].ARN())
+		require.Equal(t, "events", topics[0].Name())
+	})
+	t.Run("error if a topic is not published by the workload", func(t *testing.T) {
+		env := OfflineEnvironment{
+			Topics: []OfflineTopic{
+				{
+					ARN:      "arn:aws:sns:us-west-2:123456789012:phonetool-test-api-events",
+					Workload: "worker",
+				},
+			},
+		}
+
+		// WHEN
+		_, err := env.ListSNSTopics("phonetool", "test")
+
+		// THEN
+		require.EqualError(t, err, "parse topic arn:aws:sns:us-west-2:123456789012:phonetool-test-api-events: ARN is not a Copilot SNS topic")
+	})
+}
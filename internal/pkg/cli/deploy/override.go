@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/aws/copilot-cli/internal/pkg/override"
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/spf13/afero"
 )
@@ -59,3 +60,91 @@ func NewOverrider(pathToOverridesDir, app, env string, fs afero.Fs, sess UserAge
 		return new(override.Noop), nil
 	}
 }
+
+// NewLayeredOverrider initializes the overriders of each of the directories, to be applied in order.
+// Directories that don't exist are skipped. If there is a single overrider, then it's returned as is.
+func NewLayeredOverrider(pathsToOverridesDirs []string, app, env string, fs afero.Fs, sess UserAgentAdder) (Overrider, error) {
+	var layers []overrideLayer
+	for _, path := range pathsToOverridesDirs {
+		ovrdr, err := NewOverrider(path, app, env, fs, sess)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := ovrdr.(*override.Noop); ok {
+			continue
+		}
+		layers = append(layers, overrideLayer{
+			path:      path,
+			overrider: ovrdr,
+		})
+	}
+	switch len(layers) {
+	case 0:
+		return new(override.Noop), nil
+	case 1:
+		return layers[0].overrider, nil
+	}
+	return &LayeredOverrider{
+		layers: layers,
+	}, nil
+}
+
+type overrideLayer struct {
+	path      string
+	overrider Overrider
+}
+
+// LayeredOverrider applies a sequence of overriders, each one to the output of the previous one.
+type LayeredOverrider struct {
+	layers []overrideLayer
+
+	// The body before and after each layer during the last override.
+	bodies [][]byte
+}
+
+// Override applies the overriders in order to body.
+func (o *LayeredOverrider) Override(body []byte) ([]byte, error) {
+	bodies := [][]byte{body}
+	for _, layer := range o.layers {
+		out, err := layer.overrider.Override(body)
+		if err != nil {
+			return nil, fmt.Errorf("apply overrides at %q: %w", layer.path, err)
+		}
+		bodies = append(bodies, out)
+		body = out
+	}
+	o.bodies = bodies
+	return body, nil
+}
+
+// DiffLayers returns the differences made by each overrider during the last override.
+func (o *LayeredOverrider) DiffLayers() ([]diff.Layer, error) {
+	if len(o.bodies) == 0 {
+		return nil, nil
+	}
+	layers := make([]diff.Layer, len(o.layers))
+	for i, layer := range o.layers {
+		tree, err := diff.From(o.bodies[i]).ParseWithCFNOverriders(o.bodies[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("parse the differences made by the overrides at %q: %w", layer.path, err)
+		}
+		layers[i] = diff.Layer{
+			Name: layer.path,
+			Tree: tree,
+		}
+	}
+	return layers, nil
+}
+
+// withOverrideLayers returns the write options with the layers of the overrider if it is a LayeredOverrider.
+func withOverrideLayers(ovrdr Overrider, opts []diff.WriteOption) ([]diff.WriteOption, error) {
+	layered, ok := ovrdr.(*LayeredOverrider)
+	if !ok {
+		return opts, nil
+	}
+	layers, err := layered.DiffLayers()
+	if err != nil {
+		return nil, err
+	}
+	return append(opts[:len(opts):len(opts)], diff.WithLayers(layers...)), nil
+}
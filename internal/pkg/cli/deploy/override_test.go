@@ -64,3 +64,100 @@ func TestNewOverrider(t *testing.T) {
 		require.Contains(t, sess.UserAgent, "override cdk")
 	})
 }
+
+func TestNewLayeredOverrider(t *testing.T) {
+	writePatches := func(fs afero.Fs, dir, patches string) {
+		_ = fs.MkdirAll(dir, 0755)
+		_ = afero.WriteFile(fs, filepath.Join(dir, override.YAMLPatchFile), []byte(patches), 0755)
+	}
+	t.Run("should return override.Noop when none of the directories exist", func(t *testing.T) {
+		// WHEN
+		ovrdr, err := NewLayeredOverrider([]string{"shared", "overrides"}, "demo", "test", afero.NewMemMapFs(), new(mockSessProvider))
+
+		// THEN
+		require.NoError(t, err)
+		_, ok := ovrdr.(*override.Noop)
+		require.True(t, ok)
+	})
+	t.Run("should return the overrider as is when a single directory exists", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		writePatches(fs, "overrides", "")
+
+		// WHEN
+		ovrdr, err := NewLayeredOverrider([]string{"shared", "overrides"}, "demo", "test", fs, new(mockSessProvider))
+
+		// THEN
+		require.NoError(t, err)
+		_, ok := ovrdr.(*override.Patch)
+		require.True(t, ok)
+	})
+	t.Run("should apply the overriders in order and record the changes of each layer", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		writePatches(fs, "shared", `
+- op: replace
+  path: /Resources/Service/Properties/DesiredCount
+  value: 2
+- op: add
+  path: /Resources/Service/Properties/PropagateTags
+  value: SERVICE`)
+		writePatches(fs, "overrides", `
+- op: replace
+  path: /Resources/Service/Properties/DesiredCount
+  value: 3`)
+		ovrdr, err := NewLayeredOverrider([]string{"shared", "overrides"}, "demo", "test", fs, new(mockSessProvider))
+		require.NoError(t, err)
+		layered, ok := ovrdr.(*LayeredOverrider)
+		require.True(t, ok)
+
+		// WHEN
+		out, err := layered.Override([]byte(`
+Resources:
+  Service:
+    Properties:
+      DesiredCount: 1`))
+
+		// THEN
+		require.NoError(t, err)
+		require.Contains(t, string(out), "DesiredCount: 3")
+		require.Contains(t, string(out), "PropagateTags: SERVICE")
+
+		layers, err := layered.DiffLayers()
+		require.NoError(t, err)
+		require.Len(t, layers, 2)
+		changes := make(map[string][]string)
+		for _, layer := range layers {
+			layerChanges, err := layer.Tree.Changes()
+			require.NoError(t, err)
+			for _, change := range layerChanges {
+				changes[layer.Name] = append(changes[layer.Name], change.Path)
+			}
+		}
+		require.Equal(t, map[string][]string{
+			"shared": {
+				"/Resources/Service/Properties/DesiredCount",
+				"/Resources/Service/Properties/PropagateTags",
+			},
+			"overrides": {
+				"/Resources/Service/Properties/DesiredCount",
+			},
+		}, changes)
+	})
+	t.Run("should return a wrapped error when a layer fails to override", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		writePatches(fs, "shared", `
+- op: remove
+  path: /Resources/DoesNotExist`)
+		writePatches(fs, "overrides", "")
+		ovrdr, err := NewLayeredOverrider([]string{"shared", "overrides"}, "demo", "test", fs, new(mockSessProvider))
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = ovrdr.Override([]byte("Resources: {}"))
+
+		// THEN
+		require.ErrorContains(t, err, `apply overrides at "shared":`)
+	})
+}
@@ -191,6 +191,7 @@ type workloadDeployer struct {
 	templateFS         template.Reader
 	envVersionGetter   versionGetter
 	overrider          Overrider
+	diffIgnoreRules    []diff.IgnoreRule
 	docker             dockerEngineRunChecker
 	customResources    customResourcesFunc
 	labeledTermPrinter func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) LabeledTermPrinter
@@ -220,6 +221,7 @@ type WorkloadDeployerInput struct {
 	RawMft           string      // With env var interpolation only.
 	EnvVersionGetter versionGetter
 	Overrider        Overrider
+	DiffIgnoreRules  []diff.IgnoreRule
 
 	// Workload specific configuration.
 	customResources customResourcesFunc
@@ -327,6 +329,7 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		templateFS:               template.New(),
 		envVersionGetter:         in.EnvVersionGetter,
 		overrider:                in.Overrider,
+		diffIgnoreRules:          in.DiffIgnoreRules,
 		docker:                   docker,
 		customResources:          in.customResources,
 		defaultSess:              defaultSession,
@@ -341,8 +344,9 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	}, nil
 }
 
-// DeployDiff returns the stringified diff of the template against the deployed template of the workload.
-func (d *workloadDeployer) DeployDiff(template string) (string, error) {
+// DeployDiff returns the stringified diff of the template against the deployed template of the workload,
+// written with the given options.
+func (d *workloadDeployer) DeployDiff(template string, opts ...diff.WriteOption) (string, error) {
 	tmpl, err := d.tmplGetter.Template(stack.NameForWorkload(d.app.Name, d.env.Name, d.name))
 	if err != nil {
 		var errNotFound *awscloudformation.ErrStackNotFound
@@ -351,12 +355,16 @@ func (d *workloadDeployer) DeployDiff(template string) (string, error) {
 		}
 		tmpl = ""
 	}
-	diffTree, err := diff.From(tmpl).ParseWithCFNOverriders([]byte(template))
+	diffTree, err := diff.From(tmpl).ParseWithCFNOverriders([]byte(template), d.diffIgnoreRules...)
 	if err != nil {
 		return "", fmt.Errorf("parse the diff against the deployed %q in environment %q: %w", d.name, d.env.Name, err)
 	}
+	opts, err = withOverrideLayers(d.overrider, opts)
+	if err != nil {
+		return "", err
+	}
 	buf := strings.Builder{}
-	if err := diffTree.Write(&buf); err != nil {
+	if err := diffTree.Write(&buf, opts...); err != nil {
 		return "", err
 	}
 	return buf.String(), nil
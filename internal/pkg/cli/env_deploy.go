@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
@@ -39,6 +40,7 @@ type deployEnvVars struct {
 	forceNewUpdate    bool
 	disableRollback   bool
 	showDiff          bool
+	diffJSON          bool
 	skipDiffPrompt    bool
 	allowEnvDowngrade bool
 	detach            bool
@@ -103,8 +105,14 @@ func newEnvDeployOpts(vars deployEnvVars) (*deployEnvOpts, error) {
 		ws:              ws,
 		identity:        identity.New(defaultSess),
 		templateVersion: version.LatestTemplateVersion(),
-		newInterpolator: newManifestInterpolator,
 	}
+	opts.newInterpolator = newResolvingManifestInterpolator(func() (*session.Session, error) {
+		env, err := opts.cachedTargetEnv()
+		if err != nil {
+			return nil, err
+		}
+		return sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	})
 	opts.newEnvDeployer = func() (envDeployer, error) {
 		return newEnvDeployer(opts, ws)
 	}
@@ -120,7 +128,15 @@ func newEnvDeployer(opts *deployEnvOpts, ws deploy.WorkspaceAddonsReaderPathGett
 	if err != nil {
 		return nil, err
 	}
-	ovrdr, err := deploy.NewOverrider(opts.ws.EnvOverridesPath(), env.App, env.Name, opts.fs, opts.sessionProvider)
+	sharedOverrides, err := opts.ws.SharedEnvOverridesPaths()
+	if err != nil {
+		return nil, fmt.Errorf("get shared overrides directories: %w", err)
+	}
+	ovrdr, err := deploy.NewLayeredOverrider(append(sharedOverrides, opts.ws.EnvOverridesPath()), env.App, env.Name, opts.fs, opts.sessionProvider)
+	if err != nil {
+		return nil, err
+	}
+	ignoreRules, err := deploy.NewDiffIgnoreRules(opts.fs, opts.ws.DiffIgnorePath(), opts.ws.EnvDiffIgnorePath(env.Name))
 	if err != nil {
 		return nil, err
 	}
@@ -131,12 +147,13 @@ func newEnvDeployer(opts *deployEnvOpts, ws deploy.WorkspaceAddonsReaderPathGett
 		ConfigStore:     opts.store,
 		Workspace:       ws,
 		Overrider:       ovrdr,
+		DiffIgnoreRules: ignoreRules,
 	})
 }
 
 // Validate is a no-op for this command.
 func (o *deployEnvOpts) Validate() error {
-	return nil
+	return validateDiffJSON(o.showDiff, o.diffJSON)
 }
 
 // Ask prompts for and validates any required flags.
@@ -287,7 +304,7 @@ func (o *deployEnvOpts) showDiffAndConfirmDeployment(deployer envDeployer, input
 	if err != nil {
 		return false, fmt.Errorf("generate the template for environment %q: %w", o.name, err)
 	}
-	if err := diff(deployer, output.Template, os.Stdout); err != nil {
+	if err := diff(deployer, output.Template, os.Stdout, o.diffJSON); err != nil {
 		var errHasDiff *errHasDiff
 		if !errors.As(err, &errHasDiff) {
 			return false, fmt.Errorf("generate diff for environment %q: %w", o.name, err)
@@ -399,6 +416,7 @@ Deploy an environment named "test".
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceEnvDeployFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.diffJSON, jsonFlag, false, diffJSONFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipDiffPrompt, diffAutoApproveFlag, false, diffAutoApproveFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowEnvDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 	cmd.Flags().BoolVar(&vars.detach, detachFlag, false, detachFlagDescription)
@@ -260,7 +260,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", errors.New("some error"))
+				m.deployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
 			},
 			wantedErr: errors.New(`generate diff for environment "mockEnv": some error`),
 		},
@@ -276,7 +276,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", nil)
 				m.prompter.EXPECT().Confirm(gomock.Eq(continueDeploymentPrompt), gomock.Any(), gomock.Any()).Return(false, nil)
 			},
 			wantedDiff: "No changes.\n",
@@ -293,7 +293,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", nil)
 				m.prompter.EXPECT().Confirm(gomock.Eq(continueDeploymentPrompt), gomock.Any(), gomock.Any()).Return(false, nil)
 			},
 			wantedDiff: "mock diff",
@@ -310,7 +310,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", nil)
 				m.prompter.EXPECT().Confirm(gomock.Eq(continueDeploymentPrompt), gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
 			},
 			wantedErr: errors.New("ask whether to continue with the deployment: some error"),
@@ -327,7 +327,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", nil)
 				m.prompter.EXPECT().Confirm(gomock.Eq(continueDeploymentPrompt), gomock.Any(), gomock.Any()).Return(false, nil)
 				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
 			},
@@ -344,7 +344,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", nil)
 				m.prompter.EXPECT().Confirm(gomock.Eq(continueDeploymentPrompt), gomock.Any(), gomock.Any()).Return(true, nil)
 				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(1)
 			},
@@ -363,7 +363,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", nil)
 				m.prompter.EXPECT().Confirm(gomock.Eq(continueDeploymentPrompt), gomock.Any(), gomock.Any()).Times(0)
 				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(1)
 			},
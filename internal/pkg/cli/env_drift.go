@@ -0,0 +1,161 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDriftAppNamePrompt     = "Which application is the environment in?"
+	envDriftAppNameHelpPrompt = "An application is a collection of related services."
+	envDriftNamePrompt        = "Which environment of %s would you like to detect the drift of?"
+	envDriftNameHelpPrompt    = "The drift of an environment is the changes made to its resources outside of Copilot, for example in the AWS console."
+	envDriftRedeployPrompt    = "Would you like to force a redeployment of environment %s?"
+	envDriftRedeployHelp      = `Redeploying forces an update of the environment with the current manifest in your workspace.
+It does not revert the drifted properties: CloudFormation only updates the resources whose template changed.`
+)
+
+type driftEnvVars struct {
+	appName string
+	name    string
+}
+
+type driftEnvOpts struct {
+	driftEnvVars
+
+	// Interfaces to interact with dependencies.
+	store            store
+	sel              configSelector
+	prompt           prompter
+	driftWriter      io.Writer
+	newDriftDetector func(env *config.Environment) (stackDriftDetector, error)
+	newEnvDeployCmd  func(vars deployEnvVars) (cmd, error)
+}
+
+func newDriftEnvOpts(vars driftEnvVars) (*driftEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env drift"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &driftEnvOpts{
+		driftEnvVars:     vars,
+		store:            store,
+		sel:              selector.NewConfigSelector(prompter, store),
+		prompt:           prompter,
+		driftWriter:      log.OutputWriter,
+		newDriftDetector: newStackDriftDetector(sessProvider),
+		newEnvDeployCmd: func(vars deployEnvVars) (cmd, error) {
+			return newEnvDeployOpts(vars)
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *driftEnvOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *driftEnvOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+	} else {
+		app, err := o.sel.Application(envDriftAppNamePrompt, envDriftAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return fmt.Errorf("validate environment name %q in application %q: %v", o.name, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envDriftNamePrompt, color.HighlightUserInput(o.appName)), envDriftNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.name = env
+	return nil
+}
+
+// Execute detects the drift of the environment's stacks, and offers to redeploy the environment if it drifted.
+func (o *driftEnvOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.name, err)
+	}
+	detector, err := o.newDriftDetector(env)
+	if err != nil {
+		return err
+	}
+	drifted, err := writeStackDrift(detector, stack.NameForEnv(o.appName, o.name), o.driftWriter)
+	if err != nil {
+		return fmt.Errorf("detect drift of environment %s: %w", o.name, err)
+	}
+	if !drifted {
+		return nil
+	}
+	redeploy, err := o.prompt.Confirm(fmt.Sprintf(envDriftRedeployPrompt, o.name), envDriftRedeployHelp)
+	if err != nil {
+		return fmt.Errorf("confirm redeployment: %w", err)
+	}
+	if !redeploy {
+		return nil
+	}
+	deployCmd, err := o.newEnvDeployCmd(deployEnvVars{
+		appName:        o.appName,
+		name:           o.name,
+		forceNewUpdate: true,
+	})
+	if err != nil {
+		return err
+	}
+	return run(deployCmd)
+}
+
+// buildEnvDriftCmd builds the command for detecting the drift of a deployed environment.
+func buildEnvDriftCmd() *cobra.Command {
+	vars := driftEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects changes made to a deployed environment outside of Copilot.",
+		Long: `Detects changes made to a deployed environment outside of Copilot, for example in the AWS console.
+Runs CloudFormation drift detection on the stack of the environment and on its addons stack.`,
+		Example: `
+  Detect the drift of the "prod" environment.
+  /code $ copilot env drift -n prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDriftEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+)
+
+func TestDriftEnvOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(detector *mocks.MockstackDriftDetector, prompt *mocks.Mockprompter, deploy *mocks.Mockcmd)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"no drift": {
+			setupMocks: func(detector *mocks.MockstackDriftDetector, _ *mocks.Mockprompter, _ *mocks.Mockcmd) {
+				detector.EXPECT().StackResources("phonetool-test").Return(nil, nil)
+				detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test").Return(nil, nil)
+			},
+			wantedOutput: "No drift detected.\n",
+		},
+		"force a redeployment of the environment": {
+			setupMocks: func(detector *mocks.MockstackDriftDetector, prompt *mocks.Mockprompter, deploy *mocks.Mockcmd) {
+				detector.EXPECT().StackResources("phonetool-test").Return(nil, nil)
+				detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test").Return([]*awscfn.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("Cluster"),
+						ResourceType:             aws.String("AWS::ECS::Cluster"),
+						StackResourceDriftStatus: aws.String(sdkcloudformation.StackResourceDriftStatusModified),
+						ExpectedProperties:       aws.String(`{"ClusterSettings":[{"Name":"containerInsights","Value":"enabled"}]}`),
+						ActualProperties:         aws.String(`{"ClusterSettings":[{"Name":"containerInsights","Value":"disabled"}]}`),
+					},
+				}, nil)
+				prompt.EXPECT().Confirm("Would you like to force a redeployment of environment test?", gomock.Any()).Return(true, nil)
+				deploy.EXPECT().Validate().Return(nil)
+				deploy.EXPECT().Ask().Return(nil)
+				deploy.EXPECT().Execute().Return(nil)
+			},
+			wantedOutput: `Drift of stack phonetool-test:
+~ Resources/Cluster/Properties/ClusterSettings:
+    ~ - (changed item)
+      ~ Value: enabled -> disabled
+
+`,
+		},
+		"error if the redeployment cannot be confirmed": {
+			setupMocks: func(detector *mocks.MockstackDriftDetector, prompt *mocks.Mockprompter, _ *mocks.Mockcmd) {
+				detector.EXPECT().StackResources("phonetool-test").Return(nil, nil)
+				detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test").Return([]*awscfn.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("Cluster"),
+						ResourceType:             aws.String("AWS::ECS::Cluster"),
+						StackResourceDriftStatus: aws.String(sdkcloudformation.StackResourceDriftStatusDeleted),
+					},
+				}, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedError: errors.New("confirm redeployment: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			detector := mocks.NewMockstackDriftDetector(ctrl)
+			prompt := mocks.NewMockprompter(ctrl)
+			deploy := mocks.NewMockcmd(ctrl)
+			tc.setupMocks(detector, prompt, deploy)
+			buf := &bytes.Buffer{}
+			opts := &driftEnvOpts{
+				driftEnvVars: driftEnvVars{
+					appName: "phonetool",
+					name:    "test",
+				},
+				store:       store,
+				prompt:      prompt,
+				driftWriter: buf,
+				newDriftDetector: func(env *config.Environment) (stackDriftDetector, error) {
+					return detector, nil
+				},
+				newEnvDeployCmd: func(vars deployEnvVars) (cmd, error) {
+					require.Equal(t, deployEnvVars{
+						appName:        "phonetool",
+						name:           "test",
+						forceNewUpdate: true,
+					}, vars)
+					return deploy, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, buf.String())
+		})
+	}
+}
@@ -139,8 +139,8 @@ func TestOverrideEnv_Validate(t *testing.T) {
 			wanted error
 		}{
 			"return an error when an unknown language is selected": {
-				lang:   "python",
-				wanted: errors.New(`"python" is not a valid CDK language: must be one of: "typescript"`),
+				lang:   "java",
+				wanted: errors.New(`"java" is not a valid CDK language: must be one of: "typescript", "python", "go"`),
 			},
 			"typescript is a valid CDK language": {
 				lang: "typescript",
@@ -402,7 +402,7 @@ func TestOverrideEnv_Execute(t *testing.T) {
 				ctrl := gomock.NewController(t)
 				defer ctrl.Finish()
 
-				vars := overrideVars{appName: "demo", name: "test", iacTool: "cdk", resources: tc.resources}
+				vars := overrideVars{appName: "demo", name: "test", iacTool: "cdk", cdkLang: "typescript", resources: tc.resources}
 				cmd := &overrideEnvOpts{
 					overrideOpts: &overrideOpts{
 						overrideVars: vars,
@@ -15,8 +15,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/afero"
 
-	"github.com/aws/copilot-cli/internal/pkg/manifest"
-
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 
@@ -25,6 +23,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -45,6 +44,7 @@ type packageEnvVars struct {
 	uploadAssets      bool
 	forceNewUpdate    bool
 	showDiff          bool
+	diffJSON          bool
 	allowEnvDowngrade bool
 }
 
@@ -119,10 +119,14 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 				ConfigStore: cfgStore,
 			})
 		},
-		newInterpolator: func(appName, name string) interpolator {
-			return manifest.NewInterpolator(appName, name)
-		},
 	}
+	opts.newInterpolator = newResolvingManifestInterpolator(func() (*session.Session, error) {
+		envCfg, err := opts.getEnvCfg()
+		if err != nil {
+			return nil, err
+		}
+		return sessProvider.FromRole(envCfg.ManagerRoleARN, envCfg.Region)
+	})
 	opts.newEnvPackager = func() (envPackager, error) {
 		appCfg, err := opts.getAppCfg()
 		if err != nil {
@@ -132,7 +136,15 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 		if err != nil {
 			return nil, err
 		}
-		ovrdr, err := deploy.NewOverrider(ws.EnvOverridesPath(), envCfg.App, envCfg.Name, fs, sessProvider)
+		sharedOverrides, err := ws.SharedEnvOverridesPaths()
+		if err != nil {
+			return nil, fmt.Errorf("get shared overrides directories: %w", err)
+		}
+		ovrdr, err := deploy.NewLayeredOverrider(append(sharedOverrides, ws.EnvOverridesPath()), envCfg.App, envCfg.Name, fs, sessProvider)
+		if err != nil {
+			return nil, err
+		}
+		ignoreRules, err := deploy.NewDiffIgnoreRules(fs, ws.DiffIgnorePath(), ws.EnvDiffIgnorePath(envCfg.Name))
 		if err != nil {
 			return nil, err
 		}
@@ -143,6 +155,7 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 			ConfigStore:     opts.cfgStore,
 			Workspace:       ws,
 			Overrider:       ovrdr,
+			DiffIgnoreRules: ignoreRules,
 		})
 	}
 	return opts, nil
@@ -150,7 +163,7 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *packageEnvOpts) Validate() error {
-	return nil
+	return validateDiffJSON(o.showDiff, o.diffJSON)
 }
 
 // Ask prompts for and validates any required flags.
@@ -214,7 +227,7 @@ func (o *packageEnvOpts) Execute() error {
 		return fmt.Errorf("generate CloudFormation template from environment %q manifest: %v", o.name, err)
 	}
 	if o.showDiff {
-		if err := diff(packager, res.Template, o.diffWriter); err != nil {
+		if err := diff(packager, res.Template, o.diffWriter, o.diffJSON); err != nil {
 			var errHasDiff *errHasDiff
 			if errors.As(err, &errHasDiff) {
 				return err
@@ -364,6 +377,7 @@ func buildEnvPkgCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceEnvDeployFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.diffJSON, jsonFlag, false, diffJSONFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowEnvDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, stackOutputDirFlag)
@@ -297,7 +297,7 @@ func TestPackageEnvOpts_Execute(t *testing.T) {
 				deployer := mocks.NewMockenvPackager(ctrl)
 				deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				deployer.EXPECT().DeployDiff(gomock.Any()).Return("", errors.New("some error"))
+				deployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
 				return &packageEnvOpts{
 					packageEnvVars: packageEnvVars{
 						name:     "test",
@@ -415,7 +415,7 @@ func TestPackageEnvOpts_Execute(t *testing.T) {
 				deployer := mocks.NewMockenvPackager(ctrl)
 				deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				deployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
+				deployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("mock diff", nil)
 				return &packageEnvOpts{
 					packageEnvVars: packageEnvVars{
 						name:     "test",
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -45,10 +46,13 @@ const (
 	imageTagFlag            = "tag"
 	stackOutputDirFlag      = "output-dir"
 	uploadAssetsFlag        = "upload-assets"
+	offlineFixtureFlag      = "offline-fixture"
 	deployFlag              = "deploy"
 	diffFlag                = "diff"
 	diffAutoApproveFlag     = "diff-yes"
 	sourcesFlag             = "sources"
+	fromEnvFlag             = "from-env"
+	toEnvFlag               = "to-env"
 
 	// Flags for operational commands.
 	limitFlag                   = "limit"
@@ -73,6 +77,12 @@ const (
 	proxyNetworkFlag   = "proxy-network"
 	watchFlag          = "watch"
 	useTaskRoleFlag    = "use-task-role"
+	fromManifestFlag   = "from-manifest"
+	localQueuesFlag    = "local-queues"
+	restartFlag        = "restart"
+	topicFlag          = "topic"
+	messageFlag        = "message"
+	attributesFlag     = "attributes"
 
 	// Flags for CI/CD.
 	githubURLFlag         = "github-url"
@@ -165,6 +175,11 @@ const (
 	deleteSecretFlag        = "delete-secret"
 	deployEnvFlag           = "deploy-env"
 	yesInitEnvFlag          = "init-env"
+	checkFlag               = "check"
+	hostARNFlag             = "host-arn"
+	commitIDFlag            = "commit-id"
+	stageFlag               = "stage"
+	artifactsDirFlag        = "artifacts-dir"
 )
 
 // Short flag names.
@@ -250,13 +265,17 @@ Mutually exclusive with the -%s ,--%s and --%s flags.`, nameFlagShort, nameFlag,
 
 	iacToolFlagDescription = fmt.Sprintf(`Infrastructure as Code tool to override a template.
 Must be one of: %s.`, strings.Join(applyAll(validIaCTools, strconv.Quote), ", "))
-	cdkLanguageFlagDescription = `Optional. The Cloud Development Kit language.`
+	cdkLanguageFlagDescription = fmt.Sprintf(`Optional. The Cloud Development Kit language.
+Must be one of: %s.`, strings.Join(applyAll(validCDKLangs, strconv.Quote), ", "))
 	overrideEnvFlagDescription = `Optional. Name of the environment to use when retrieving resources in a template.
 Defaults to a random environment.`
 	skipResourcesFlagDescription = `Optional. Skip asking for which resources to override and generate empty IaC extension files.`
 
 	repoURLFlagDescription = fmt.Sprintf(`The repository URL to trigger your pipeline.
-Supported providers are: %s.`, strings.Join(manifest.PipelineProviders, ", "))
+Supported providers are: %s.
+Repositories on GitHub Enterprise Server or GitLab self-managed require --%s.`, strings.Join(manifest.PipelineProviders, ", "), hostARNFlag)
+	hostARNFlagDescription = `Optional. The ARN of the CodeStar Connections host of a repository
+on GitHub Enterprise Server or GitLab self-managed.`
 
 	ingressTypeFlagDescription = fmt.Sprintf(`Required for a Request-Driven Web Service. Allowed source of traffic to your service.
 Must be one of %s.`, english.OxfordWordSeries(rdwsIngressOptions, "or"))
@@ -276,6 +295,9 @@ const (
 Allows you to categorize resources.`
 	diffFlagDescription            = "Compares the generated CloudFormation template to the deployed stack."
 	diffAutoApproveFlagDescription = "Skip interactive approval of diff before deploying."
+	diffJSONFlagDescription        = "Optional. Output the diff as a JSON array of changes. Must be used with --diff."
+	fromEnvFlagDescription         = "Name of the environment to compare from."
+	toEnvFlagDescription           = "Name of the environment to compare to."
 
 	// Deployment.
 	deployFlagDescription         = `Deploy your service or job to a new or existing environment.`
@@ -322,12 +344,31 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	// Run local
 	envVarOverrideFlagDescription = `Optional. Override environment variables passed to containers.
 Format: [container]:KEY=VALUE. Omit container name to apply to all containers.`
-	portOverridesFlagDescription = `Optional. Override ports exposed by service. Format: <host port>:<service port>.
-Example: --port-override 5000:80 binds localhost:5000 to the service's port 80.`
-	proxyFlagDescription        = `Optional. Proxy outbound requests to your environment's VPC.`
-	proxyNetworkFlagDescription = `Optional. Set the IP Network used by --proxy.`
-	watchFlagDescription        = `Optional. Watch changes to local files and restart containers when updated. Directories and files in the main .dockerignore file are ignored.`
-	useTaskRoleFlagDescription  = "Optional. Run containers with TaskRole credentials instead of session credentials."
+	portOverridesFlagDescription = `Optional. Override ports exposed by service. Format: [workload:]<host port>:<service port>.
+Example: --port-override 5000:80 binds localhost:5000 to the service's port 80.
+When running multiple workloads, only Load Balanced Web Services and the workloads
+named in an override, like --port-override api:5000:80, bind ports on localhost.`
+	proxyFlagDescription             = `Optional. Proxy outbound requests to your environment's VPC.`
+	proxyNetworkFlagDescription      = `Optional. Set the IP Network used by --proxy.`
+	watchFlagDescription             = `Optional. Watch changes to local files and restart containers when updated. Directories and files in the main .dockerignore file are ignored.`
+	useTaskRoleFlagDescription       = "Optional. Run containers with TaskRole credentials instead of session credentials."
+	runLocalWorkloadsFlagDescription = `Names of the services or jobs to run. Multiple workloads run together
+on a shared network and can reach each other by their Service Connect and service discovery names.`
+	runLocalAllFlagDescription = "Optional. Run all workloads in the current Copilot workspace together."
+	localQueuesFlagDescription = `Optional. Run a local SQS emulator for Worker Services, and point them to their queues on it.
+Use "copilot run local publish" to send messages to the queues.`
+	runLocalScheduleFlagDescription = `Optional. Run a Scheduled Job on the schedule in its manifest, in local time, until interrupted.
+By default, the job is run once.`
+	restartFlagDescription = `Optional. Restart containers with a backoff when they exit or their health check fails,
+instead of stopping the task.`
+	publishEnvFlagDescription = "Optional. Name of the environment whose manifest overrides apply to the subscriptions."
+	publisherFlagDescription  = "Optional. Name of the service that publishes to the topic."
+	topicFlagDescription      = "Name of the topic to publish the message to."
+	messageFlagDescription    = "Optional. Body of the message to publish."
+	attributesFlagDescription = `Optional. Message attributes to match against subscription filter policies. Format: KEY=VALUE.
+Values that are JSON arrays are string arrays, numbers are numbers, and others are strings.`
+	fromManifestFlagDescription = `Optional. Build the task from the workload manifest instead of the deployed task definition.
+Images are built locally, so the workload doesn't need to be deployed first.`
 
 	svcManifestFlagDescription = `Optional. Name of the environment in which the service was deployed;
 output the manifest file used for that deployment.`
@@ -343,6 +384,8 @@ output the manifest file used for that deployment.`
 	uploadAssetsFlagDescription = `Optional. Whether to upload assets (container images, Lambda functions, etc.).
 Uploaded asset locations are filled in the template configuration.`
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
+	offlineFixtureFlagDescription = `Optional. Path to a YAML file with the application and environment configuration.
+Generates the template without AWS credentials, reading this file instead of the deployed resources.`
 
 	// CI/CD.
 	pipelineFlagDescription          = "Name of the pipeline."
@@ -351,6 +394,10 @@ Uploaded asset locations are filled in the template configuration.`
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
 	pipelineTypeFlagDescription      = `The type of pipeline. Must be either "Workloads" or "Environments".`
+	commitIDFlagDescription          = `Optional. The commit to release instead of the latest commit on the tracked branch.`
+	stageFlagDescription             = "Name of the pipeline stage."
+	artifactsDirFlagDescription      = `Optional. Directory to write the artifacts of the stage to.
+Defaults to a new temporary directory.`
 
 	// Storage.
 	storageFlagDescription             = "Name of the storage resource to create."
@@ -446,16 +493,30 @@ permissions boundary for all roles generated within the application.`
 )
 
 type portOverride struct {
+	wkld      string // Empty if the override applies to all workloads.
 	host      string
 	container string
 }
 
+// appliesTo returns true if the override binds the container port of the workload.
+func (p portOverride) appliesTo(wkld, ctrPort string) bool {
+	return (p.wkld == "" || p.wkld == wkld) && p.container == ctrPort
+}
+
 type portOverrides []portOverride
 
 func (p *portOverrides) Set(val string) error {
 	err := errors.New("should be in format 8080:80")
 	split := strings.Split(val, ":")
-	if len(split) != 2 {
+	var wkld string
+	switch len(split) {
+	case 2:
+	case 3:
+		wkld, split = split[0], split[1:]
+		if wkld == "" {
+			return err
+		}
+	default:
 		return err
 	}
 	if _, ok := strconv.Atoi(split[0]); ok != nil {
@@ -466,12 +527,20 @@ func (p *portOverrides) Set(val string) error {
 	}
 
 	*p = append(*p, portOverride{
+		wkld:      wkld,
 		host:      split[0],
 		container: split[1],
 	})
 	return nil
 }
 
+// namesWorkload returns true if any of the overrides is specific to the workload.
+func (p portOverrides) namesWorkload(wkld string) bool {
+	return slices.ContainsFunc(p, func(override portOverride) bool {
+		return override.wkld == wkld
+	})
+}
+
 func (p *portOverrides) Type() string {
 	return "list"
 }
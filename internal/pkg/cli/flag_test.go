@@ -32,6 +32,14 @@ func TestFlag_portOverrides(t *testing.T) {
 			in:      []string{"--p", "8080:asdf"},
 			wantErr: `invalid argument "8080:asdf" for "--p" flag: should be in format 8080:80`,
 		},
+		"error: empty workload": {
+			in:      []string{"--p", ":77:7777"},
+			wantErr: `invalid argument ":77:7777" for "--p" flag: should be in format 8080:80`,
+		},
+		"error: too many parts": {
+			in:      []string{"--p", "api:77:7777:1"},
+			wantErr: `invalid argument "api:77:7777:1" for "--p" flag: should be in format 8080:80`,
+		},
 		"success: no port overrides": {},
 		"success: one port override": {
 			in: []string{"--p", "77:7777"},
@@ -55,6 +63,16 @@ func TestFlag_portOverrides(t *testing.T) {
 				},
 			},
 		},
+		"success: port override for a workload": {
+			in: []string{"--p", "api:77:7777"},
+			want: portOverrides{
+				{
+					wkld:      "api",
+					host:      "77",
+					container: "7777",
+				},
+			},
+		},
 	}
 
 	for name, tc := range tests {
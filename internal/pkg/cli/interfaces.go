@@ -33,6 +33,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -325,6 +326,10 @@ type wsWlDirReader interface {
 	wlLister
 	wsEnvironmentsLister
 	WorkloadOverridesPath(string) string
+	SharedWorkloadOverridesPaths() ([]string, error)
+	DiffIgnorePath() string
+	EnvDiffIgnorePath(string) string
+	WorkloadDiffIgnorePath(string) string
 	Summary() (*workspace.Summary, error)
 }
 
@@ -332,6 +337,9 @@ type wsEnvironmentReader interface {
 	wsEnvironmentsLister
 	HasEnvironments() (bool, error)
 	EnvOverridesPath() string
+	SharedEnvOverridesPaths() ([]string, error)
+	DiffIgnorePath() string
+	EnvDiffIgnorePath(string) string
 	ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error)
 	EnvAddonFilePath(fName string) string
 	EnvAddonFileAbsPath(fName string) string
@@ -340,10 +348,29 @@ type wsEnvironmentReader interface {
 type wsPipelineReader interface {
 	wsPipelineGetter
 	wsPipelineManifestReader
+	manifestReader
 	relPath
 	PipelineOverridesPath(string) string
 }
 
+type wsPipelineStageReader interface {
+	wsPipelineManifestReader
+	ListPipelines() ([]workspace.PipelineManifest, error)
+	ProjectRoot() string
+}
+
+type wsManifestsReader interface {
+	manifestReader
+	wlLister
+	wsEnvironmentsLister
+	relPath
+	ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error)
+	ListPipelineManifestPaths() ([]string, error)
+	ReadFile(path string) ([]byte, error)
+	WorkloadManifestFileAbsPath(name string) string
+	EnvManifestFileAbsPath(name string) string
+}
+
 type wsPipelineGetter interface {
 	wsPipelineManifestReader
 	wlLister
@@ -493,6 +520,10 @@ type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
 }
 
+type pipelineExecutionStarter interface {
+	StartExecution(pipelineName, commitID string) (string, error)
+}
+
 type deployedPipelineLister interface {
 	ListDeployedPipelines(appName string) ([]deploy.Pipeline, error)
 }
@@ -526,6 +557,11 @@ type configSelector interface {
 	Workload(prompt, help, app string) (string, error)
 }
 
+type stackDriftDetector interface {
+	DetectDrift(ctx context.Context, stackName string) ([]*awscloudformation.StackResourceDrift, error)
+	StackResources(name string) ([]*awscloudformation.StackResource, error)
+}
+
 type deploySelector interface {
 	appSelector
 	DeployedService(prompt, help string, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedService, error)
@@ -670,6 +706,10 @@ type codestar interface {
 	GetConnectionARN(string) (string, error)
 }
 
+type codestarHostGetter interface {
+	HostProviderType(hostARN string) (string, error)
+}
+
 type publicIPGetter interface {
 	PublicIP(ENI string) (string, error)
 }
@@ -700,7 +740,7 @@ type workloadDeployer interface {
 }
 
 type templateDiffer interface {
-	DeployDiff(inTmpl string) (string, error)
+	DeployDiff(inTmpl string, opts ...templatediff.WriteOption) (string, error)
 }
 
 type dockerEngineRunner interface {
@@ -713,6 +753,13 @@ type dockerEngineRunner interface {
 	ContainerExitCode(ctx context.Context, containerName string) (int, error)
 	IsContainerHealthy(ctx context.Context, containerName string) (bool, error)
 	Rm(context.Context, string) error
+	CreateNetwork(ctx context.Context, name string) error
+	RemoveNetwork(ctx context.Context, name string) error
+}
+
+type localQueueClient interface {
+	CreateQueue(ctx context.Context, name string) (string, error)
+	SendMessage(ctx context.Context, queueURL, body string) error
 }
 
 type workloadStackGenerator interface {
@@ -756,6 +803,10 @@ type secretGetter interface {
 	GetSecretValue(context.Context, string) (string, error)
 }
 
+type ssmParameterGetter interface {
+	GetParameterValue(context.Context, string) (string, error)
+}
+
 type dockerWorkload interface {
 	Dockerfile() string
 }
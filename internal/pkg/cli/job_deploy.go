@@ -86,11 +86,13 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 		sel:             selector.NewLocalWorkloadSelector(prompter, store, ws, selector.OnlyInitializedWorkloads),
 		prompt:          prompter,
 		sessProvider:    sessProvider,
-		newInterpolator: newManifestInterpolator,
 		cmd:             exec.NewCmd(),
 		templateVersion: version.LatestTemplateVersion(),
 		diffWriter:      os.Stdout,
 	}
+	opts.newInterpolator = newResolvingManifestInterpolator(func() (*session.Session, error) {
+		return opts.envSess, nil
+	})
 	opts.newJobDeployer = func() (workloadDeployer, error) {
 		// NOTE: Defined as a struct member to facilitate unit testing.
 		return newJobDeployer(opts)
@@ -99,7 +101,15 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 }
 
 func newJobDeployer(o *deployJobOpts) (workloadDeployer, error) {
-	ovrdr, err := deploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider)
+	sharedOverrides, err := o.ws.SharedWorkloadOverridesPaths()
+	if err != nil {
+		return nil, fmt.Errorf("get shared overrides directories: %w", err)
+	}
+	ovrdr, err := deploy.NewLayeredOverrider(append(sharedOverrides, o.ws.WorkloadOverridesPath(o.name)), o.appName, o.envName, afero.NewOsFs(), o.sessProvider)
+	if err != nil {
+		return nil, err
+	}
+	ignoreRules, err := deploy.NewDiffIgnoreRules(afero.NewOsFs(), o.ws.DiffIgnorePath(), o.ws.EnvDiffIgnorePath(o.envName), o.ws.WorkloadDiffIgnorePath(o.name))
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +128,7 @@ func newJobDeployer(o *deployJobOpts) (workloadDeployer, error) {
 		RawMft:           o.rawMft,
 		EnvVersionGetter: o.envFeaturesDescriber,
 		Overrider:        ovrdr,
+		DiffIgnoreRules:  ignoreRules,
 	}
 	var deployer workloadDeployer
 	switch t := content.(type) {
@@ -147,7 +158,7 @@ func (o *deployJobOpts) Validate() error {
 			return err
 		}
 	}
-	return nil
+	return validateDiffJSON(o.showDiff, o.diffJSON)
 }
 
 // Ask prompts the user for any required fields that are not provided.
@@ -222,7 +233,7 @@ func (o *deployJobOpts) Execute() error {
 		if err != nil {
 			return fmt.Errorf("generate the template for job %q against environment %q: %w", o.name, o.envName, err)
 		}
-		if err := diff(deployer, output.Template, o.diffWriter); err != nil {
+		if err := diff(deployer, output.Template, o.diffWriter, o.diffJSON); err != nil {
 			var errHasDiff *errHasDiff
 			if !errors.As(err, &errHasDiff) {
 				return err
@@ -414,6 +425,7 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.diffJSON, jsonFlag, false, diffJSONFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 	cmd.Flags().BoolVar(&vars.detach, detachFlag, false, detachFlagDescription)
 	return cmd
@@ -308,7 +308,7 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("", errors.New("some error"))
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
 			},
 			wantedError: errors.New("some error"),
 		},
@@ -328,7 +328,7 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
 			},
@@ -350,7 +350,7 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("mock diff", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
 			},
@@ -372,7 +372,7 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("mock diff", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
 			},
@@ -394,7 +394,7 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("mock diff", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
@@ -416,7 +416,7 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("mock diff", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(true, nil)
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(1)
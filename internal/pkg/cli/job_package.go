@@ -9,6 +9,7 @@ import (
 	"slices"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/version"
@@ -37,6 +38,7 @@ type packageJobVars struct {
 	outputDir          string
 	uploadAssets       bool
 	showDiff           bool
+	diffJSON           bool
 	allowWkldDowngrade bool
 }
 
@@ -78,7 +80,7 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 	}
 
 	opts.newPackageCmd = func(o *packageJobOpts) {
-		opts.packageCmd = &packageSvcOpts{
+		packageCmd := &packageSvcOpts{
 			packageSvcVars: packageSvcVars{
 				name:               o.name,
 				envName:            o.envName,
@@ -88,13 +90,13 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 				uploadAssets:       o.uploadAssets,
 				allowWkldDowngrade: o.allowWkldDowngrade,
 				showDiff:           o.showDiff,
+				diffJSON:           o.diffJSON,
 			},
 			runner:            o.runner,
 			ws:                ws,
 			store:             o.store,
 			templateWriter:    os.Stdout,
 			unmarshal:         manifest.UnmarshalWorkload,
-			newInterpolator:   newManifestInterpolator,
 			paramsWriter:      discardFile{},
 			addonsWriter:      discardFile{},
 			diffWriter:        os.Stdout,
@@ -104,6 +106,10 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 			gitShortCommit:    imageTagFromGit(o.runner),
 			templateVersion:   version.LatestTemplateVersion(),
 		}
+		packageCmd.newInterpolator = newResolvingManifestInterpolator(func() (*session.Session, error) {
+			return packageCmd.envSess, nil
+		})
+		opts.packageCmd = packageCmd
 	}
 	return opts, nil
 }
@@ -127,7 +133,7 @@ func (o *packageJobOpts) Validate() error {
 			return err
 		}
 	}
-	return nil
+	return validateDiffJSON(o.showDiff, o.diffJSON)
 }
 
 // Ask prompts the user for any missing required fields.
@@ -210,6 +216,7 @@ func buildJobPackageCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.diffJSON, jsonFlag, false, diffJSONFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, stackOutputDirFlag)
@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"io"
+	"os"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/lsp"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type lspVars struct {
+	appName string
+}
+
+type lspOpts struct {
+	lspVars
+
+	ws              wsManifestsReader
+	newInterpolator func(app, env string) interpolator
+	in              io.Reader
+	out             io.Writer
+}
+
+func newLSPOpts(vars lspVars) (*lspOpts, error) {
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	return &lspOpts{
+		lspVars:         vars,
+		ws:              ws,
+		newInterpolator: newManifestInterpolator,
+		in:              os.Stdin,
+		out:             os.Stdout,
+	}, nil
+}
+
+// Validate is a no-op for this command.
+func (o *lspOpts) Validate() error {
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *lspOpts) Ask() error {
+	return nil
+}
+
+// Execute serves the language server protocol over stdin and stdout until the editor exits.
+func (o *lspOpts) Execute() error {
+	linter := &manifestLinter{
+		validateManifestsOpts: &validateManifestsOpts{
+			validateManifestsVars: validateManifestsVars{
+				appName: o.appName,
+			},
+			ws:              o.ws,
+			newInterpolator: o.newInterpolator,
+		},
+	}
+	return lsp.NewServer(linter, o.ws, version.Version).Serve(o.in, o.out)
+}
+
+// manifestLinter reports the problems of the manifests in the workspace the same way as "copilot validate".
+type manifestLinter struct {
+	*validateManifestsOpts
+}
+
+// Lint returns the problems in the content of the manifest at path, or nil if path isn't a manifest of the workspace.
+func (l *manifestLinter) Lint(path string, content []byte) []lsp.Problem {
+	rel, err := l.ws.Rel(path)
+	if err != nil {
+		return nil
+	}
+	var problems []*manifestProblem
+	switch kind, name := workspace.ManifestKindOf(rel); kind {
+	case workspace.ManifestKindEnvironment:
+		problems = l.environmentProblems(rel, name, content)
+	case workspace.ManifestKindPipeline:
+		problems = pipelineProblems(rel, content)
+	case workspace.ManifestKindWorkload:
+		envs, err := listWorkspaceEnvs(l.ws)
+		if err != nil {
+			return nil
+		}
+		problems = l.workloadProblems(rel, content, envs)
+	default:
+		return nil
+	}
+	var out []lsp.Problem
+	for _, problem := range problems {
+		out = append(out, lsp.Problem{
+			Position: problem.pos,
+			Message:  problem.message(),
+		})
+	}
+	return out
+}
+
+// BuildLSPCmd builds the command for running the language server of manifests.
+func BuildLSPCmd() *cobra.Command {
+	vars := lspVars{}
+	cmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "Runs a language server for your manifests.",
+		Long: `Runs a language server for your manifests over stdin and stdout.
+Editors use it to report problems in the manifests of the workspace as you type,
+show the documentation of fields on hover, and go to the definition of references.`,
+		Example: `
+  Run the language server from the workspace, usually started by your editor.
+  /code $ copilot lsp`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newLSPOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/lsp"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestLinter_Lint(t *testing.T) {
+	const invalidSvcMft = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+environments:
+  test:
+    count:
+      range: 1-10
+      requests: 100
+`
+	testCases := map[string]struct {
+		inPath     string
+		inContent  string
+		setupMocks func(m *mocks.MockwsManifestsReader)
+
+		wantedProblems []lsp.Problem
+	}{
+		"file outside of the workspace": {
+			inPath:    "/other/copilot/api/manifest.yml",
+			inContent: invalidSvcMft,
+		},
+		"file that isn't a manifest": {
+			inPath:    "/ws/copilot/api/addons/db.yml",
+			inContent: invalidSvcMft,
+		},
+		"workload manifest against the environments of the workspace": {
+			inPath:    "/ws/copilot/api/manifest.yml",
+			inContent: invalidSvcMft,
+			setupMocks: func(m *mocks.MockwsManifestsReader) {
+				m.EXPECT().ListEnvironments().Return([]string{"prod"}, nil)
+			},
+			wantedProblems: []lsp.Problem{
+				{
+					Position: manifest.Position{Line: 10, Column: 7},
+					Message:  `"http" must be specified if "count.requests" or "count.response_time" are specified (environments: test)`,
+				},
+			},
+		},
+		"no problems if the environments can't be listed": {
+			inPath:    "/ws/copilot/api/manifest.yml",
+			inContent: invalidSvcMft,
+			setupMocks: func(m *mocks.MockwsManifestsReader) {
+				m.EXPECT().ListEnvironments().Return(nil, errors.New("some error"))
+			},
+		},
+		"environment manifest": {
+			inPath:    "/ws/copilot/environments/test/manifest.yml",
+			inContent: "name: test\ntype: Environment\nhttp:\n  public:\n    certificates: [bad]\n",
+			wantedProblems: []lsp.Problem{
+				{
+					Position: manifest.Position{Line: 5, Column: 20},
+					Message:  `validate "http config": validate "public": parse "certificates[0]": arn: invalid prefix`,
+				},
+			},
+		},
+		"pipeline manifest": {
+			inPath:    "/ws/copilot/pipelines/release/manifest.yml",
+			inContent: "name: release\nversion: 1\nstages:\n  - name: test\n   requires_approval: true\n",
+			wantedProblems: []lsp.Problem{
+				{
+					Position: manifest.Position{Line: 3, Column: 1},
+					Message:  `yaml: line 3: did not find expected '-' indicator`,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsManifestsReader(ctrl)
+			ws.EXPECT().Rel(gomock.Any()).DoAndReturn(func(path string) (string, error) {
+				return filepath.Rel("/ws", path)
+			})
+			if tc.setupMocks != nil {
+				tc.setupMocks(ws)
+			}
+			linter := &manifestLinter{
+				validateManifestsOpts: &validateManifestsOpts{
+					validateManifestsVars: validateManifestsVars{
+						appName: "phonetool",
+					},
+					ws:              ws,
+					newInterpolator: newManifestInterpolator,
+				},
+			}
+
+			// WHEN
+			problems := linter.Lint(filepath.FromSlash(tc.inPath), []byte(tc.inContent))
+
+			// THEN
+			require.Equal(t, tc.wantedProblems, problems)
+			for _, problem := range problems {
+				require.False(t, strings.Contains(problem.Message, tc.inPath))
+			}
+		})
+	}
+}
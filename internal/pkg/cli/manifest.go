@@ -0,0 +1,53 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildManifestCmd is the top level command for manifests.
+func BuildManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "manifest",
+		Short: `Commands for manifests.
+Manifests describe your workloads, environments and pipelines as code.`,
+		Long: `Commands for manifests.
+Manifests describe your workloads, environments and pipelines as code.`,
+	}
+
+	cmd.AddCommand(buildManifestSchemaCmd())
+	cmd.AddCommand(buildManifestUpgradeCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
+
+// listWorkspaceEnvs returns the environments that have a manifest in the workspace.
+// It returns none if the workspace has no environments directory.
+func listWorkspaceEnvs(ws wsEnvironmentsLister) ([]string, error) {
+	envs, err := ws.ListEnvironments()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("list environments in workspace: %w", err)
+	}
+	return envs, nil
+}
+
+// workspaceRelPath returns the path relative to the workspace, or the absolute path if it can't be made relative.
+func workspaceRelPath(ws relPath, absPath string) string {
+	rel, err := ws.Rel(absPath)
+	if err != nil {
+		return absPath
+	}
+	return rel
+}
@@ -0,0 +1,104 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/spf13/cobra"
+)
+
+const (
+	manifestSchemaTypeHelpPrompt = "Editors such as VS Code use the JSON schema of a manifest type to complete and validate manifests of that type."
+)
+
+var (
+	manifestSchemaTypePrompt          = "Which " + color.Emphasize("type") + " of manifest would you like the JSON schema of?"
+	manifestSchemaTypeFlagDescription = fmt.Sprintf(`Type of manifest. Must be one of:
+%s.`, strings.Join(applyAll(manifest.SchemaTypes(), strconv.Quote), ", "))
+)
+
+type manifestSchemaVars struct {
+	mftType string
+}
+
+type manifestSchemaOpts struct {
+	manifestSchemaVars
+
+	prompt       prompter
+	schemaWriter io.Writer
+}
+
+func newManifestSchemaOpts(vars manifestSchemaVars) *manifestSchemaOpts {
+	return &manifestSchemaOpts{
+		manifestSchemaVars: vars,
+		prompt:             prompt.New(),
+		schemaWriter:       os.Stdout,
+	}
+}
+
+// Validate returns an error if the manifest type is not supported.
+func (o *manifestSchemaOpts) Validate() error {
+	if o.mftType == "" {
+		return nil
+	}
+	if !slices.Contains(manifest.SchemaTypes(), o.mftType) {
+		return fmt.Errorf("invalid manifest type %q: must be one of %s", o.mftType, strings.Join(applyAll(manifest.SchemaTypes(), strconv.Quote), ", "))
+	}
+	return nil
+}
+
+// Ask prompts for the manifest type if it's not provided.
+func (o *manifestSchemaOpts) Ask() error {
+	if o.mftType != "" {
+		return nil
+	}
+	mftType, err := o.prompt.SelectOne(manifestSchemaTypePrompt, manifestSchemaTypeHelpPrompt, manifest.SchemaTypes(), prompt.WithFinalMessage("Manifest type:"))
+	if err != nil {
+		return fmt.Errorf("select manifest type: %w", err)
+	}
+	o.mftType = mftType
+	return nil
+}
+
+// Execute writes the JSON schema of the manifest type.
+func (o *manifestSchemaOpts) Execute() error {
+	schema, err := manifest.JSONSchema(o.mftType)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(o.schemaWriter, string(schema)); err != nil {
+		return fmt.Errorf("write JSON schema of %s manifest: %w", o.mftType, err)
+	}
+	return nil
+}
+
+// buildManifestSchemaCmd builds the command for printing the JSON schema of a manifest type.
+func buildManifestSchemaCmd() *cobra.Command {
+	vars := manifestSchemaVars{}
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Prints the JSON schema of a manifest type.",
+		Long: `Prints the JSON schema of a manifest type.
+Editors can use the schema to complete and validate your manifests.`,
+		Example: `
+  Save the JSON schema of Load Balanced Web Service manifests.
+  /code $ copilot manifest schema --type "Load Balanced Web Service" > lb-web-service.schema.json
+  Print the JSON schema of environment manifests.
+  /code $ copilot manifest schema --type Environment`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(newManifestSchemaOpts(vars))
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.mftType, typeFlag, typeFlagShort, "", manifestSchemaTypeFlagDescription)
+	return cmd
+}
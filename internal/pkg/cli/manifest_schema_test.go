@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestSchemaOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inType string
+
+		wantedError error
+	}{
+		"no type": {},
+		"valid type": {
+			inType: manifest.PipelineManifestType,
+		},
+		"invalid type": {
+			inType:      "Lambda Function",
+			wantedError: errors.New(`invalid manifest type "Lambda Function": must be one of "Request-Driven Web Service", "Load Balanced Web Service", "Backend Service", "Worker Service", "Static Site", "Scheduled Job", "Environment", "Pipeline"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &manifestSchemaOpts{
+				manifestSchemaVars: manifestSchemaVars{
+					mftType: tc.inType,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestManifestSchemaOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inType     string
+		setupMocks func(m *mocks.Mockprompter)
+
+		wantedType  string
+		wantedError error
+	}{
+		"does not prompt if the type is provided": {
+			inType:     manifestinfo.BackendServiceType,
+			setupMocks: func(m *mocks.Mockprompter) {},
+			wantedType: manifestinfo.BackendServiceType,
+		},
+		"prompts for the type": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(manifestSchemaTypePrompt, manifestSchemaTypeHelpPrompt, manifest.SchemaTypes(), gomock.Any()).Return(manifest.PipelineManifestType, nil)
+			},
+			wantedType: manifest.PipelineManifestType,
+		},
+		"error if the type cannot be selected": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select manifest type: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			prompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(prompt)
+			opts := &manifestSchemaOpts{
+				manifestSchemaVars: manifestSchemaVars{
+					mftType: tc.inType,
+				},
+				prompt: prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedType, opts.mftType)
+		})
+	}
+}
+
+func TestManifestSchemaOpts_Execute(t *testing.T) {
+	t.Run("writes the JSON schema of the manifest type", func(t *testing.T) {
+		// GIVEN
+		buf := &strings.Builder{}
+		opts := &manifestSchemaOpts{
+			manifestSchemaVars: manifestSchemaVars{
+				mftType: manifestinfo.WorkerServiceType,
+			},
+			schemaWriter: buf,
+		}
+
+		// WHEN
+		err := opts.Execute()
+
+		// THEN
+		require.NoError(t, err)
+		var schema struct {
+			Title string `json:"title"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(buf.String()), &schema))
+		require.Equal(t, "Copilot Worker Service manifest", schema.Title)
+	})
+}
@@ -0,0 +1,193 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	manifestUpgradeCheckFlagDescription = `Optional. Print the changes without writing them,
+and exit with an error if any manifest uses deprecated fields.`
+)
+
+type manifestUpgradeVars struct {
+	check bool
+}
+
+type manifestUpgradeOpts struct {
+	manifestUpgradeVars
+
+	ws         wsManifestsReader
+	fs         afero.Fs
+	diffWriter io.Writer
+}
+
+func newManifestUpgradeOpts(vars manifestUpgradeVars) (*manifestUpgradeOpts, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+	return &manifestUpgradeOpts{
+		manifestUpgradeVars: vars,
+		ws:                  ws,
+		fs:                  fs,
+		diffWriter:          os.Stdout,
+	}, nil
+}
+
+// Validate is a no-op for this command.
+func (o *manifestUpgradeOpts) Validate() error {
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *manifestUpgradeOpts) Ask() error {
+	return nil
+}
+
+// Execute rewrites the deprecated fields of the workload and environment manifests of the workspace
+// and prints the diff of each upgraded manifest.
+func (o *manifestUpgradeOpts) Execute() error {
+	paths, err := o.manifestPaths()
+	if err != nil {
+		return err
+	}
+	var outdated int
+	for _, path := range paths {
+		upgraded, err := o.upgrade(path)
+		if err != nil {
+			return err
+		}
+		if upgraded {
+			outdated++
+		}
+	}
+	switch {
+	case outdated == 0:
+		log.Successln("All manifests are up to date.")
+		return nil
+	case !o.check:
+		log.Successf("Upgraded %s.\n", pluralManifests(outdated))
+		return nil
+	case outdated == 1:
+		return errors.New(`1 manifest uses deprecated fields, run "copilot manifest upgrade" to upgrade it`)
+	default:
+		return fmt.Errorf(`%d manifests use deprecated fields, run "copilot manifest upgrade" to upgrade them`, outdated)
+	}
+}
+
+// manifestPaths returns the absolute paths of the workload and environment manifests of the workspace.
+func (o *manifestUpgradeOpts) manifestPaths() ([]string, error) {
+	wklds, err := o.ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in workspace: %w", err)
+	}
+	envs, err := listWorkspaceEnvs(o.ws)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, wkld := range wklds {
+		paths = append(paths, o.ws.WorkloadManifestFileAbsPath(wkld))
+	}
+	for _, env := range envs {
+		paths = append(paths, o.ws.EnvManifestFileAbsPath(env))
+	}
+	return paths, nil
+}
+
+// upgrade prints the diff of the manifest at path once upgraded, and writes it unless the command only checks the manifests.
+// It returns true if the manifest uses deprecated fields.
+func (o *manifestUpgradeOpts) upgrade(path string) (bool, error) {
+	rel := workspaceRelPath(o.ws, path)
+	in, err := afero.ReadFile(o.fs, path)
+	if err != nil {
+		return false, fmt.Errorf("read manifest %s: %w", rel, err)
+	}
+	out, changes, err := manifest.Upgrade(in)
+	if err != nil {
+		return false, fmt.Errorf("upgrade manifest %s: %w", rel, err)
+	}
+	if len(changes) == 0 {
+		return false, nil
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        diffLines(in),
+		B:        diffLines(out),
+		FromFile: rel,
+		ToFile:   rel,
+		Context:  3,
+	})
+	if err != nil {
+		return false, fmt.Errorf("diff manifest %s: %w", rel, err)
+	}
+	if _, err := fmt.Fprint(o.diffWriter, diff); err != nil {
+		return false, fmt.Errorf("write diff of manifest %s: %w", rel, err)
+	}
+	for _, change := range changes {
+		log.Infof("%s: %s\n", rel, change)
+	}
+	if o.check {
+		return true, nil
+	}
+	if err := afero.WriteFile(o.fs, path, out, 0644); err != nil {
+		return false, fmt.Errorf("write manifest %s: %w", rel, err)
+	}
+	return true, nil
+}
+
+// diffLines splits the content of a file into lines for a diff, keeping the line endings.
+func diffLines(content []byte) []string {
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func pluralManifests(n int) string {
+	if n == 1 {
+		return "1 manifest"
+	}
+	return fmt.Sprintf("%d manifests", n)
+}
+
+// buildManifestUpgradeCmd builds the command for rewriting the deprecated fields of the manifests in the workspace.
+func buildManifestUpgradeCmd() *cobra.Command {
+	vars := manifestUpgradeVars{}
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Rewrites the deprecated fields of your manifests to their current form.",
+		Long: `Rewrites the deprecated fields of the workload and environment manifests in your workspace
+to their current form, and prints the diff of each upgraded manifest.
+Comments and the order of the fields are preserved.`,
+		Example: `
+  Upgrade the manifests of the workspace.
+  /code $ copilot manifest upgrade
+  Fail a CI build if a manifest uses deprecated fields.
+  /code $ copilot manifest upgrade --check`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newManifestUpgradeOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().BoolVar(&vars.check, checkFlag, false, manifestUpgradeCheckFlagDescription)
+	return cmd
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestUpgradeOpts_Execute(t *testing.T) {
+	const (
+		svcPath = "/ws/copilot/api/manifest.yml"
+		envPath = "/ws/copilot/environments/test/manifest.yml"
+
+		deprecatedSvcMft = `name: api
+type: Load Balanced Web Service
+http:
+  path: '/'
+  targetContainer: nginx
+`
+		upgradedSvcMft = `name: api
+type: Load Balanced Web Service
+http:
+  path: '/'
+  target_container: nginx
+`
+		envMft = `name: test
+type: Environment
+`
+	)
+	mockWorkspace := func(m *mocks.MockwsManifestsReader) {
+		m.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+		m.EXPECT().ListEnvironments().Return([]string{"test"}, nil)
+		m.EXPECT().WorkloadManifestFileAbsPath("api").Return(svcPath)
+		m.EXPECT().EnvManifestFileAbsPath("test").Return(envPath)
+		m.EXPECT().Rel(gomock.Any()).DoAndReturn(func(path string) (string, error) {
+			return filepath.Rel("/ws", path)
+		}).AnyTimes()
+	}
+	const wantedDiff = `--- copilot/api/manifest.yml
++++ copilot/api/manifest.yml
+@@ -2,4 +2,4 @@
+ type: Load Balanced Web Service
+ http:
+   path: '/'
+-  targetContainer: nginx
++  target_container: nginx
+`
+	testCases := map[string]struct {
+		inCheck    bool
+		inSvcMft   string
+		setupMocks func(m *mocks.MockwsManifestsReader)
+
+		wantedSvcMft string
+		wantedDiff   string
+		wantedError  error
+	}{
+		"error if the workloads can't be listed": {
+			inSvcMft: deprecatedSvcMft,
+			setupMocks: func(m *mocks.MockwsManifestsReader) {
+				m.EXPECT().ListWorkloads().Return(nil, errors.New("some error"))
+			},
+			wantedSvcMft: deprecatedSvcMft,
+			wantedError:  errors.New("list workloads in workspace: some error"),
+		},
+		"manifests that are up to date are left as is": {
+			inSvcMft:     upgradedSvcMft,
+			setupMocks:   mockWorkspace,
+			wantedSvcMft: upgradedSvcMft,
+		},
+		"upgrade manifests that use deprecated fields": {
+			inSvcMft:     deprecatedSvcMft,
+			setupMocks:   mockWorkspace,
+			wantedSvcMft: upgradedSvcMft,
+			wantedDiff:   wantedDiff,
+		},
+		"only print the diff and return an error with --check": {
+			inCheck:      true,
+			inSvcMft:     deprecatedSvcMft,
+			setupMocks:   mockWorkspace,
+			wantedSvcMft: deprecatedSvcMft,
+			wantedDiff:   wantedDiff,
+			wantedError:  errors.New(`1 manifest uses deprecated fields, run "copilot manifest upgrade" to upgrade it`),
+		},
+		"error if a manifest is not valid YAML": {
+			inSvcMft:     "name: api\n  type: Backend Service\n",
+			setupMocks:   mockWorkspace,
+			wantedSvcMft: "name: api\n  type: Backend Service\n",
+			wantedError:  errors.New("upgrade manifest copilot/api/manifest.yml: unmarshal manifest: yaml: line 2: mapping values are not allowed in this context"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsManifestsReader(ctrl)
+			tc.setupMocks(ws)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, svcPath, []byte(tc.inSvcMft), 0644))
+			require.NoError(t, afero.WriteFile(fs, envPath, []byte(envMft), 0644))
+			out := &strings.Builder{}
+			opts := &manifestUpgradeOpts{
+				manifestUpgradeVars: manifestUpgradeVars{
+					check: tc.inCheck,
+				},
+				ws:         ws,
+				fs:         fs,
+				diffWriter: out,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedDiff, out.String())
+			svcMft, err := afero.ReadFile(fs, svcPath)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvcMft, string(svcMft))
+			env, err := afero.ReadFile(fs, envPath)
+			require.NoError(t, err)
+			require.Equal(t, envMft, string(env))
+		})
+	}
+}
@@ -34,6 +34,7 @@ import (
 	manifest "github.com/aws/copilot-cli/internal/pkg/manifest"
 	task "github.com/aws/copilot-cli/internal/pkg/task"
 	template "github.com/aws/copilot-cli/internal/pkg/template"
+	diff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	prompt "github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	selector "github.com/aws/copilot-cli/internal/pkg/term/selector"
 	workspace "github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -3037,6 +3038,20 @@ func (m *MockwsReadWriter) EXPECT() *MockwsReadWriterMockRecorder {
 	return m.recorder
 }
 
+// DiffIgnorePath mocks base method.
+func (m *MockwsReadWriter) DiffIgnorePath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffIgnorePath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// DiffIgnorePath indicates an expected call of DiffIgnorePath.
+func (mr *MockwsReadWriterMockRecorder) DiffIgnorePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffIgnorePath", reflect.TypeOf((*MockwsReadWriter)(nil).DiffIgnorePath))
+}
+
 // EnvAddonFileAbsPath mocks base method.
 func (m *MockwsReadWriter) EnvAddonFileAbsPath(fName string) string {
 	m.ctrl.T.Helper()
@@ -3065,6 +3080,20 @@ func (mr *MockwsReadWriterMockRecorder) EnvAddonFilePath(fName interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonFilePath", reflect.TypeOf((*MockwsReadWriter)(nil).EnvAddonFilePath), fName)
 }
 
+// EnvDiffIgnorePath mocks base method.
+func (m *MockwsReadWriter) EnvDiffIgnorePath(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvDiffIgnorePath", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EnvDiffIgnorePath indicates an expected call of EnvDiffIgnorePath.
+func (mr *MockwsReadWriterMockRecorder) EnvDiffIgnorePath(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvDiffIgnorePath", reflect.TypeOf((*MockwsReadWriter)(nil).EnvDiffIgnorePath), arg0)
+}
+
 // EnvOverridesPath mocks base method.
 func (m *MockwsReadWriter) EnvOverridesPath() string {
 	m.ctrl.T.Helper()
@@ -3154,6 +3183,21 @@ func (mr *MockwsReadWriterMockRecorder) ReadWorkloadManifest(name interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsReadWriter)(nil).ReadWorkloadManifest), name)
 }
 
+// SharedEnvOverridesPaths mocks base method.
+func (m *MockwsReadWriter) SharedEnvOverridesPaths() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SharedEnvOverridesPaths")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SharedEnvOverridesPaths indicates an expected call of SharedEnvOverridesPaths.
+func (mr *MockwsReadWriterMockRecorder) SharedEnvOverridesPaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SharedEnvOverridesPaths", reflect.TypeOf((*MockwsReadWriter)(nil).SharedEnvOverridesPaths))
+}
+
 // WorkloadAddonFileAbsPath mocks base method.
 func (m *MockwsReadWriter) WorkloadAddonFileAbsPath(wkldName, fName string) string {
 	m.ctrl.T.Helper()
@@ -3302,6 +3346,34 @@ func (m *MockwsWlDirReader) EXPECT() *MockwsWlDirReaderMockRecorder {
 	return m.recorder
 }
 
+// DiffIgnorePath mocks base method.
+func (m *MockwsWlDirReader) DiffIgnorePath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffIgnorePath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// DiffIgnorePath indicates an expected call of DiffIgnorePath.
+func (mr *MockwsWlDirReaderMockRecorder) DiffIgnorePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffIgnorePath", reflect.TypeOf((*MockwsWlDirReader)(nil).DiffIgnorePath))
+}
+
+// EnvDiffIgnorePath mocks base method.
+func (m *MockwsWlDirReader) EnvDiffIgnorePath(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvDiffIgnorePath", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EnvDiffIgnorePath indicates an expected call of EnvDiffIgnorePath.
+func (mr *MockwsWlDirReaderMockRecorder) EnvDiffIgnorePath(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvDiffIgnorePath", reflect.TypeOf((*MockwsWlDirReader)(nil).EnvDiffIgnorePath), arg0)
+}
+
 // ListEnvironments mocks base method.
 func (m *MockwsWlDirReader) ListEnvironments() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -3391,6 +3463,21 @@ func (mr *MockwsWlDirReaderMockRecorder) ReadWorkloadManifest(name interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWlDirReader)(nil).ReadWorkloadManifest), name)
 }
 
+// SharedWorkloadOverridesPaths mocks base method.
+func (m *MockwsWlDirReader) SharedWorkloadOverridesPaths() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SharedWorkloadOverridesPaths")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SharedWorkloadOverridesPaths indicates an expected call of SharedWorkloadOverridesPaths.
+func (mr *MockwsWlDirReaderMockRecorder) SharedWorkloadOverridesPaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SharedWorkloadOverridesPaths", reflect.TypeOf((*MockwsWlDirReader)(nil).SharedWorkloadOverridesPaths))
+}
+
 // Summary mocks base method.
 func (m *MockwsWlDirReader) Summary() (*workspace.Summary, error) {
 	m.ctrl.T.Helper()
@@ -3406,6 +3493,20 @@ func (mr *MockwsWlDirReaderMockRecorder) Summary() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsWlDirReader)(nil).Summary))
 }
 
+// WorkloadDiffIgnorePath mocks base method.
+func (m *MockwsWlDirReader) WorkloadDiffIgnorePath(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadDiffIgnorePath", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// WorkloadDiffIgnorePath indicates an expected call of WorkloadDiffIgnorePath.
+func (mr *MockwsWlDirReaderMockRecorder) WorkloadDiffIgnorePath(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadDiffIgnorePath", reflect.TypeOf((*MockwsWlDirReader)(nil).WorkloadDiffIgnorePath), arg0)
+}
+
 // WorkloadOverridesPath mocks base method.
 func (m *MockwsWlDirReader) WorkloadOverridesPath(arg0 string) string {
 	m.ctrl.T.Helper()
@@ -3443,6 +3544,20 @@ func (m *MockwsEnvironmentReader) EXPECT() *MockwsEnvironmentReaderMockRecorder
 	return m.recorder
 }
 
+// DiffIgnorePath mocks base method.
+func (m *MockwsEnvironmentReader) DiffIgnorePath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffIgnorePath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// DiffIgnorePath indicates an expected call of DiffIgnorePath.
+func (mr *MockwsEnvironmentReaderMockRecorder) DiffIgnorePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffIgnorePath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).DiffIgnorePath))
+}
+
 // EnvAddonFileAbsPath mocks base method.
 func (m *MockwsEnvironmentReader) EnvAddonFileAbsPath(fName string) string {
 	m.ctrl.T.Helper()
@@ -3471,6 +3586,20 @@ func (mr *MockwsEnvironmentReaderMockRecorder) EnvAddonFilePath(fName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonFilePath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvAddonFilePath), fName)
 }
 
+// EnvDiffIgnorePath mocks base method.
+func (m *MockwsEnvironmentReader) EnvDiffIgnorePath(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvDiffIgnorePath", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EnvDiffIgnorePath indicates an expected call of EnvDiffIgnorePath.
+func (mr *MockwsEnvironmentReaderMockRecorder) EnvDiffIgnorePath(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvDiffIgnorePath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvDiffIgnorePath), arg0)
+}
+
 // EnvOverridesPath mocks base method.
 func (m *MockwsEnvironmentReader) EnvOverridesPath() string {
 	m.ctrl.T.Helper()
@@ -3530,6 +3659,21 @@ func (mr *MockwsEnvironmentReaderMockRecorder) ReadEnvironmentManifest(mftDirNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvironmentReader)(nil).ReadEnvironmentManifest), mftDirName)
 }
 
+// SharedEnvOverridesPaths mocks base method.
+func (m *MockwsEnvironmentReader) SharedEnvOverridesPaths() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SharedEnvOverridesPaths")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SharedEnvOverridesPaths indicates an expected call of SharedEnvOverridesPaths.
+func (mr *MockwsEnvironmentReaderMockRecorder) SharedEnvOverridesPaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SharedEnvOverridesPaths", reflect.TypeOf((*MockwsEnvironmentReader)(nil).SharedEnvOverridesPaths))
+}
+
 // MockwsPipelineReader is a mock of wsPipelineReader interface.
 type MockwsPipelineReader struct {
 	ctrl     *gomock.Controller
@@ -3612,6 +3756,21 @@ func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest(path interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest), path)
 }
 
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsPipelineReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsPipelineReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadWorkloadManifest), name)
+}
+
 // Rel mocks base method.
 func (m *MockwsPipelineReader) Rel(path string) (string, error) {
 	m.ctrl.T.Helper()
@@ -3627,6 +3786,229 @@ func (mr *MockwsPipelineReaderMockRecorder) Rel(path interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rel", reflect.TypeOf((*MockwsPipelineReader)(nil).Rel), path)
 }
 
+// MockwsPipelineStageReader is a mock of wsPipelineStageReader interface.
+type MockwsPipelineStageReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineStageReaderMockRecorder
+}
+
+// MockwsPipelineStageReaderMockRecorder is the mock recorder for MockwsPipelineStageReader.
+type MockwsPipelineStageReaderMockRecorder struct {
+	mock *MockwsPipelineStageReader
+}
+
+// NewMockwsPipelineStageReader creates a new mock instance.
+func NewMockwsPipelineStageReader(ctrl *gomock.Controller) *MockwsPipelineStageReader {
+	mock := &MockwsPipelineStageReader{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineStageReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPipelineStageReader) EXPECT() *MockwsPipelineStageReaderMockRecorder {
+	return m.recorder
+}
+
+// ListPipelines mocks base method.
+func (m *MockwsPipelineStageReader) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelineStageReaderMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineStageReader)(nil).ListPipelines))
+}
+
+// ProjectRoot mocks base method.
+func (m *MockwsPipelineStageReader) ProjectRoot() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectRoot")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ProjectRoot indicates an expected call of ProjectRoot.
+func (mr *MockwsPipelineStageReaderMockRecorder) ProjectRoot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectRoot", reflect.TypeOf((*MockwsPipelineStageReader)(nil).ProjectRoot))
+}
+
+// ReadPipelineManifest mocks base method.
+func (m *MockwsPipelineStageReader) ReadPipelineManifest(path string) (*manifest.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
+	ret0, _ := ret[0].(*manifest.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
+func (mr *MockwsPipelineStageReaderMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineStageReader)(nil).ReadPipelineManifest), path)
+}
+
+// MockwsManifestsReader is a mock of wsManifestsReader interface.
+type MockwsManifestsReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsManifestsReaderMockRecorder
+}
+
+// MockwsManifestsReaderMockRecorder is the mock recorder for MockwsManifestsReader.
+type MockwsManifestsReaderMockRecorder struct {
+	mock *MockwsManifestsReader
+}
+
+// NewMockwsManifestsReader creates a new mock instance.
+func NewMockwsManifestsReader(ctrl *gomock.Controller) *MockwsManifestsReader {
+	mock := &MockwsManifestsReader{ctrl: ctrl}
+	mock.recorder = &MockwsManifestsReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsManifestsReader) EXPECT() *MockwsManifestsReaderMockRecorder {
+	return m.recorder
+}
+
+// EnvManifestFileAbsPath mocks base method.
+func (m *MockwsManifestsReader) EnvManifestFileAbsPath(name string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvManifestFileAbsPath", name)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EnvManifestFileAbsPath indicates an expected call of EnvManifestFileAbsPath.
+func (mr *MockwsManifestsReaderMockRecorder) EnvManifestFileAbsPath(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvManifestFileAbsPath", reflect.TypeOf((*MockwsManifestsReader)(nil).EnvManifestFileAbsPath), name)
+}
+
+// ListEnvironments mocks base method.
+func (m *MockwsManifestsReader) ListEnvironments() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockwsManifestsReaderMockRecorder) ListEnvironments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockwsManifestsReader)(nil).ListEnvironments))
+}
+
+// ListPipelineManifestPaths mocks base method.
+func (m *MockwsManifestsReader) ListPipelineManifestPaths() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelineManifestPaths")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelineManifestPaths indicates an expected call of ListPipelineManifestPaths.
+func (mr *MockwsManifestsReaderMockRecorder) ListPipelineManifestPaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineManifestPaths", reflect.TypeOf((*MockwsManifestsReader)(nil).ListPipelineManifestPaths))
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsManifestsReader) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsManifestsReaderMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsManifestsReader)(nil).ListWorkloads))
+}
+
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsManifestsReader) ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", mftDirName)
+	ret0, _ := ret[0].(workspace.EnvironmentManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsManifestsReaderMockRecorder) ReadEnvironmentManifest(mftDirName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsManifestsReader)(nil).ReadEnvironmentManifest), mftDirName)
+}
+
+// ReadFile mocks base method.
+func (m *MockwsManifestsReader) ReadFile(path string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFile", path)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFile indicates an expected call of ReadFile.
+func (mr *MockwsManifestsReaderMockRecorder) ReadFile(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockwsManifestsReader)(nil).ReadFile), path)
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsManifestsReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsManifestsReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsManifestsReader)(nil).ReadWorkloadManifest), name)
+}
+
+// Rel mocks base method.
+func (m *MockwsManifestsReader) Rel(path string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rel", path)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rel indicates an expected call of Rel.
+func (mr *MockwsManifestsReaderMockRecorder) Rel(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rel", reflect.TypeOf((*MockwsManifestsReader)(nil).Rel), path)
+}
+
+// WorkloadManifestFileAbsPath mocks base method.
+func (m *MockwsManifestsReader) WorkloadManifestFileAbsPath(name string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadManifestFileAbsPath", name)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// WorkloadManifestFileAbsPath indicates an expected call of WorkloadManifestFileAbsPath.
+func (mr *MockwsManifestsReaderMockRecorder) WorkloadManifestFileAbsPath(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadManifestFileAbsPath", reflect.TypeOf((*MockwsManifestsReader)(nil).WorkloadManifestFileAbsPath), name)
+}
+
 // MockwsPipelineGetter is a mock of wsPipelineGetter interface.
 type MockwsPipelineGetter struct {
 	ctrl     *gomock.Controller
@@ -5435,6 +5817,44 @@ func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
 }
 
+// MockpipelineExecutionStarter is a mock of pipelineExecutionStarter interface.
+type MockpipelineExecutionStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineExecutionStarterMockRecorder
+}
+
+// MockpipelineExecutionStarterMockRecorder is the mock recorder for MockpipelineExecutionStarter.
+type MockpipelineExecutionStarterMockRecorder struct {
+	mock *MockpipelineExecutionStarter
+}
+
+// NewMockpipelineExecutionStarter creates a new mock instance.
+func NewMockpipelineExecutionStarter(ctrl *gomock.Controller) *MockpipelineExecutionStarter {
+	mock := &MockpipelineExecutionStarter{ctrl: ctrl}
+	mock.recorder = &MockpipelineExecutionStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineExecutionStarter) EXPECT() *MockpipelineExecutionStarterMockRecorder {
+	return m.recorder
+}
+
+// StartExecution mocks base method.
+func (m *MockpipelineExecutionStarter) StartExecution(pipelineName, commitID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartExecution", pipelineName, commitID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartExecution indicates an expected call of StartExecution.
+func (mr *MockpipelineExecutionStarterMockRecorder) StartExecution(pipelineName, commitID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartExecution", reflect.TypeOf((*MockpipelineExecutionStarter)(nil).StartExecution), pipelineName, commitID)
+}
+
 // MockdeployedPipelineLister is a mock of deployedPipelineLister interface.
 type MockdeployedPipelineLister struct {
 	ctrl     *gomock.Controller
@@ -5813,6 +6233,59 @@ func (mr *MockconfigSelectorMockRecorder) Workload(prompt, help, app interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockconfigSelector)(nil).Workload), prompt, help, app)
 }
 
+// MockstackDriftDetector is a mock of stackDriftDetector interface.
+type MockstackDriftDetector struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDriftDetectorMockRecorder
+}
+
+// MockstackDriftDetectorMockRecorder is the mock recorder for MockstackDriftDetector.
+type MockstackDriftDetectorMockRecorder struct {
+	mock *MockstackDriftDetector
+}
+
+// NewMockstackDriftDetector creates a new mock instance.
+func NewMockstackDriftDetector(ctrl *gomock.Controller) *MockstackDriftDetector {
+	mock := &MockstackDriftDetector{ctrl: ctrl}
+	mock.recorder = &MockstackDriftDetectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDriftDetector) EXPECT() *MockstackDriftDetectorMockRecorder {
+	return m.recorder
+}
+
+// DetectDrift mocks base method.
+func (m *MockstackDriftDetector) DetectDrift(ctx context.Context, stackName string) ([]*cloudformation0.StackResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", ctx, stackName)
+	ret0, _ := ret[0].([]*cloudformation0.StackResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockstackDriftDetectorMockRecorder) DetectDrift(ctx, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockstackDriftDetector)(nil).DetectDrift), ctx, stackName)
+}
+
+// StackResources mocks base method.
+func (m *MockstackDriftDetector) StackResources(name string) ([]*cloudformation0.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources", name)
+	ret0, _ := ret[0].([]*cloudformation0.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockstackDriftDetectorMockRecorder) StackResources(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackDriftDetector)(nil).StackResources), name)
+}
+
 // MockdeploySelector is a mock of deploySelector interface.
 type MockdeploySelector struct {
 	ctrl     *gomock.Controller
@@ -7317,6 +7790,44 @@ func (mr *MockcodestarMockRecorder) GetConnectionARN(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionARN", reflect.TypeOf((*Mockcodestar)(nil).GetConnectionARN), arg0)
 }
 
+// MockcodestarHostGetter is a mock of codestarHostGetter interface.
+type MockcodestarHostGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockcodestarHostGetterMockRecorder
+}
+
+// MockcodestarHostGetterMockRecorder is the mock recorder for MockcodestarHostGetter.
+type MockcodestarHostGetterMockRecorder struct {
+	mock *MockcodestarHostGetter
+}
+
+// NewMockcodestarHostGetter creates a new mock instance.
+func NewMockcodestarHostGetter(ctrl *gomock.Controller) *MockcodestarHostGetter {
+	mock := &MockcodestarHostGetter{ctrl: ctrl}
+	mock.recorder = &MockcodestarHostGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcodestarHostGetter) EXPECT() *MockcodestarHostGetterMockRecorder {
+	return m.recorder
+}
+
+// HostProviderType mocks base method.
+func (m *MockcodestarHostGetter) HostProviderType(hostARN string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostProviderType", hostARN)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HostProviderType indicates an expected call of HostProviderType.
+func (mr *MockcodestarHostGetterMockRecorder) HostProviderType(hostARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostProviderType", reflect.TypeOf((*MockcodestarHostGetter)(nil).HostProviderType), hostARN)
+}
+
 // MockpublicIPGetter is a mock of publicIPGetter interface.
 type MockpublicIPGetter struct {
 	ctrl     *gomock.Controller
@@ -7530,18 +8041,23 @@ func (m *MockworkloadDeployer) EXPECT() *MockworkloadDeployerMockRecorder {
 }
 
 // DeployDiff mocks base method.
-func (m *MockworkloadDeployer) DeployDiff(inTmpl string) (string, error) {
+func (m *MockworkloadDeployer) DeployDiff(inTmpl string, opts ...diff.WriteOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	varargs := []interface{}{inTmpl}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployDiff", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockworkloadDeployerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+func (mr *MockworkloadDeployerMockRecorder) DeployDiff(inTmpl interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployDiff), inTmpl)
+	varargs := append([]interface{}{inTmpl}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployDiff), varargs...)
 }
 
 // DeployWorkload mocks base method.
@@ -7628,18 +8144,23 @@ func (m *MocktemplateDiffer) EXPECT() *MocktemplateDifferMockRecorder {
 }
 
 // DeployDiff mocks base method.
-func (m *MocktemplateDiffer) DeployDiff(inTmpl string) (string, error) {
+func (m *MocktemplateDiffer) DeployDiff(inTmpl string, opts ...diff.WriteOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	varargs := []interface{}{inTmpl}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployDiff", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeployDiff indicates an expected call of DeployDiff.
-func (mr *MocktemplateDifferMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+func (mr *MocktemplateDifferMockRecorder) DeployDiff(inTmpl interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MocktemplateDiffer)(nil).DeployDiff), inTmpl)
+	varargs := append([]interface{}{inTmpl}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MocktemplateDiffer)(nil).DeployDiff), varargs...)
 }
 
 // MockdockerEngineRunner is a mock of dockerEngineRunner interface.
@@ -7708,6 +8229,20 @@ func (mr *MockdockerEngineRunnerMockRecorder) ContainerExitCode(ctx, containerNa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerExitCode", reflect.TypeOf((*MockdockerEngineRunner)(nil).ContainerExitCode), ctx, containerName)
 }
 
+// CreateNetwork mocks base method.
+func (m *MockdockerEngineRunner) CreateNetwork(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MockdockerEngineRunnerMockRecorder) CreateNetwork(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockdockerEngineRunner)(nil).CreateNetwork), ctx, name)
+}
+
 // Exec mocks base method.
 func (m *MockdockerEngineRunner) Exec(ctx context.Context, container string, out io.Writer, cmd string, args ...string) error {
 	m.ctrl.T.Helper()
@@ -7757,6 +8292,20 @@ func (mr *MockdockerEngineRunnerMockRecorder) IsContainerRunning(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsContainerRunning", reflect.TypeOf((*MockdockerEngineRunner)(nil).IsContainerRunning), arg0, arg1)
 }
 
+// RemoveNetwork mocks base method.
+func (m *MockdockerEngineRunner) RemoveNetwork(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetwork", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNetwork indicates an expected call of RemoveNetwork.
+func (mr *MockdockerEngineRunnerMockRecorder) RemoveNetwork(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetwork", reflect.TypeOf((*MockdockerEngineRunner)(nil).RemoveNetwork), ctx, name)
+}
+
 // Rm mocks base method.
 func (m *MockdockerEngineRunner) Rm(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -7799,6 +8348,58 @@ func (mr *MockdockerEngineRunnerMockRecorder) Stop(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockdockerEngineRunner)(nil).Stop), arg0, arg1)
 }
 
+// MocklocalQueueClient is a mock of localQueueClient interface.
+type MocklocalQueueClient struct {
+	ctrl     *gomock.Controller
+	recorder *MocklocalQueueClientMockRecorder
+}
+
+// MocklocalQueueClientMockRecorder is the mock recorder for MocklocalQueueClient.
+type MocklocalQueueClientMockRecorder struct {
+	mock *MocklocalQueueClient
+}
+
+// NewMocklocalQueueClient creates a new mock instance.
+func NewMocklocalQueueClient(ctrl *gomock.Controller) *MocklocalQueueClient {
+	mock := &MocklocalQueueClient{ctrl: ctrl}
+	mock.recorder = &MocklocalQueueClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklocalQueueClient) EXPECT() *MocklocalQueueClientMockRecorder {
+	return m.recorder
+}
+
+// CreateQueue mocks base method.
+func (m *MocklocalQueueClient) CreateQueue(ctx context.Context, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQueue", ctx, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQueue indicates an expected call of CreateQueue.
+func (mr *MocklocalQueueClientMockRecorder) CreateQueue(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQueue", reflect.TypeOf((*MocklocalQueueClient)(nil).CreateQueue), ctx, name)
+}
+
+// SendMessage mocks base method.
+func (m *MocklocalQueueClient) SendMessage(ctx context.Context, queueURL, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMessage", ctx, queueURL, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMessage indicates an expected call of SendMessage.
+func (mr *MocklocalQueueClientMockRecorder) SendMessage(ctx, queueURL, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MocklocalQueueClient)(nil).SendMessage), ctx, queueURL, body)
+}
+
 // MockworkloadStackGenerator is a mock of workloadStackGenerator interface.
 type MockworkloadStackGenerator struct {
 	ctrl     *gomock.Controller
@@ -7838,18 +8439,23 @@ func (mr *MockworkloadStackGeneratorMockRecorder) AddonsTemplate() *gomock.Call
 }
 
 // DeployDiff mocks base method.
-func (m *MockworkloadStackGenerator) DeployDiff(inTmpl string) (string, error) {
+func (m *MockworkloadStackGenerator) DeployDiff(inTmpl string, opts ...diff.WriteOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	varargs := []interface{}{inTmpl}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployDiff", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockworkloadStackGeneratorMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+func (mr *MockworkloadStackGeneratorMockRecorder) DeployDiff(inTmpl interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadStackGenerator)(nil).DeployDiff), inTmpl)
+	varargs := append([]interface{}{inTmpl}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadStackGenerator)(nil).DeployDiff), varargs...)
 }
 
 // GenerateCloudFormationTemplate mocks base method.
@@ -7943,18 +8549,23 @@ func (m *MockenvDeployer) EXPECT() *MockenvDeployerMockRecorder {
 }
 
 // DeployDiff mocks base method.
-func (m *MockenvDeployer) DeployDiff(inTmpl string) (string, error) {
+func (m *MockenvDeployer) DeployDiff(inTmpl string, opts ...diff.WriteOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	varargs := []interface{}{inTmpl}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployDiff", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockenvDeployerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+func (mr *MockenvDeployerMockRecorder) DeployDiff(inTmpl interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvDeployer)(nil).DeployDiff), inTmpl)
+	varargs := append([]interface{}{inTmpl}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvDeployer)(nil).DeployDiff), varargs...)
 }
 
 // DeployEnvironment mocks base method.
@@ -8054,18 +8665,23 @@ func (mr *MockenvPackagerMockRecorder) AddonsTemplate() *gomock.Call {
 }
 
 // DeployDiff mocks base method.
-func (m *MockenvPackager) DeployDiff(inTmpl string) (string, error) {
+func (m *MockenvPackager) DeployDiff(inTmpl string, opts ...diff.WriteOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	varargs := []interface{}{inTmpl}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployDiff", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockenvPackagerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+func (mr *MockenvPackagerMockRecorder) DeployDiff(inTmpl interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvPackager)(nil).DeployDiff), inTmpl)
+	varargs := append([]interface{}{inTmpl}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvPackager)(nil).DeployDiff), varargs...)
 }
 
 // GenerateCloudFormationTemplate mocks base method.
@@ -8246,6 +8862,44 @@ func (mr *MocksecretGetterMockRecorder) GetSecretValue(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MocksecretGetter)(nil).GetSecretValue), arg0, arg1)
 }
 
+// MockssmParameterGetter is a mock of ssmParameterGetter interface.
+type MockssmParameterGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmParameterGetterMockRecorder
+}
+
+// MockssmParameterGetterMockRecorder is the mock recorder for MockssmParameterGetter.
+type MockssmParameterGetterMockRecorder struct {
+	mock *MockssmParameterGetter
+}
+
+// NewMockssmParameterGetter creates a new mock instance.
+func NewMockssmParameterGetter(ctrl *gomock.Controller) *MockssmParameterGetter {
+	mock := &MockssmParameterGetter{ctrl: ctrl}
+	mock.recorder = &MockssmParameterGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmParameterGetter) EXPECT() *MockssmParameterGetterMockRecorder {
+	return m.recorder
+}
+
+// GetParameterValue mocks base method.
+func (m *MockssmParameterGetter) GetParameterValue(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParameterValue", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParameterValue indicates an expected call of GetParameterValue.
+func (mr *MockssmParameterGetterMockRecorder) GetParameterValue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameterValue", reflect.TypeOf((*MockssmParameterGetter)(nil).GetParameterValue), arg0, arg1)
+}
+
 // MockdockerWorkload is a mock of dockerWorkload interface.
 type MockdockerWorkload struct {
 	ctrl     *gomock.Controller
@@ -23,7 +23,7 @@ const (
 	yamlPatch  = "yamlpatch"
 
 	// IaC toolkit configuration.
-	typescriptCDKLang = "typescript"
+	typescriptCDKLang = override.CDKLanguageTypeScript
 )
 
 var validIaCTools = []string{
@@ -31,9 +31,7 @@ var validIaCTools = []string{
 	yamlPatch,
 }
 
-var validCDKLangs = []string{
-	typescriptCDKLang,
-}
+var validCDKLangs = override.CDKLanguages
 
 type stringWriteCloser interface {
 	fmt.Stringer
@@ -100,7 +98,7 @@ func (o *overrideOpts) Execute() error {
 	dir := o.dir()
 	switch o.iacTool {
 	case cdkIaCTool:
-		if err := override.ScaffoldWithCDK(o.fs, dir, o.resources, o.requiresEnv); err != nil {
+		if err := override.ScaffoldWithCDK(o.fs, dir, o.cdkLang, o.resources, o.requiresEnv); err != nil {
 			return fmt.Errorf("scaffold CDK application under %q: %v", dir, err)
 		}
 		log.Successf("Created a new CDK application at %q to override resources\n", displayPath(dir))
@@ -25,6 +25,8 @@ Continuous delivery pipelines to release services.`,
 	cmd.AddCommand(buildPipelineDeleteCmd())
 	cmd.AddCommand(buildPipelineShowCmd())
 	cmd.AddCommand(buildPipelineStatusCmd())
+	cmd.AddCommand(buildPipelineRunCmd())
+	cmd.AddCommand(buildPipelineTestStageCmd())
 	cmd.AddCommand(buildPipelineListCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
@@ -11,6 +11,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -31,6 +33,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -71,6 +74,7 @@ type deployPipelineVars struct {
 	name             string
 	skipConfirmation bool
 	showDiff         bool
+	diffJSON         bool
 	allowDowngrade   bool
 }
 
@@ -199,7 +203,7 @@ func newDeployPipelineOpts(vars deployPipelineVars) (*deployPipelineOpts, error)
 
 // Validate returns an error if the optional flag values passed by the user are invalid.
 func (o *deployPipelineOpts) Validate() error {
-	return nil
+	return validateDiffJSON(o.showDiff, o.diffJSON)
 }
 
 // Ask prompts the user for any unprovided required fields and validates them.
@@ -339,7 +343,7 @@ func (o *deployPipelineOpts) Execute() error {
 		if err != nil {
 			return fmt.Errorf("generate the new template for diff: %w", err)
 		}
-		if err = diff(o, tpl, o.diffWriter); err != nil {
+		if err = diff(o, tpl, o.diffWriter, o.diffJSON); err != nil {
 			var errHasDiff *errHasDiff
 			if !errors.As(err, &errHasDiff) {
 				return err
@@ -371,8 +375,9 @@ func (o *deployPipelineOpts) Execute() error {
 	return nil
 }
 
-// DeployDiff returns the stringified diff of the template against the deployed template of the pipeline.
-func (o *deployPipelineOpts) DeployDiff(template string) (string, error) {
+// DeployDiff returns the stringified diff of the template against the deployed template of the pipeline,
+// written with the given options.
+func (o *deployPipelineOpts) DeployDiff(template string, opts ...templatediff.WriteOption) (string, error) {
 	isLegacy, err := o.isLegacy(o.pipeline.Name)
 	if err != nil {
 		return "", err
@@ -391,7 +396,7 @@ func (o *deployPipelineOpts) DeployDiff(template string) (string, error) {
 		return "", fmt.Errorf("parse the diff against the deployed pipeline stack %q: %w", o.pipeline.Name, err)
 	}
 	buf := strings.Builder{}
-	if err := diffTree.Write(&buf); err != nil {
+	if err := diffTree.Write(&buf, opts...); err != nil {
 		return "", err
 	}
 	return buf.String(), nil
@@ -476,11 +481,87 @@ func (o *deployPipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 
 		var stg deploy.PipelineStage
 		stg.Init(env, &stage, workloads)
+		if stage.Bake != nil {
+			alarms, err := stageBakeAlarms(&stg, o.ws)
+			if err != nil {
+				return nil, err
+			}
+			stg.SetBakeAlarms(alarms)
+		}
 		stages = append(stages, stg)
 	}
 	return stages, nil
 }
 
+// stageBakeAlarms returns the names of the rollback alarms of the services deployed in a stage,
+// as configured in their manifests for the environment of the stage.
+func stageBakeAlarms(stg *deploy.PipelineStage, ws manifestReader) ([]string, error) {
+	deployments, err := stg.Deployments()
+	if err != nil {
+		return nil, fmt.Errorf("get deployments of stage %s: %w", stg.Name(), err)
+	}
+	var alarms []string
+	for _, deployment := range deployments {
+		names, err := workloadRollbackAlarms(ws, stg.AppName, stg.Name(), deployment.WorkloadName())
+		if err != nil {
+			return nil, fmt.Errorf("get rollback alarms of stage %s: %w", stg.Name(), err)
+		}
+		for _, name := range names {
+			if !slices.Contains(alarms, name) {
+				alarms = append(alarms, name)
+			}
+		}
+	}
+	if len(alarms) == 0 {
+		return nil, fmt.Errorf(`stage %s bakes but none of its services has rollback alarms: add "deployment.rollback_alarms" to the manifest of a service or remove "bake" from the stage`, stg.Name())
+	}
+	sort.Strings(alarms)
+	return alarms, nil
+}
+
+// workloadRollbackAlarms returns the names of the alarms that roll back the deployments of a workload to the environment.
+func workloadRollbackAlarms(ws manifestReader, app, env, name string) ([]string, error) {
+	raw, err := ws.ReadWorkloadManifest(name)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest file for %s: %w", name, err)
+	}
+	interpolated, err := manifest.NewInterpolator(app, env).Interpolate(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", name, err)
+	}
+	mft, err := manifest.UnmarshalWorkload([]byte(interpolated))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for %s: %w", name, err)
+	}
+	envMft, err := mft.ApplyEnv(env)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override to %s manifest: %w", env, name, err)
+	}
+	var rollback template.RollingUpdateRollbackConfig
+	switch mft := envMft.Manifest().(type) {
+	case *manifest.LoadBalancedWebService:
+		rollback = template.RollingUpdateRollbackConfig{
+			AlarmNames:        mft.DeployConfig.RollbackAlarms.Basic,
+			CPUUtilization:    mft.DeployConfig.RollbackAlarms.Advanced.CPUUtilization,
+			MemoryUtilization: mft.DeployConfig.RollbackAlarms.Advanced.MemoryUtilization,
+		}
+	case *manifest.BackendService:
+		rollback = template.RollingUpdateRollbackConfig{
+			AlarmNames:        mft.DeployConfig.RollbackAlarms.Basic,
+			CPUUtilization:    mft.DeployConfig.RollbackAlarms.Advanced.CPUUtilization,
+			MemoryUtilization: mft.DeployConfig.RollbackAlarms.Advanced.MemoryUtilization,
+		}
+	case *manifest.WorkerService:
+		rollback = template.RollingUpdateRollbackConfig{
+			AlarmNames:        mft.DeployConfig.WorkerRollbackAlarms.Basic,
+			CPUUtilization:    mft.DeployConfig.WorkerRollbackAlarms.Advanced.CPUUtilization,
+			MemoryUtilization: mft.DeployConfig.WorkerRollbackAlarms.Advanced.MemoryUtilization,
+			MessagesDelayed:   mft.DeployConfig.WorkerRollbackAlarms.Advanced.MessagesDelayed,
+		}
+	}
+	return rollback.RollbackAlarmNames(app, env, name), nil
+}
+
 func (o deployPipelineOpts) getLocalWorkloads() ([]string, error) {
 	var localWklds []string
 	if err := o.newSvcListCmd(o.svcBuffer, o.appName).Execute(); err != nil {
@@ -636,6 +717,7 @@ func buildPipelineDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.diffJSON, jsonFlag, false, diffJSONFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 	return cmd
 }
@@ -1033,3 +1033,113 @@ func TestDeployPipelineOpts_getArtifactBuckets(t *testing.T) {
 		})
 	}
 }
+
+func TestStageBakeAlarms(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inDeployments  manifest.Deployments
+		inWorkloads    []string
+		setupMocks     func(m *mocks.MockmanifestReader)
+		expectedAlarms []string
+		expectedError  error
+	}{
+		"wraps error when fails to read the manifest of a service": {
+			inWorkloads: []string{"api"},
+			setupMocks: func(m *mocks.MockmanifestReader) {
+				m.EXPECT().ReadWorkloadManifest("api").Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("get rollback alarms of stage test: read manifest file for api: %w", mockError),
+		},
+		"error when none of the services of the stage has rollback alarms": {
+			inWorkloads: []string{"api"},
+			setupMocks: func(m *mocks.MockmanifestReader) {
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte(`name: api
+type: Backend Service
+image:
+  location: api:latest
+environments:
+  prod:
+    deployment:
+      rollback_alarms:
+        cpu_utilization: 70
+`), nil)
+			},
+			expectedError: errors.New(`stage test bakes but none of its services has rollback alarms: add "deployment.rollback_alarms" to the manifest of a service or remove "bake" from the stage`),
+		},
+		"returns the rollback alarms of the deployments of the stage": {
+			inDeployments: manifest.Deployments{
+				"api":      nil,
+				"frontend": nil,
+				"worker":   nil,
+			},
+			inWorkloads: []string{"api", "frontend", "worker", "jobs"},
+			setupMocks: func(m *mocks.MockmanifestReader) {
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte(`name: api
+type: Backend Service
+image:
+  location: api:latest
+deployment:
+  rollback_alarms: ["shared-5xx"]
+environments:
+  test:
+    deployment:
+      rollback_alarms:
+        cpu_utilization: 70
+        memory_utilization: 80
+`), nil)
+				m.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(`name: frontend
+type: Load Balanced Web Service
+image:
+  build: Dockerfile
+  port: 80
+http:
+  path: '/'
+deployment:
+  rollback_alarms: ["shared-5xx", "${COPILOT_ENVIRONMENT_NAME}-latency"]
+`), nil)
+				m.EXPECT().ReadWorkloadManifest("worker").Return([]byte(`name: worker
+type: Worker Service
+image:
+  location: worker:latest
+deployment:
+  rollback_alarms:
+    messages_delayed: 5
+`), nil)
+			},
+			expectedAlarms: []string{
+				"phonetool-test-api-CopilotRollbackCPUAlarm",
+				"phonetool-test-api-CopilotRollbackMemAlarm",
+				"phonetool-test-worker-CopilotRollbackMsgsDelayedAlarm",
+				"shared-5xx",
+				"test-latency",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockmanifestReader(ctrl)
+			tc.setupMocks(mockWs)
+
+			var stg deploy.PipelineStage
+			stg.Init(&config.Environment{App: "phonetool", Name: "test"}, &manifest.PipelineStage{
+				Name:        "test",
+				Deployments: tc.inDeployments,
+			}, tc.inWorkloads)
+
+			// WHEN
+			alarms, err := stageBakeAlarms(&stg, mockWs)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedAlarms, alarms)
+			}
+		})
+	}
+}
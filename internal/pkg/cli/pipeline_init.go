@@ -26,6 +26,8 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	cs "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -77,6 +79,11 @@ const (
 	// For a Bitbucket repository.
 	bbURL        = "bitbucket.org"
 	fmtBBRepoURL = "https://%s/%s/%s" // Ex: "https://bitbucket.org/repoOwner/repoName"
+	// For a GitLab repository.
+	glURL        = "gitlab.com"
+	fmtGLRepoURL = "https://%s/%s/%s" // Ex: "https://gitlab.com/repoOwner/subgroup/repoName"
+	// For a GitHub Enterprise Server or GitLab self-managed repository.
+	fmtSelfHostedRepoURL = "https://%s/%s/%s" // Ex: "https://git.example.com/repoOwner/repoName"
 )
 
 const (
@@ -97,7 +104,7 @@ var (
 
 // Pipeline init errors.
 var (
-	fmtErrInvalidPipelineProvider = "repository %s must be from a supported provider: %s"
+	fmtErrInvalidPipelineProvider = "repository %s must be from a supported provider: %s, or from a self-hosted provider with --%s"
 )
 
 type pipelineInitializer interface {
@@ -181,6 +188,7 @@ type initPipelineVars struct {
 	repoBranch        string
 	githubAccessToken string
 	pipelineType      string
+	hostARN           string // ARN of the CodeStar Connections host of a self-hosted repository.
 }
 
 type initPipelineOpts struct {
@@ -196,12 +204,14 @@ type initPipelineOpts struct {
 	prompt         prompter
 	sel            pipelineEnvSelector
 	pipelineLister deployedPipelineLister
+	codestar       codestarHostGetter
 
 	// Outputs stored on successful actions.
 	secret    string
 	provider  string
 	repoName  string
 	repoOwner string
+	repoHost  string
 	ccRegion  string
 
 	// Cached variables
@@ -250,11 +260,15 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 		runner:           exec.NewCmd(),
 		wsAppName:        wsAppName,
 		pipelineLister:   deploy.NewPipelineStore(rg.New(defaultSession)),
+		codestar:         cs.New(defaultSession),
 	}, nil
 }
 
 // Validate returns an error if the optional flag values passed by the user are invalid.
 func (o *initPipelineOpts) Validate() error {
+	if o.hostARN != "" && !arn.IsARN(o.hostARN) {
+		return fmt.Errorf("host %q must be the ARN of a CodeStar Connections host", o.hostARN)
+	}
 	return nil
 }
 
@@ -439,12 +453,37 @@ func (o *initPipelineOpts) askOrValidatePipelineType() error {
 func (o *initPipelineOpts) validateURL(url string) error {
 	// Note: no longer calling `validateDomainName` because if users use git-remote-codecommit
 	// (the HTTPS (GRC) protocol) to connect to CodeCommit, the url does not have any periods.
-	if !strings.Contains(url, githubURL) && !strings.Contains(url, ccIdentifier) && !strings.Contains(url, bbURL) {
-		return fmt.Errorf(fmtErrInvalidPipelineProvider, url, english.WordSeries(manifest.PipelineProviders, "or"))
+	if !o.isSupportedURL(url) {
+		return fmt.Errorf(fmtErrInvalidPipelineProvider, url, english.WordSeries(manifest.PipelineProviders, "or"), hostARNFlag)
 	}
 	return nil
 }
 
+// isSupportedURL returns true if the URL is from a supported provider.
+// Any URL is supported for self-hosted repositories since they can be on any domain.
+func (o *initPipelineOpts) isSupportedURL(url string) bool {
+	if o.hostARN != "" {
+		return true
+	}
+	for _, identifier := range []string{githubURL, ccIdentifier, bbURL} {
+		if strings.Contains(url, identifier) {
+			return true
+		}
+	}
+	return isGitLabURL(url)
+}
+
+// isGitLabURL returns true if the URL is hosted on gitlab.com.
+// The host is compared exactly so that self-managed instances such as "gitlab.example.com" aren't mistaken for GitLab.
+func isGitLabURL(url string) bool {
+	details, err := gitRepoURL(url).parse()
+	if err != nil {
+		// Let the caller surface the parsing error.
+		return strings.Contains(url, glURL)
+	}
+	return details.host == glURL
+}
+
 // To avoid duplicating calls to GetEnvironment, validate and get config in the same step.
 func (o *initPipelineOpts) validateEnvs() error {
 	var envConfigs []*config.Environment
@@ -473,6 +512,10 @@ func (o *initPipelineOpts) askEnvs() error {
 
 func (o *initPipelineOpts) parseRepoDetails() error {
 	switch {
+	case o.hostARN != "":
+		return o.parseSelfHostedRepoDetails()
+	case isGitLabURL(o.repoURL):
+		return o.parseGitLabRepoDetails()
 	case strings.Contains(o.repoURL, githubURL):
 		return o.parseGitHubRepoDetails()
 	case strings.Contains(o.repoURL, ccIdentifier):
@@ -480,7 +523,7 @@ func (o *initPipelineOpts) parseRepoDetails() error {
 	case strings.Contains(o.repoURL, bbURL):
 		return o.parseBitbucketRepoDetails()
 	default:
-		return fmt.Errorf(fmtErrInvalidPipelineProvider, o.repoURL, english.WordSeries(manifest.PipelineProviders, "or"))
+		return fmt.Errorf(fmtErrInvalidPipelineProvider, o.repoURL, english.WordSeries(manifest.PipelineProviders, "or"), hostARNFlag)
 	}
 }
 
@@ -555,6 +598,42 @@ func (o *initPipelineOpts) parseBitbucketRepoDetails() error {
 	return nil
 }
 
+func (o *initPipelineOpts) parseGitLabRepoDetails() error {
+	o.provider = manifest.GitLabProviderName
+	repoDetails, err := gitRepoURL(o.repoURL).parse()
+	if err != nil {
+		return err
+	}
+	o.repoName = repoDetails.name
+	o.repoOwner = repoDetails.owner
+
+	return nil
+}
+
+func (o *initPipelineOpts) parseSelfHostedRepoDetails() error {
+	// The provider is installed on the host, so its type is read from the host rather than guessed from the URL.
+	providerType, err := o.codestar.HostProviderType(o.hostARN)
+	if err != nil {
+		return err
+	}
+	switch providerType {
+	case manifest.GitHubEnterpriseServerProviderName, manifest.GitLabSelfManagedProviderName:
+		o.provider = providerType
+	default:
+		return fmt.Errorf("host %s has provider type %q: must be one of %s or %s", o.hostARN, providerType,
+			manifest.GitHubEnterpriseServerProviderName, manifest.GitLabSelfManagedProviderName)
+	}
+	repoDetails, err := gitRepoURL(o.repoURL).parse()
+	if err != nil {
+		return err
+	}
+	o.repoName = repoDetails.name
+	o.repoOwner = repoDetails.owner
+	o.repoHost = repoDetails.host
+
+	return nil
+}
+
 func (o *initPipelineOpts) selectURL() error {
 	// Fetches and parses all remote repositories.
 	err := o.runner.Run("git", []string{"remote", "-v"}, exec.Stdout(&o.buffer))
@@ -601,6 +680,7 @@ func (o *initPipelineOpts) selectURL() error {
 // ssh		ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (push)
 // bbhttps	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (fetch)
 // bbssh	ssh://git@bitbucket.org:teamsinspace/documentation-tests.git (fetch)
+// gl		git@gitlab.com:teamsinspace/platform/documentation-tests.git (fetch)
 
 // parseGitRemoteResults returns just the trimmed middle column (url) of the `git remote -v` results,
 // and skips urls from unsupported sources.
@@ -609,7 +689,7 @@ func (o *initPipelineOpts) parseGitRemoteResult(s string) ([]string, error) {
 	urlSet := make(map[string]bool)
 	items := strings.Split(s, "\n")
 	for _, item := range items {
-		if !strings.Contains(item, githubURL) && !strings.Contains(item, ccIdentifier) && !strings.Contains(item, bbURL) {
+		if !o.isSupportedURL(item) {
 			continue
 		}
 		cols := strings.Split(item, "\t")
@@ -639,6 +719,13 @@ type bbRepoDetails struct {
 	owner string
 }
 
+type gitRepoURL string
+type gitRepoDetails struct {
+	host  string
+	name  string
+	owner string
+}
+
 func (url ghRepoURL) parse() (ghRepoDetails, error) {
 	urlString := string(url)
 	regexPattern := regexp.MustCompile(`.*(github.com)(:|\/)`)
@@ -711,6 +798,60 @@ func (url bbRepoURL) parse() (bbRepoDetails, error) {
 	}, nil
 }
 
+// GitLab and self-hosted URLs, post-parseGitRemoteResults(), may look like:
+// https://gitlab.com/teamsinspace/platform/documentation-tests
+// https://username@git.example.com/teamsinspace/documentation-tests
+// git@git.example.com:teamsinspace/documentation-tests
+// ssh://git@git.example.com:2222/teamsinspace/documentation-tests
+// The owner of GitLab repositories contains their subgroups, if any.
+func (url gitRepoURL) parse() (gitRepoDetails, error) {
+	urlString := string(url)
+	if _, rest, ok := strings.Cut(urlString, "://"); ok {
+		urlString = rest
+	}
+	if user, rest, ok := strings.Cut(urlString, "@"); ok && !strings.ContainsAny(user, "/:") {
+		urlString = rest
+	}
+	sep := strings.IndexAny(urlString, "/:")
+	if sep == -1 {
+		return gitRepoDetails{}, fmt.Errorf("unable to parse the repository owner and name from %s", url)
+	}
+	host, path := urlString[:sep], urlString[sep+1:]
+	if port, rest, ok := strings.Cut(path, "/"); ok && urlString[sep] == ':' && isNumeric(port) {
+		path = rest
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	owner, name, ok := cutLast(path, "/")
+	if !ok || owner == "" || name == "" {
+		return gitRepoDetails{}, fmt.Errorf("unable to parse the repository owner and name from %s", url)
+	}
+	return gitRepoDetails{
+		host:  host,
+		name:  name,
+		owner: owner,
+	}, nil
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	i := strings.LastIndex(s, sep)
+	if i == -1 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 func (o *initPipelineOpts) storeGitHubAccessToken() error {
 	secretName := o.secretName()
 	_, err := o.secretsmanager.CreateSecret(secretName, o.githubAccessToken)
@@ -837,6 +978,23 @@ func (o *initPipelineOpts) pipelineProvider() (manifest.Provider, error) {
 			RepositoryURL: fmt.Sprintf(fmtBBRepoURL, bbURL, o.repoOwner, o.repoName),
 			Branch:        o.repoBranch,
 		}
+	case manifest.GitLabProviderName:
+		config = &manifest.GitLabProperties{
+			RepositoryURL: fmt.Sprintf(fmtGLRepoURL, glURL, o.repoOwner, o.repoName),
+			Branch:        o.repoBranch,
+		}
+	case manifest.GitHubEnterpriseServerProviderName:
+		config = &manifest.GitHubEnterpriseServerProperties{
+			RepositoryURL: fmt.Sprintf(fmtSelfHostedRepoURL, o.repoHost, o.repoOwner, o.repoName),
+			Branch:        o.repoBranch,
+			HostARN:       o.hostARN,
+		}
+	case manifest.GitLabSelfManagedProviderName:
+		config = &manifest.GitLabSelfManagedProperties{
+			RepositoryURL: fmt.Sprintf(fmtSelfHostedRepoURL, o.repoHost, o.repoOwner, o.repoName),
+			Branch:        o.repoBranch,
+			HostARN:       o.hostARN,
+		}
 	default:
 		return nil, fmt.Errorf("unable to create pipeline source provider for %s", o.repoName)
 	}
@@ -884,7 +1042,11 @@ func buildPipelineInitCmd() *cobra.Command {
   /code  --name frontend-main \
   /code  --url https://github.com/gitHubUserName/frontend.git \
   /code  --git-branch main \
-  /code  --environments "stage,prod"`,
+  /code  --environments "stage,prod"
+  Create a pipeline for a repository on GitHub Enterprise Server or GitLab self-managed.
+  /code $ copilot pipeline init \
+  /code  --url https://git.example.com/teamName/frontend.git \
+  /code  --host-arn arn:aws:codestar-connections:us-west-2:123456789012:host/my-host-1a2b3c`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitPipelineOpts(vars)
 			if err != nil {
@@ -917,5 +1079,6 @@ func buildPipelineInitCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.repoBranch, gitBranchFlag, gitBranchFlagShort, "", gitBranchFlagDescription)
 	cmd.Flags().StringSliceVarP(&vars.environments, envsFlag, envsFlagShort, []string{}, pipelineEnvsFlagDescription)
 	cmd.Flags().StringVarP(&vars.pipelineType, pipelineTypeFlag, pipelineTypeShort, "", pipelineTypeFlagDescription)
+	cmd.Flags().StringVar(&vars.hostARN, hostARNFlag, "", hostARNFlagDescription)
 	return cmd
 }
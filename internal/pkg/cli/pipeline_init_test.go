@@ -5,6 +5,7 @@ package cli
 
 import (
 	"bytes"
+	"encoding"
 	"errors"
 	"fmt"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	templatemocks "github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -34,6 +36,44 @@ type pipelineInitMocks struct {
 	prompt         *mocks.Mockprompter
 	sel            *mocks.MockpipelineEnvSelector
 	pipelineLister *mocks.MockdeployedPipelineLister
+	codestar       *mocks.MockcodestarHostGetter
+}
+
+func TestInitPipelineOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inHostARN string
+
+		expectedError error
+	}{
+		"valid without a host": {},
+		"valid host ARN": {
+			inHostARN: "arn:aws:codestar-connections:us-west-2:123456789012:host/gitlab-1a2b3c",
+		},
+		"invalid host ARN": {
+			inHostARN:     "gitlab-1a2b3c",
+			expectedError: errors.New(`host "gitlab-1a2b3c" must be the ARN of a CodeStar Connections host`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &initPipelineOpts{
+				initPipelineVars: initPipelineVars{
+					hostARN: tc.inHostARN,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
 }
 
 func TestInitPipelineOpts_Ask(t *testing.T) {
@@ -57,6 +97,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 		inGitHubAccessToken string
 		inGitBranch         string
 		inType              string
+		inHostARN           string
 
 		setupMocks func(m pipelineInitMocks)
 		buffer     bytes.Buffer
@@ -89,7 +130,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			setupMocks: func(m pipelineInitMocks) {
 				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
 			},
-			expectedError: errors.New("repository https://gitlab.company.com/group/project.git must be from a supported provider: GitHub, CodeCommit or Bitbucket"),
+			expectedError: errors.New("repository https://gitlab.company.com/group/project.git must be from a supported provider: GitHub, CodeCommit, Bitbucket or GitLab, or from a self-hosted provider with --host-arn"),
 		},
 		"returns error when GitHub repository URL is of unknown format": {
 			inWsAppName: mockAppName,
@@ -136,6 +177,34 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			},
 			expectedError: errors.New("unable to parse the Bitbucket repository name from bitbucket.org"),
 		},
+		"returns error when GitLab repository URL is of unknown format": {
+			inWsAppName: mockAppName,
+			inRepoURL:   "gitlab.com",
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+			},
+			expectedError: errors.New("unable to parse the repository owner and name from gitlab.com"),
+		},
+		"returns error when the provider type of the host can't be retrieved": {
+			inWsAppName: mockAppName,
+			inRepoURL:   "https://git.example.com/badgoose/chaOS",
+			inHostARN:   "arn:aws:codestar-connections:us-west-2:123456789012:host/ghes-1a2b3c",
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+				m.codestar.EXPECT().HostProviderType("arn:aws:codestar-connections:us-west-2:123456789012:host/ghes-1a2b3c").Return("", mockError)
+			},
+			expectedError: mockError,
+		},
+		"returns error when the host has an unsupported provider type": {
+			inWsAppName: mockAppName,
+			inRepoURL:   "https://git.example.com/badgoose/chaOS",
+			inHostARN:   "arn:aws:codestar-connections:us-west-2:123456789012:host/ghes-1a2b3c",
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+				m.codestar.EXPECT().HostProviderType(gomock.Any()).Return("Bitbucket", nil)
+			},
+			expectedError: errors.New(`host arn:aws:codestar-connections:us-west-2:123456789012:host/ghes-1a2b3c has provider type "Bitbucket": must be one of GitHubEnterpriseServer or GitLabSelfManaged`),
+		},
 		"successfully detects local branch and sets it": {
 			inWsAppName:    mockAppName,
 			inRepoURL:      "git@github.com:badgoose/goose.git",
@@ -249,7 +318,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.prompt.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			},
 
-			expectedError: errors.New("repository unsupported.org/repositories/repoName must be from a supported provider: GitHub, CodeCommit or Bitbucket"),
+			expectedError: errors.New("repository unsupported.org/repositories/repoName must be from a supported provider: GitHub, CodeCommit, Bitbucket or GitLab, or from a self-hosted provider with --host-arn"),
 		},
 		"passed-in invalid environments": {
 			inWsAppName:    mockAppName,
@@ -426,6 +495,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				store:          mocks.NewMockstore(ctrl),
 				pipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
 				workspace:      mocks.NewMockwsPipelineIniter(ctrl),
+				codestar:       mocks.NewMockcodestarHostGetter(ctrl),
 			}
 			if tc.setupMocks != nil {
 				tc.setupMocks(mocks)
@@ -440,6 +510,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 					githubAccessToken: tc.inGitHubAccessToken,
 					repoBranch:        tc.inGitBranch,
 					pipelineType:      tc.inType,
+					hostARN:           tc.inHostARN,
 				},
 				wsAppName:      tc.inWsAppName,
 				prompt:         mocks.prompt,
@@ -450,6 +521,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				store:          mocks.store,
 				pipelineLister: mocks.pipelineLister,
 				workspace:      mocks.workspace,
+				codestar:       mocks.codestar,
 			}
 
 			// WHEN
@@ -488,6 +560,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		inBranch       string
 		inAppName      string
 		inType         string
+		inHostARN      string
 
 		setupMocks func(m pipelineInitMocks)
 		buffer     bytes.Buffer
@@ -625,6 +698,91 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"writes workloads pipeline manifest and buildspec for GL provider": {
+			inName: wantedName,
+			inType: pipelineTypeWorkloads,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoURL: "git@gitlab.com:badgoose/platform/goose.git",
+			inBranch:  "main",
+			inAppName: "badgoose",
+			setupMocks: func(m pipelineInitMocks) {
+				m.workspace.EXPECT().WritePipelineManifest(gomock.Any(), wantedName).DoAndReturn(func(mft encoding.BinaryMarshaler, _ string) (string, error) {
+					require.Equal(t, &manifest.Source{
+						ProviderName: manifest.GitLabProviderName,
+						Properties: map[string]interface{}{
+							"repository": "https://gitlab.com/badgoose/platform/goose",
+							"branch":     "main",
+						},
+					}, mft.(*manifest.Pipeline).Source)
+					return wantedManifestFile, nil
+				})
+				m.workspace.EXPECT().WritePipelineBuildspec(gomock.Any(), wantedName).Return(wantedBuildspecFile, nil)
+				m.workspace.EXPECT().Rel(wantedManifestFile).Return(wantedManifestRelPath, nil)
+				m.parser.EXPECT().Parse(workloadsPipelineBuildspecTemplatePath, gomock.Any(), gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+				m.store.EXPECT().GetApplication("badgoose").Return(&config.Application{
+					Name: "badgoose",
+				}, nil)
+				m.cfnClient.EXPECT().GetRegionalAppResources(&config.Application{
+					Name: "badgoose",
+				}).Return([]*stack.AppRegionalResources{
+					{
+						Region:   "us-west-2",
+						S3Bucket: "gooseBucket",
+					},
+				}, nil)
+			},
+			expectedError: nil,
+		},
+		"writes workloads pipeline manifest and buildspec for a self-hosted provider": {
+			inName: wantedName,
+			inType: pipelineTypeWorkloads,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoURL: "ssh://git@git.example.com:2222/badgoose/goose.git",
+			inBranch:  "main",
+			inAppName: "badgoose",
+			inHostARN: "arn:aws:codestar-connections:us-west-2:123456789012:host/ghes-1a2b3c",
+			setupMocks: func(m pipelineInitMocks) {
+				m.codestar.EXPECT().HostProviderType("arn:aws:codestar-connections:us-west-2:123456789012:host/ghes-1a2b3c").Return(manifest.GitHubEnterpriseServerProviderName, nil)
+				m.workspace.EXPECT().WritePipelineManifest(gomock.Any(), wantedName).DoAndReturn(func(mft encoding.BinaryMarshaler, _ string) (string, error) {
+					require.Equal(t, &manifest.Source{
+						ProviderName: manifest.GitHubEnterpriseServerProviderName,
+						Properties: map[string]interface{}{
+							"repository": "https://git.example.com/badgoose/goose",
+							"branch":     "main",
+							"host_arn":   "arn:aws:codestar-connections:us-west-2:123456789012:host/ghes-1a2b3c",
+						},
+					}, mft.(*manifest.Pipeline).Source)
+					return wantedManifestFile, nil
+				})
+				m.workspace.EXPECT().WritePipelineBuildspec(gomock.Any(), wantedName).Return(wantedBuildspecFile, nil)
+				m.workspace.EXPECT().Rel(wantedManifestFile).Return(wantedManifestRelPath, nil)
+				m.parser.EXPECT().Parse(workloadsPipelineBuildspecTemplatePath, gomock.Any(), gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+				m.store.EXPECT().GetApplication("badgoose").Return(&config.Application{
+					Name: "badgoose",
+				}, nil)
+				m.cfnClient.EXPECT().GetRegionalAppResources(&config.Application{
+					Name: "badgoose",
+				}).Return([]*stack.AppRegionalResources{
+					{
+						Region:   "us-west-2",
+						S3Bucket: "gooseBucket",
+					},
+				}, nil)
+			},
+			expectedError: nil,
+		},
 		"writes environments pipeline manifest for GH(v2) provider": {
 			inName: wantedName,
 			inType: pipelineTypeEnvironments,
@@ -862,6 +1020,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				sessProvider:   mocks.NewMocksessionProvider(ctrl),
 				cfnClient:      mocks.NewMockappResourcesGetter(ctrl),
 				store:          mocks.NewMockstore(ctrl),
+				codestar:       mocks.NewMockcodestarHostGetter(ctrl),
 			}
 			if tc.setupMocks != nil {
 				tc.setupMocks(mocks)
@@ -874,6 +1033,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 					repoBranch:        tc.inBranch,
 					repoURL:           tc.inRepoURL,
 					pipelineType:      tc.inType,
+					hostARN:           tc.inHostARN,
 				},
 				workspace:      mocks.workspace,
 				secretsmanager: mocks.secretsmanager,
@@ -881,6 +1041,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				sessProvider:   mocks.sessProvider,
 				store:          mocks.store,
 				cfnClient:      mocks.cfnClient,
+				codestar:       mocks.codestar,
 				buffer:         tc.buffer,
 				envConfigs:     tc.inEnvConfigs,
 			}
@@ -902,6 +1063,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 func TestInitPipelineOpts_parseGitRemoteResult(t *testing.T) {
 	testCases := map[string]struct {
 		inRemoteResult string
+		inHostARN      string
 
 		expectedURLs  []string
 		expectedError error
@@ -914,21 +1076,32 @@ koke	git://github.com/koke/grit.git (push)
 https	https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (fetch)
 fed	codecommit::us-west-2://aws-sample (fetch)
 ssh	ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (push)
-bb	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (push)`,
+bb	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (push)
+gl	git@gitlab.com:badgoose/platform/grit.git (fetch)`,
 
-			expectedURLs: []string{"git@github.com:badgoose/grit", "https://github.com/badgoose/cli", "https://github.com/koke/grit", "git://github.com/koke/grit", "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "codecommit::us-west-2://aws-sample", "ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service"},
+			expectedURLs: []string{"git@github.com:badgoose/grit", "https://github.com/badgoose/cli", "https://github.com/koke/grit", "git://github.com/koke/grit", "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "codecommit::us-west-2://aws-sample", "ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service", "git@gitlab.com:badgoose/platform/grit"},
 		},
-		"don't add to URL list if it is not a GitHub or CodeCommit or Bitbucket URL": {
-			inRemoteResult: `badgoose	verybad@gitlab.com/whatever (fetch)`,
+		"don't add to URL list if it is not a GitHub, CodeCommit, Bitbucket or GitLab URL": {
+			inRemoteResult: `badgoose	verybad@git.example.com/whatever (fetch)`,
 
 			expectedURLs: []string{},
 		},
+		"add all URLs for a self-hosted repository": {
+			inRemoteResult: `badgoose	git@git.example.com:badgoose/grit.git (fetch)`,
+			inHostARN:      "arn:aws:codestar-connections:us-west-2:123456789012:host/ghes-1a2b3c",
+
+			expectedURLs: []string{"git@git.example.com:badgoose/grit"},
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
-			opts := &initPipelineOpts{}
+			opts := &initPipelineOpts{
+				initPipelineVars: initPipelineVars{
+					hostARN: tc.inHostARN,
+				},
+			}
 
 			// WHEN
 			urls, err := opts.parseGitRemoteResult(tc.inRemoteResult)
@@ -1074,3 +1247,68 @@ func TestInitPipelineBBRepoURL_parse(t *testing.T) {
 		})
 	}
 }
+
+func TestInitPipelineGitRepoURL_parse(t *testing.T) {
+	testCases := map[string]struct {
+		inRepoURL gitRepoURL
+
+		expectedDetails gitRepoDetails
+		expectedError   error
+	}{
+		"successfully parses https url with subgroups": {
+			inRepoURL: "https://gitlab.com/badgoose/platform/grit",
+
+			expectedDetails: gitRepoDetails{
+				host:  "gitlab.com",
+				name:  "grit",
+				owner: "badgoose/platform",
+			},
+		},
+		"successfully parses https url with a user": {
+			inRepoURL: "https://huanjani@git.example.com/badgoose/grit.git",
+
+			expectedDetails: gitRepoDetails{
+				host:  "git.example.com",
+				name:  "grit",
+				owner: "badgoose",
+			},
+		},
+		"successfully parses scp-like ssh url": {
+			inRepoURL: "git@gitlab.com:badgoose/grit",
+
+			expectedDetails: gitRepoDetails{
+				host:  "gitlab.com",
+				name:  "grit",
+				owner: "badgoose",
+			},
+		},
+		"successfully parses ssh url with a port": {
+			inRepoURL: "ssh://git@git.example.com:2222/badgoose/grit",
+
+			expectedDetails: gitRepoDetails{
+				host:  "git.example.com",
+				name:  "grit",
+				owner: "badgoose",
+			},
+		},
+		"returns an error if the url doesn't have an owner": {
+			inRepoURL: "https://git.example.com/grit",
+
+			expectedError: errors.New("unable to parse the repository owner and name from https://git.example.com/grit"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			details, err := gitRepoURL.parse(tc.inRepoURL)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.Equal(t, tc.expectedDetails, details)
+			}
+		})
+	}
+}
@@ -363,7 +363,7 @@ func TestOverridePipeline_Execute(t *testing.T) {
 				ctrl := gomock.NewController(t)
 				defer ctrl.Finish()
 
-				vars := overrideVars{appName: "demo", name: "mockPipelineName", iacTool: "cdk", resources: tc.resources}
+				vars := overrideVars{appName: "demo", name: "mockPipelineName", iacTool: "cdk", cdkLang: "typescript", resources: tc.resources}
 				cmd := &overridePipelineOpts{
 					overrideOpts: &overrideOpts{
 						overrideVars: vars,
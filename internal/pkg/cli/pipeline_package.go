@@ -261,6 +261,13 @@ func (o *packagePipelineOpts) convertStages(manifestStages []manifest.PipelineSt
 
 		var stg deploy.PipelineStage
 		stg.Init(env, &stage, workloads)
+		if stage.Bake != nil {
+			alarms, err := stageBakeAlarms(&stg, o.ws)
+			if err != nil {
+				return nil, err
+			}
+			stg.SetBakeAlarms(alarms)
+		}
 		stages = append(stages, stg)
 	}
 	return stages, nil
@@ -0,0 +1,156 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineRunAppNamePrompt     = "Which application's pipeline would you like to run?"
+	pipelineRunAppNameHelpPrompt = "An application is a collection of related services."
+
+	fmtPipelineRunPrompt = "Which pipeline of %s would you like to run?"
+)
+
+type pipelineRunVars struct {
+	appName  string
+	name     string
+	commitID string
+}
+
+type pipelineRunOpts struct {
+	pipelineRunVars
+
+	store                  store
+	codepipeline           pipelineExecutionStarter
+	sel                    codePipelineSelector
+	deployedPipelineLister deployedPipelineLister
+
+	// Cached variables.
+	targetPipeline *deploy.Pipeline
+}
+
+func newPipelineRunOpts(vars pipelineRunVars) (*pipelineRunOpts, error) {
+	session, err := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline run")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("session: %w", err)
+	}
+	pipelineLister := deploy.NewPipelineStore(rg.New(session))
+	store := config.NewSSMStore(identity.New(session), ssm.New(session), aws.StringValue(session.Config.Region))
+	return &pipelineRunOpts{
+		pipelineRunVars:        vars,
+		store:                  store,
+		codepipeline:           codepipeline.New(session),
+		deployedPipelineLister: pipelineLister,
+		sel:                    selector.NewAppPipelineSelector(prompt.New(), store, pipelineLister),
+	}, nil
+}
+
+// Validate returns an error if the optional flag values provided by the user are invalid.
+func (o *pipelineRunOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in, and validates those that are.
+func (o *pipelineRunOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name: %w", err)
+		}
+	} else {
+		if err := o.askAppName(); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		pipeline, err := getDeployedPipelineInfo(o.deployedPipelineLister, o.appName, o.name)
+		if err != nil {
+			return fmt.Errorf("validate pipeline name %s: %w", o.name, err)
+		}
+		o.targetPipeline = &pipeline
+		return nil
+	}
+	pipeline, err := askDeployedPipelineName(o.sel, fmt.Sprintf(fmtPipelineRunPrompt, color.HighlightUserInput(o.appName)), o.appName)
+	if err != nil {
+		return err
+	}
+	o.name = pipeline.Name
+	o.targetPipeline = &pipeline
+	return nil
+}
+
+// Execute starts a new execution of the pipeline.
+func (o *pipelineRunOpts) Execute() error {
+	executionID, err := o.codepipeline.StartExecution(o.targetPipeline.ResourceName, o.commitID)
+	if err != nil {
+		return fmt.Errorf("run pipeline %s: %w", o.name, err)
+	}
+	if o.commitID != "" {
+		log.Successf("Started execution %s of pipeline %s for commit %s.\n", color.HighlightResource(executionID), color.HighlightUserInput(o.name), color.HighlightUserInput(o.commitID))
+	} else {
+		log.Successf("Started execution %s of pipeline %s.\n", color.HighlightResource(executionID), color.HighlightUserInput(o.name))
+	}
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *pipelineRunOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to follow the progress of the execution.", color.HighlightCode(fmt.Sprintf("copilot pipeline status -n %s", o.name))),
+	})
+	return nil
+}
+
+func (o *pipelineRunOpts) askAppName() error {
+	name, err := o.sel.Application(pipelineRunAppNamePrompt, pipelineRunAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+// buildPipelineRunCmd builds the command for starting a new execution of a deployed pipeline.
+func buildPipelineRunCmd() *cobra.Command {
+	vars := pipelineRunVars{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Starts a new execution of a pipeline.",
+		Long: `Starts a new execution of a pipeline.
+The pipeline releases the latest commit on its tracked branch, or the commit passed with --commit-id.`,
+
+		Example: `
+Runs the pipeline "my-repo-my-branch".
+/code $ copilot pipeline run -n my-repo-my-branch
+Releases commit "4c1d2e3" with the pipeline "my-repo-my-branch".
+/code $ copilot pipeline run -n my-repo-my-branch --commit-id 4c1d2e3`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineRunOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.commitID, commitIDFlag, "", commitIDFlagDescription)
+
+	return cmd
+}
@@ -0,0 +1,201 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineRunMocks struct {
+	store                  *mocks.Mockstore
+	codepipeline           *mocks.MockpipelineExecutionStarter
+	sel                    *mocks.MockcodePipelineSelector
+	deployedPipelineLister *mocks.MockdeployedPipelineLister
+}
+
+func TestPipelineRun_Ask(t *testing.T) {
+	const (
+		mockAppName      = "dinder"
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+	)
+	mockError := errors.New("mock error")
+	mockPipeline := deploy.Pipeline{
+		AppName:      mockAppName,
+		Name:         mockPipelineName,
+		ResourceName: "pipeline-dinder-badgoose-repo-RANDOMSTRING",
+	}
+
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		setupMocks     func(m pipelineRunMocks)
+
+		expectedApp      string
+		expectedPipeline *deploy.Pipeline
+		expectedErr      error
+	}{
+		"with invalid app name": {
+			inAppName: mockAppName,
+			setupMocks: func(m pipelineRunMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(nil, mockError)
+			},
+			expectedErr: fmt.Errorf("validate application name: %w", mockError),
+		},
+		"errors if fail to select app name": {
+			setupMocks: func(m pipelineRunMocks) {
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", mockError)
+			},
+			expectedErr: fmt.Errorf("select application: %w", mockError),
+		},
+		"with invalid pipeline name": {
+			inAppName:      mockAppName,
+			inPipelineName: "badgoose",
+			setupMocks: func(m pipelineRunMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+			},
+			expectedErr: errors.New("validate pipeline name badgoose: cannot find pipeline named badgoose"),
+		},
+		"wraps error when fails to select pipeline": {
+			inAppName: mockAppName,
+			setupMocks: func(m pipelineRunMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.sel.EXPECT().DeployedPipeline(gomock.Any(), gomock.Any(), mockAppName).Return(deploy.Pipeline{}, mockError)
+			},
+			expectedErr: fmt.Errorf("select deployed pipelines: %w", mockError),
+		},
+		"prompts for app and pipeline names": {
+			setupMocks: func(m pipelineRunMocks) {
+				gomock.InOrder(
+					m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return(mockAppName, nil),
+					m.sel.EXPECT().DeployedPipeline(gomock.Any(), gomock.Any(), mockAppName).Return(mockPipeline, nil),
+				)
+			},
+			expectedApp:      mockAppName,
+			expectedPipeline: &mockPipeline,
+		},
+		"success with flags": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineRunMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+			},
+			expectedApp:      mockAppName,
+			expectedPipeline: &mockPipeline,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineRunMocks{
+				store:                  mocks.NewMockstore(ctrl),
+				sel:                    mocks.NewMockcodePipelineSelector(ctrl),
+				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineRunOpts{
+				pipelineRunVars: pipelineRunVars{
+					appName: tc.inAppName,
+					name:    tc.inPipelineName,
+				},
+				store:                  m.store,
+				sel:                    m.sel,
+				deployedPipelineLister: m.deployedPipelineLister,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedApp, opts.appName)
+				require.Equal(t, tc.expectedPipeline.Name, opts.name)
+				require.Equal(t, tc.expectedPipeline, opts.targetPipeline)
+			}
+		})
+	}
+}
+
+func TestPipelineRun_Execute(t *testing.T) {
+	const (
+		mockPipelineName     = "pipeline-dinder-badgoose-repo"
+		mockPipelineResource = "pipeline-dinder-badgoose-repo-RANDOMSTRING"
+	)
+	mockError := errors.New("mock error")
+
+	testCases := map[string]struct {
+		inCommitID string
+		setupMocks func(m pipelineRunMocks)
+
+		expectedErr error
+	}{
+		"starts an execution of the pipeline": {
+			setupMocks: func(m pipelineRunMocks) {
+				m.codepipeline.EXPECT().StartExecution(mockPipelineResource, "").Return("12345678-fake-exec-utio-nid987654321", nil)
+			},
+		},
+		"starts an execution of the pipeline for a commit": {
+			inCommitID: "4c1d2e3",
+			setupMocks: func(m pipelineRunMocks) {
+				m.codepipeline.EXPECT().StartExecution(mockPipelineResource, "4c1d2e3").Return("12345678-fake-exec-utio-nid987654321", nil)
+			},
+		},
+		"wraps error when fails to start the execution": {
+			setupMocks: func(m pipelineRunMocks) {
+				m.codepipeline.EXPECT().StartExecution(mockPipelineResource, "").Return("", mockError)
+			},
+			expectedErr: fmt.Errorf("run pipeline %s: %w", mockPipelineName, mockError),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineRunMocks{
+				codepipeline: mocks.NewMockpipelineExecutionStarter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineRunOpts{
+				pipelineRunVars: pipelineRunVars{
+					name:     mockPipelineName,
+					commitID: tc.inCommitID,
+				},
+				codepipeline: m.codepipeline,
+				targetPipeline: &deploy.Pipeline{
+					Name:         mockPipelineName,
+					ResourceName: mockPipelineResource,
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
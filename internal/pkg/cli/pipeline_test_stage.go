@@ -0,0 +1,508 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+const (
+	pipelineTestStagePipelinePrompt = "Select a pipeline from your workspace to test"
+	fmtPipelineTestStagePrompt      = "Which stage of %s would you like to test?"
+	pipelineTestStageFinalMsg       = "Stage:"
+)
+
+const (
+	// Path inside the build container, mirroring where CodeBuild checks out the source.
+	pipelineTestStageSrcDir = "/codebuild/output/src"
+
+	pipelineTestStageArtifactsDirPrefix = "copilot-pipeline-artifacts-"
+
+	// CodeBuild's curated images can't be pulled from Docker Hub, but they are published to the Amazon ECR Public Gallery.
+	codeBuildImagePrefix       = "aws/codebuild/"
+	codeBuildPublicImagePrefix = "public.ecr.aws/codebuild/"
+)
+
+type pipelineTestStageVars struct {
+	name         string
+	stage        string
+	artifactsDir string
+}
+
+type pipelineTestStageOpts struct {
+	pipelineTestStageVars
+
+	ws       wsPipelineStageReader
+	sel      wsPipelineSelector
+	prompt   prompter
+	docker   dockerEngineRunner
+	identity identityService
+	fs       afero.Fs
+
+	// cached variables
+	wsAppName   string
+	pipeline    *workspace.PipelineManifest
+	pipelineMft *manifest.Pipeline
+}
+
+func newPipelineTestStageOpts(vars pipelineTestStageVars) (*pipelineTestStageOpts, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+	defaultSess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline test-stage")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	prompter := prompt.New()
+	return &pipelineTestStageOpts{
+		pipelineTestStageVars: vars,
+		ws:                    ws,
+		sel:                   selector.NewWsPipelineSelector(prompter, ws),
+		prompt:                prompter,
+		docker:                dockerengine.New(exec.NewCmd()),
+		identity:              identity.New(defaultSess),
+		fs:                    fs,
+		wsAppName:             tryReadingAppName(),
+	}, nil
+}
+
+// Validate returns an error if the optional flag values provided by the user are invalid.
+func (o *pipelineTestStageOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates the pipeline and the stage to test.
+func (o *pipelineTestStageOpts) Ask() error {
+	if o.wsAppName == "" {
+		return errNoAppInWorkspace
+	}
+	if err := o.askPipeline(); err != nil {
+		return err
+	}
+	mft, err := o.ws.ReadPipelineManifest(o.pipeline.Path)
+	if err != nil {
+		return fmt.Errorf("read pipeline manifest: %w", err)
+	}
+	if err := mft.Validate(); err != nil {
+		return fmt.Errorf("validate pipeline manifest: %w", err)
+	}
+	o.pipelineMft = mft
+	return o.askStage()
+}
+
+// Execute runs the pre-deployments, post-deployments and test commands of the stage in local containers.
+func (o *pipelineTestStageOpts) Execute() error {
+	if err := o.docker.CheckDockerEngineRunning(); err != nil {
+		return fmt.Errorf("check if docker engine is running: %w", err)
+	}
+	artifactsDir, err := o.localArtifactsDir()
+	if err != nil {
+		return err
+	}
+	actions, err := o.localActions()
+	if err != nil {
+		return err
+	}
+	if len(actions) == 0 {
+		log.Infof("Stage %s of pipeline %s has no pre-deployments, post-deployments, or test commands to run.\n",
+			color.HighlightUserInput(o.stage), color.HighlightUserInput(o.name))
+		return nil
+	}
+	pipelineEnvVars, err := o.pipelineEnvVars()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	for _, action := range actions {
+		log.Infof("Running %s of stage %s in %s.\n", color.HighlightUserInput(action.name), color.HighlightUserInput(o.stage), color.HighlightResource(action.image))
+		envVars := make(map[string]string)
+		for k, v := range action.buildspec.Env.Variables {
+			envVars[k] = v
+		}
+		// Variables set on the CodeBuild project take precedence over the ones declared in the buildspec.
+		for k, v := range pipelineEnvVars {
+			envVars[k] = v
+		}
+		for k, v := range action.envVars {
+			envVars[k] = v
+		}
+		envVars["CODEBUILD_SRC_DIR"] = pipelineTestStageSrcDir
+		action.buildspec.warnSkippedEnvVars(action.name)
+		err := o.docker.Run(ctx, &dockerengine.RunOptions{
+			ImageURI: action.image,
+			EnvVars:  envVars,
+			Volumes: map[string]string{
+				o.ws.ProjectRoot(): pipelineTestStageSrcDir,
+			},
+			WorkingDir: pipelineTestStageSrcDir,
+			Remove:     true,
+			Command:    []string{"sh", "-c", action.buildspec.script()},
+			LogOptions: dockerengine.RunLogOptions{
+				LinePrefix: fmt.Sprintf("[%s] ", action.name),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("run %s of stage %s: %w", action.name, o.stage, err)
+		}
+		if err := o.copyArtifacts(action.buildspec.Artifacts.Files, artifactsDir); err != nil {
+			return fmt.Errorf("copy artifacts of %s: %w", action.name, err)
+		}
+		log.Successf("Ran %s of stage %s.\n", color.HighlightUserInput(action.name), color.HighlightUserInput(o.stage))
+	}
+	log.Infof("Artifacts are written to %s.\n", color.HighlightResource(artifactsDir))
+	return nil
+}
+
+// pipelineEnvVars returns the environment variables that the pipeline sets on all of its actions.
+func (o *pipelineTestStageOpts) pipelineEnvVars() (map[string]string, error) {
+	caller, err := o.identity.Get()
+	if err != nil {
+		return nil, fmt.Errorf("get identity: %w", err)
+	}
+	rootARN, err := arn.Parse(caller.RootUserARN)
+	if err != nil {
+		return nil, fmt.Errorf("parse ARN %s: %w", caller.RootUserARN, err)
+	}
+	return map[string]string{
+		"AWS_ACCOUNT_ID": caller.Account,
+		"PARTITION":      rootARN.Partition,
+	}, nil
+}
+
+func (o *pipelineTestStageOpts) askPipeline() error {
+	if o.name != "" {
+		pipelines, err := o.ws.ListPipelines()
+		if err != nil {
+			return fmt.Errorf("list pipelines: %w", err)
+		}
+		for _, pipeline := range pipelines {
+			if pipeline.Name == o.name {
+				o.pipeline = &pipeline
+				return nil
+			}
+		}
+		return fmt.Errorf(`pipeline %s not found in the workspace`, color.HighlightUserInput(o.name))
+	}
+	pipeline, err := o.sel.WsPipeline(pipelineTestStagePipelinePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select pipeline: %w", err)
+	}
+	o.pipeline = pipeline
+	o.name = pipeline.Name
+	return nil
+}
+
+func (o *pipelineTestStageOpts) askStage() error {
+	var names []string
+	for _, stage := range o.pipelineMft.Stages {
+		names = append(names, stage.Name)
+	}
+	if o.stage != "" {
+		for _, name := range names {
+			if name == o.stage {
+				return nil
+			}
+		}
+		return fmt.Errorf("stage %s not found in pipeline %s", color.HighlightUserInput(o.stage), color.HighlightUserInput(o.name))
+	}
+	stage, err := o.prompt.SelectOne(fmt.Sprintf(fmtPipelineTestStagePrompt, color.HighlightUserInput(o.name)), "", names, prompt.WithFinalMessage(pipelineTestStageFinalMsg))
+	if err != nil {
+		return fmt.Errorf("select stage: %w", err)
+	}
+	o.stage = stage
+	return nil
+}
+
+func (o *pipelineTestStageOpts) localArtifactsDir() (string, error) {
+	if o.artifactsDir == "" {
+		dir, err := afero.TempDir(o.fs, "", pipelineTestStageArtifactsDirPrefix)
+		if err != nil {
+			return "", fmt.Errorf("create artifacts directory: %w", err)
+		}
+		return dir, nil
+	}
+	dir, err := filepath.Abs(o.artifactsDir)
+	if err != nil {
+		return "", fmt.Errorf("get absolute path of artifacts directory %s: %w", o.artifactsDir, err)
+	}
+	if err := o.fs.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("create artifacts directory %s: %w", dir, err)
+	}
+	return dir, nil
+}
+
+// localActions returns the CodeBuild-backed actions of the stage in the order the pipeline runs them.
+func (o *pipelineTestStageOpts) localActions() ([]localBuildAction, error) {
+	var mftStage *manifest.PipelineStage
+	for i := range o.pipelineMft.Stages {
+		if o.pipelineMft.Stages[i].Name == o.stage {
+			mftStage = &o.pipelineMft.Stages[i]
+			break
+		}
+	}
+	if mftStage == nil {
+		return nil, fmt.Errorf("stage %s not found in pipeline %s", o.stage, o.name)
+	}
+	var build deploy.Build
+	if err := build.Init(o.pipelineMft.Build, filepath.Dir(o.pipeline.Path)); err != nil {
+		return nil, err
+	}
+	var stage deploy.PipelineStage
+	stage.Init(&config.Environment{App: o.wsAppName, Name: o.stage}, mftStage, nil)
+
+	preDeployments, err := stage.PreDeployments()
+	if err != nil {
+		return nil, fmt.Errorf("get pre-deployments of stage %s: %w", o.stage, err)
+	}
+	postDeployments, err := stage.PostDeployments()
+	if err != nil {
+		return nil, fmt.Errorf("get post-deployments of stage %s: %w", o.stage, err)
+	}
+	var actions []localBuildAction
+	for _, deployments := range [][]deploy.PrePostDeployAction{preDeployments, postDeployments} {
+		sort.SliceStable(deployments, func(i, j int) bool {
+			return deployments[i].RunOrder() < deployments[j].RunOrder()
+		})
+		for _, deployment := range deployments {
+			buildspec, err := o.readBuildspec(deployment.BuildspecPath)
+			if err != nil {
+				return nil, err
+			}
+			actions = append(actions, localBuildAction{
+				name:      deployment.Name(),
+				image:     localBuildImage(build.Image),
+				envVars:   deployment.Variables,
+				buildspec: buildspec,
+			})
+		}
+	}
+	test, err := stage.Test()
+	if err != nil {
+		return nil, fmt.Errorf("get test commands of stage %s: %w", o.stage, err)
+	}
+	if test != nil {
+		spec := &localBuildspec{}
+		spec.Phases.Build.Commands = test.Commands()
+		actions = append(actions, localBuildAction{
+			name:      test.Name(),
+			image:     localBuildImage(build.Image),
+			buildspec: spec,
+		})
+	}
+	return actions, nil
+}
+
+func (o *pipelineTestStageOpts) readBuildspec(path string) (*localBuildspec, error) {
+	content, err := afero.ReadFile(o.fs, filepath.Join(o.ws.ProjectRoot(), filepath.FromSlash(path)))
+	if err != nil {
+		return nil, fmt.Errorf("read buildspec %s: %w", path, err)
+	}
+	spec := &localBuildspec{}
+	if err := yaml.Unmarshal(content, spec); err != nil {
+		return nil, fmt.Errorf("unmarshal buildspec %s: %w", path, err)
+	}
+	return spec, nil
+}
+
+// copyArtifacts copies the files of the workspace that match the artifact patterns of a buildspec to artifactsDir,
+// under the same relative paths.
+func (o *pipelineTestStageOpts) copyArtifacts(patterns []string, artifactsDir string) error {
+	if len(patterns) == 0 {
+		return nil
+	}
+	root := o.ws.ProjectRoot()
+	return afero.Walk(o.fs, root, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if file == artifactsDir || info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return err
+		}
+		if !matchesArtifact(patterns, filepath.ToSlash(rel)) {
+			return nil
+		}
+		content, err := afero.ReadFile(o.fs, file)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", rel, err)
+		}
+		dst := filepath.Join(artifactsDir, rel)
+		if err := o.fs.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return fmt.Errorf("create directory for %s: %w", rel, err)
+		}
+		if err := afero.WriteFile(o.fs, dst, content, info.Mode()); err != nil {
+			return fmt.Errorf("write %s: %w", dst, err)
+		}
+		return nil
+	})
+}
+
+// matchesArtifact returns true if the slash-separated relative path, or one of its parent directories,
+// matches one of the artifact patterns of a buildspec.
+func matchesArtifact(patterns []string, rel string) bool {
+	segments := strings.Split(rel, "/")
+	for _, pattern := range patterns {
+		patternSegments := strings.Split(path.Clean(pattern), "/")
+		for i := len(segments); i > 0; i-- {
+			if matchArtifactSegments(patternSegments, segments[:i]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchArtifactSegments returns true if the segments of a path match the ones of a pattern,
+// in which "**" matches any number of directories like in CodeBuild.
+func matchArtifactSegments(pattern, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(segments); i++ {
+				if matchArtifactSegments(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], segments[0]); !ok {
+			return false
+		}
+		pattern, segments = pattern[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
+
+// localBuildImage returns the image to pull to run a build locally, which is the image of the Amazon ECR Public Gallery
+// for the images curated by CodeBuild, such as "aws/codebuild/amazonlinux2-x86_64-standard:5.0".
+func localBuildImage(image string) string {
+	if name, ok := strings.CutPrefix(image, codeBuildImagePrefix); ok {
+		return codeBuildPublicImagePrefix + name
+	}
+	return image
+}
+
+// localBuildAction is a CodeBuild-backed action of a pipeline stage that can be run in a local container.
+type localBuildAction struct {
+	name      string
+	image     string
+	envVars   map[string]string
+	buildspec *localBuildspec
+}
+
+// localBuildspec holds the fields of a CodeBuild buildspec that can be run locally.
+type localBuildspec struct {
+	Env struct {
+		Variables      map[string]string `yaml:"variables"`
+		ParameterStore map[string]string `yaml:"parameter-store"`
+		SecretsManager map[string]string `yaml:"secrets-manager"`
+	} `yaml:"env"`
+	Phases struct {
+		Install   localBuildPhase `yaml:"install"`
+		PreBuild  localBuildPhase `yaml:"pre_build"`
+		Build     localBuildPhase `yaml:"build"`
+		PostBuild localBuildPhase `yaml:"post_build"`
+	} `yaml:"phases"`
+	Artifacts struct {
+		Files []string `yaml:"files"`
+	} `yaml:"artifacts"`
+}
+
+type localBuildPhase struct {
+	Commands []string `yaml:"commands"`
+}
+
+// script returns a shell script that runs the commands of each phase in order.
+func (b *localBuildspec) script() string {
+	lines := []string{"set -e"}
+	for _, phase := range []localBuildPhase{b.Phases.Install, b.Phases.PreBuild, b.Phases.Build, b.Phases.PostBuild} {
+		lines = append(lines, phase.Commands...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// warnSkippedEnvVars warns about the variables of the buildspec that are read from Parameter Store or Secrets Manager,
+// since they aren't resolved locally.
+func (b *localBuildspec) warnSkippedEnvVars(action string) {
+	for _, source := range []struct {
+		name string
+		vars map[string]string
+	}{
+		{name: "parameter-store", vars: b.Env.ParameterStore},
+		{name: "secrets-manager", vars: b.Env.SecretsManager},
+	} {
+		names := make([]string, 0, len(source.vars))
+		for name := range source.vars {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			log.Warningf("Skipping variable %s of %s: %s variables are not resolved locally.\n", color.HighlightUserInput(name), color.HighlightUserInput(action), source.name)
+		}
+	}
+}
+
+// buildPipelineTestStageCmd builds the command for running the build actions of a pipeline stage locally.
+func buildPipelineTestStageCmd() *cobra.Command {
+	vars := pipelineTestStageVars{}
+	cmd := &cobra.Command{
+		Use:   "test-stage",
+		Short: "Runs the buildspecs of a pipeline stage locally.",
+		Long: `Runs the pre-deployments, post-deployments, and test commands of a pipeline stage in local containers.
+The containers use the pipeline's build image and receive the same environment variables as in the pipeline.
+Deployments of the stage are not run.`,
+
+		Example: `
+Runs the "test" stage of the pipeline "my-repo-my-branch".
+/code $ copilot pipeline test-stage -n my-repo-my-branch --stage test
+Writes the artifacts of the stage to the "out" directory.
+/code $ copilot pipeline test-stage -n my-repo-my-branch --stage test --artifacts-dir out`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineTestStageOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVar(&vars.stage, stageFlag, "", stageFlagDescription)
+	cmd.Flags().StringVar(&vars.artifactsDir, artifactsDirFlag, "", artifactsDirFlagDescription)
+
+	return cmd
+}
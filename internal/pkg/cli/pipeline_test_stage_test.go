@@ -0,0 +1,471 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineTestStageMocks struct {
+	ws       *mocks.MockwsPipelineStageReader
+	sel      *mocks.MockwsPipelineSelector
+	prompt   *mocks.Mockprompter
+	docker   *mocks.MockdockerEngineRunner
+	identity *mocks.MockidentityService
+}
+
+func TestPipelineTestStage_Ask(t *testing.T) {
+	const (
+		mockAppName      = "phonetool"
+		mockPipelineName = "phonetool-main"
+		mockPipelinePath = "/ws/copilot/pipelines/phonetool-main/manifest.yml"
+	)
+	mockError := errors.New("mock error")
+	mockPipeline := workspace.PipelineManifest{
+		Name: mockPipelineName,
+		Path: mockPipelinePath,
+	}
+	mockMft := &manifest.Pipeline{
+		Name: mockPipelineName,
+		Source: &manifest.Source{
+			ProviderName: "GitHub",
+			Properties: map[string]interface{}{
+				"repository": "aws/phonetool",
+			},
+		},
+		Stages: []manifest.PipelineStage{
+			{Name: "test"},
+			{Name: "prod"},
+		},
+	}
+
+	testCases := map[string]struct {
+		inWsAppName string
+		inName      string
+		inStage     string
+		setupMocks  func(m pipelineTestStageMocks)
+
+		wantedName  string
+		wantedStage string
+		wantedErr   error
+	}{
+		"errors if the workspace doesn't have an application": {
+			setupMocks:  func(m pipelineTestStageMocks) {},
+			wantedErr:   errNoAppInWorkspace,
+			inWsAppName: "",
+		},
+		"errors if the pipeline is not in the workspace": {
+			inWsAppName: mockAppName,
+			inName:      "badgoose",
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{mockPipeline}, nil)
+			},
+			wantedErr: errors.New("pipeline badgoose not found in the workspace"),
+		},
+		"wraps error when fails to select a pipeline": {
+			inWsAppName: mockAppName,
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.sel.EXPECT().WsPipeline(gomock.Any(), gomock.Any()).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("select pipeline: %w", mockError),
+		},
+		"wraps error when fails to read the pipeline manifest": {
+			inWsAppName: mockAppName,
+			inName:      mockPipelineName,
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{mockPipeline}, nil)
+				m.ws.EXPECT().ReadPipelineManifest(mockPipelinePath).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("read pipeline manifest: %w", mockError),
+		},
+		"errors if the stage is not in the pipeline": {
+			inWsAppName: mockAppName,
+			inName:      mockPipelineName,
+			inStage:     "staging",
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{mockPipeline}, nil)
+				m.ws.EXPECT().ReadPipelineManifest(mockPipelinePath).Return(mockMft, nil)
+			},
+			wantedErr: errors.New("stage staging not found in pipeline phonetool-main"),
+		},
+		"wraps error when fails to select a stage": {
+			inWsAppName: mockAppName,
+			inName:      mockPipelineName,
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{mockPipeline}, nil)
+				m.ws.EXPECT().ReadPipelineManifest(mockPipelinePath).Return(mockMft, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"test", "prod"}, gomock.Any()).Return("", mockError)
+			},
+			wantedErr: fmt.Errorf("select stage: %w", mockError),
+		},
+		"prompts for the pipeline and the stage": {
+			inWsAppName: mockAppName,
+			setupMocks: func(m pipelineTestStageMocks) {
+				gomock.InOrder(
+					m.sel.EXPECT().WsPipeline(gomock.Any(), gomock.Any()).Return(&mockPipeline, nil),
+					m.ws.EXPECT().ReadPipelineManifest(mockPipelinePath).Return(mockMft, nil),
+					m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"test", "prod"}, gomock.Any()).Return("prod", nil),
+				)
+			},
+			wantedName:  mockPipelineName,
+			wantedStage: "prod",
+		},
+		"success with flags": {
+			inWsAppName: mockAppName,
+			inName:      mockPipelineName,
+			inStage:     "test",
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{mockPipeline}, nil)
+				m.ws.EXPECT().ReadPipelineManifest(mockPipelinePath).Return(mockMft, nil)
+			},
+			wantedName:  mockPipelineName,
+			wantedStage: "test",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineTestStageMocks{
+				ws:     mocks.NewMockwsPipelineStageReader(ctrl),
+				sel:    mocks.NewMockwsPipelineSelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &pipelineTestStageOpts{
+				pipelineTestStageVars: pipelineTestStageVars{
+					name:  tc.inName,
+					stage: tc.inStage,
+				},
+				ws:        m.ws,
+				sel:       m.sel,
+				prompt:    m.prompt,
+				wsAppName: tc.inWsAppName,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+				require.Equal(t, tc.wantedStage, opts.stage)
+			}
+		})
+	}
+}
+
+func TestPipelineTestStage_Execute(t *testing.T) {
+	const (
+		mockAppName      = "phonetool"
+		mockPipelineName = "phonetool-main"
+		mockRoot         = "/ws"
+		mockArtifactsDir = "/out"
+	)
+	mockError := errors.New("mock error")
+	mockPipeline := &workspace.PipelineManifest{
+		Name: mockPipelineName,
+		Path: "/ws/copilot/pipelines/phonetool-main/manifest.yml",
+	}
+	mockBuildspec := `version: 0.2
+env:
+  variables:
+    STAGE: local
+    COPILOT_ENVIRONMENT_NAME: overridden
+  parameter-store:
+    DB_HOST: /phonetool/db/host
+  secrets-manager:
+    DB_PASSWORD: db-password
+    API_KEY: api-key
+phases:
+  install:
+    commands:
+      - yum install -y jq
+  build:
+    commands:
+      - ./migrate.sh
+artifacts:
+  files:
+    - out/report.json
+    - ./reports/**/*.xml
+    - coverage
+`
+	mockCaller := identity.Caller{
+		RootUserARN: "arn:aws:iam::123456789012:root",
+		Account:     "123456789012",
+	}
+	wantedVolumes := map[string]string{
+		mockRoot: "/codebuild/output/src",
+	}
+
+	testCases := map[string]struct {
+		inStage    manifest.PipelineStage
+		inBuild    *manifest.Build
+		setupFS    func(fs afero.Fs)
+		setupMocks func(m pipelineTestStageMocks)
+
+		wantedArtifacts    []string
+		wantedNotArtifacts []string
+		wantedWarnings     []string
+		wantedErr          error
+	}{
+		"errors if docker engine is not running": {
+			inStage: manifest.PipelineStage{Name: "test"},
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(mockError)
+			},
+			wantedErr: fmt.Errorf("check if docker engine is running: %w", mockError),
+		},
+		"does nothing if the stage has no build actions": {
+			inStage: manifest.PipelineStage{Name: "test"},
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+			},
+		},
+		"errors if a buildspec can't be read": {
+			inStage: manifest.PipelineStage{
+				Name: "test",
+				PreDeployments: map[string]*manifest.PrePostDeployment{
+					"migrate": {BuildspecPath: "copilot/pipelines/phonetool-main/migrate.yml"},
+				},
+			},
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+			},
+			wantedErr: errors.New("read buildspec copilot/pipelines/phonetool-main/migrate.yml: open /ws/copilot/pipelines/phonetool-main/migrate.yml: file does not exist"),
+		},
+		"wraps error when fails to get the identity": {
+			inStage: manifest.PipelineStage{
+				Name:         "test",
+				TestCommands: []string{"make test"},
+			},
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{}, mockError)
+			},
+			wantedErr: fmt.Errorf("get identity: %w", mockError),
+		},
+		"wraps error when a container fails": {
+			inStage: manifest.PipelineStage{
+				Name:         "test",
+				TestCommands: []string{"make test"},
+			},
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.identity.EXPECT().Get().Return(mockCaller, nil)
+				m.docker.EXPECT().Run(gomock.Any(), gomock.Any()).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("run TestCommands of stage test: %w", mockError),
+		},
+		"runs the default build image from the Amazon ECR Public Gallery": {
+			inStage: manifest.PipelineStage{
+				Name:         "test",
+				TestCommands: []string{"make test"},
+			},
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.identity.EXPECT().Get().Return(mockCaller, nil)
+				m.docker.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, in *dockerengine.RunOptions) error {
+					require.Equal(t, "public.ecr.aws/codebuild/amazonlinux2-x86_64-standard:5.0", in.ImageURI)
+					return nil
+				})
+			},
+		},
+		"runs a custom build image as is": {
+			inStage: manifest.PipelineStage{
+				Name:         "test",
+				TestCommands: []string{"make test"},
+			},
+			inBuild: &manifest.Build{Image: "public.ecr.aws/docker/library/golang:1.21"},
+			setupMocks: func(m pipelineTestStageMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.identity.EXPECT().Get().Return(mockCaller, nil)
+				m.docker.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, in *dockerengine.RunOptions) error {
+					require.Equal(t, "public.ecr.aws/docker/library/golang:1.21", in.ImageURI)
+					return nil
+				})
+			},
+		},
+		"runs pre-deployments in dependency order, then post-deployments, then test commands": {
+			inStage: manifest.PipelineStage{
+				Name:         "test",
+				TestCommands: []string{"make test", "make integ-test"},
+				PreDeployments: map[string]*manifest.PrePostDeployment{
+					"seed": {
+						BuildspecPath: "copilot/pipelines/phonetool-main/migrate.yml",
+						DependsOn:     []string{"migrate"},
+					},
+					"migrate": {BuildspecPath: "copilot/pipelines/phonetool-main/migrate.yml"},
+				},
+				PostDeployments: map[string]*manifest.PrePostDeployment{
+					"smoke": {BuildspecPath: "copilot/pipelines/phonetool-main/migrate.yml"},
+				},
+			},
+			inBuild: &manifest.Build{Image: "aws/codebuild/standard:7.0"},
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "/ws/copilot/pipelines/phonetool-main/migrate.yml", []byte(mockBuildspec), 0644)
+				_ = afero.WriteFile(fs, "/ws/out/report.json", []byte("{}"), 0644)
+				_ = afero.WriteFile(fs, "/ws/out/debug.log", []byte("debug"), 0644)
+				_ = afero.WriteFile(fs, "/ws/reports/junit.xml", []byte("<testsuites/>"), 0644)
+				_ = afero.WriteFile(fs, "/ws/reports/integ/junit.xml", []byte("<testsuites/>"), 0644)
+				_ = afero.WriteFile(fs, "/ws/reports/integ/junit.txt", []byte("junit"), 0644)
+				_ = afero.WriteFile(fs, "/ws/coverage/api/index.html", []byte("<html/>"), 0644)
+			},
+			setupMocks: func(m pipelineTestStageMocks) {
+				wantedBuildspecRun := func(prefix string) func(ctx context.Context, in *dockerengine.RunOptions) error {
+					return func(ctx context.Context, in *dockerengine.RunOptions) error {
+						require.Equal(t, &dockerengine.RunOptions{
+							ImageURI: "public.ecr.aws/codebuild/standard:7.0",
+							EnvVars: map[string]string{
+								"STAGE":                    "local",
+								"COPILOT_APPLICATION_NAME": mockAppName,
+								"COPILOT_ENVIRONMENT_NAME": "test",
+								"AWS_ACCOUNT_ID":           "123456789012",
+								"PARTITION":                "aws",
+								"CODEBUILD_SRC_DIR":        "/codebuild/output/src",
+							},
+							Volumes:    wantedVolumes,
+							WorkingDir: "/codebuild/output/src",
+							Remove:     true,
+							Command: []string{"sh", "-c", `set -e
+yum install -y jq
+./migrate.sh`},
+							LogOptions: dockerengine.RunLogOptions{
+								LinePrefix: prefix,
+							},
+						}, in)
+						return nil
+					}
+				}
+				gomock.InOrder(
+					m.docker.EXPECT().CheckDockerEngineRunning().Return(nil),
+					m.identity.EXPECT().Get().Return(mockCaller, nil),
+					m.docker.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(wantedBuildspecRun("[migrate] ")),
+					m.docker.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(wantedBuildspecRun("[seed] ")),
+					m.docker.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(wantedBuildspecRun("[smoke] ")),
+					m.docker.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, in *dockerengine.RunOptions) error {
+						require.Equal(t, &dockerengine.RunOptions{
+							ImageURI: "public.ecr.aws/codebuild/standard:7.0",
+							EnvVars: map[string]string{
+								"AWS_ACCOUNT_ID":    "123456789012",
+								"PARTITION":         "aws",
+								"CODEBUILD_SRC_DIR": "/codebuild/output/src",
+							},
+							Volumes:    wantedVolumes,
+							WorkingDir: "/codebuild/output/src",
+							Remove:     true,
+							Command: []string{"sh", "-c", `set -e
+make test
+make integ-test`},
+							LogOptions: dockerengine.RunLogOptions{
+								LinePrefix: "[TestCommands] ",
+							},
+						}, in)
+						return nil
+					}),
+				)
+			},
+			wantedArtifacts: []string{
+				"/out/out/report.json",
+				"/out/reports/junit.xml",
+				"/out/reports/integ/junit.xml",
+				"/out/coverage/api/index.html",
+			},
+			wantedNotArtifacts: []string{
+				"/out/out/debug.log",
+				"/out/reports/integ/junit.txt",
+				"/out/copilot/pipelines/phonetool-main/migrate.yml",
+			},
+			wantedWarnings: []string{
+				"Skipping variable DB_HOST of migrate: parameter-store variables are not resolved locally.",
+				"Skipping variable API_KEY of migrate: secrets-manager variables are not resolved locally.",
+				"Skipping variable DB_PASSWORD of migrate: secrets-manager variables are not resolved locally.",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineTestStageMocks{
+				ws:       mocks.NewMockwsPipelineStageReader(ctrl),
+				docker:   mocks.NewMockdockerEngineRunner(ctrl),
+				identity: mocks.NewMockidentityService(ctrl),
+			}
+			m.ws.EXPECT().ProjectRoot().Return(mockRoot).AnyTimes()
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			if tc.setupFS != nil {
+				tc.setupFS(fs)
+			}
+
+			opts := &pipelineTestStageOpts{
+				pipelineTestStageVars: pipelineTestStageVars{
+					name:         mockPipelineName,
+					stage:        tc.inStage.Name,
+					artifactsDir: mockArtifactsDir,
+				},
+				ws:        m.ws,
+				docker:    m.docker,
+				identity:  m.identity,
+				fs:        fs,
+				wsAppName: mockAppName,
+				pipeline:  mockPipeline,
+				pipelineMft: &manifest.Pipeline{
+					Name:   mockPipelineName,
+					Build:  tc.inBuild,
+					Stages: []manifest.PipelineStage{tc.inStage},
+				},
+			}
+
+			buf := new(strings.Builder)
+			log.DiagnosticWriter = buf
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			for _, path := range tc.wantedArtifacts {
+				exists, err := afero.Exists(fs, path)
+				require.NoError(t, err)
+				require.True(t, exists, "artifact %s should be copied", path)
+			}
+			for _, path := range tc.wantedNotArtifacts {
+				exists, err := afero.Exists(fs, path)
+				require.NoError(t, err)
+				require.False(t, exists, "file %s should not be copied", path)
+			}
+			for _, warning := range tc.wantedWarnings {
+				require.Contains(t, buf.String(), warning)
+			}
+		})
+	}
+}
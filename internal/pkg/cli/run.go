@@ -0,0 +1,26 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildRunCmd is the top level command for run.
+func BuildRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Commands for running workloads locally.",
+	}
+
+	cmd.AddCommand(BuildRunLocalCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
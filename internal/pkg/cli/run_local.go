@@ -23,6 +23,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
@@ -33,13 +34,14 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/file"
-	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -49,7 +51,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/docker/orchestrator"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/localqueue"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	termcolor "github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -66,15 +70,34 @@ import (
 )
 
 const (
-	workloadAskPrompt = "Which workload would you like to run locally?"
+	workloadAskPrompt    = "Which workload would you like to run locally?"
+	runLocalEnvAskPrompt = "Which environment's configuration would you like to run the workload with?"
 )
 
+// runLocalWorkloadTypes are the workload types that can be run locally.
+var runLocalWorkloadTypes = []string{
+	manifestinfo.LoadBalancedWebServiceType,
+	manifestinfo.BackendServiceType,
+	manifestinfo.WorkerServiceType,
+	manifestinfo.ScheduledJobType,
+}
+
 const (
 	// Command to retrieve container credentials with ecs exec. See more at https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-iam-roles.html.
 	// Example output: {"AccessKeyId":"ACCESS_KEY_ID","Expiration":"EXPIRATION_DATE","RoleArn":"TASK_ROLE_ARN","SecretAccessKey":"SECRET_ACCESS_KEY","Token":"SECURITY_TOKEN_STRING"}
 	curlContainerCredentialsCmd = "curl 169.254.170.2$AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"
 )
 
+const (
+	localQueueEmulatorTimeout = 30 * time.Second
+	localQueueRetryInterval   = 500 * time.Millisecond
+)
+
+const (
+	localRestartInitialBackoff = time.Second
+	localRestartMaxBackoff     = 30 * time.Second
+)
+
 type containerOrchestrator interface {
 	Start() <-chan error
 	RunTask(orchestrator.Task, ...orchestrator.RunTaskOption)
@@ -103,6 +126,8 @@ type recursiveWatcher interface {
 
 type runLocalVars struct {
 	wkldName      string
+	wkldNames     []string
+	allWkld       bool
 	wkldType      string
 	appName       string
 	envName       string
@@ -112,36 +137,47 @@ type runLocalVars struct {
 	portOverrides portOverrides
 	proxy         bool
 	proxyNetwork  net.IPNet
+	fromManifest  bool
+	localQueues   bool
+	schedule      bool
+	restart       bool
 }
 
 type runLocalOpts struct {
 	runLocalVars
 
-	sel            deploySelector
-	ecsClient      ecsClient
-	ecsExecutor    ecsCommandExecutor
-	ssm            secretGetter
-	secretsManager secretGetter
-	sessProvider   sessionProvider
-	sess           *session.Session
-	envManagerSess *session.Session
-	targetEnv      *config.Environment
-	targetApp      *config.Application
-	store          store
-	ws             wsWlDirReader
-	cmd            execRunner
-	dockerEngine   dockerEngineRunner
-	repository     repositoryService
-	prog           progress
-	orchestrator   containerOrchestrator
-	hostFinder     hostFinder
-	envChecker     versionCompatibilityChecker
-	debounceTime   time.Duration
-	dockerExcludes []string
+	sel              deploySelector
+	wsSel            wsSelector
+	ecsClient        ecsClient
+	ecsExecutor      ecsCommandExecutor
+	ssm              secretGetter
+	secretsManager   secretGetter
+	sessProvider     sessionProvider
+	sess             *session.Session
+	envManagerSess   *session.Session
+	targetEnv        *config.Environment
+	targetApp        *config.Application
+	wkldTypes        map[string]string
+	store            store
+	fs               afero.Fs
+	ws               wsWlDirReader
+	cmd              execRunner
+	dockerEngine     dockerEngineRunner
+	repository       repositoryService
+	prog             progress
+	orchestrator     containerOrchestrator
+	hostFinder       hostFinder
+	envChecker       versionCompatibilityChecker
+	debounceTime     time.Duration
+	jobRetryInterval time.Duration
+	dockerExcludes   []string
+	queueClient      localQueueClient
+	localWorkers     map[string]localqueue.Worker
 
 	newRecursiveWatcher  func() (recursiveWatcher, error)
 	buildContainerImages func(mft manifest.DynamicWorkload) (map[string]string, error)
 	configureClients     func() error
+	newOrchestrator      func() containerOrchestrator
 	labeledTermPrinter   func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) clideploy.LabeledTermPrinter
 	unmarshal            func([]byte) (manifest.DynamicWorkload, error)
 	newInterpolator      func(app, env string) interpolator
@@ -170,10 +206,19 @@ func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
 	labeledTermPrinter := func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) clideploy.LabeledTermPrinter {
 		return syncbuffer.NewLabeledTermPrinter(fw, bufs, opts...)
 	}
+	queueClient, err := newLocalQueueClient()
+	if err != nil {
+		return nil, err
+	}
+	if len(vars.wkldNames) == 1 {
+		vars.wkldName = vars.wkldNames[0]
+	}
 	o := &runLocalOpts{
 		runLocalVars:       vars,
 		sel:                selector.NewDeploySelect(prompt.New(), store, deployStore),
+		wsSel:              selector.NewLocalWorkloadSelector(prompt.New(), store, ws, selector.OnlyInitializedWorkloads),
 		store:              store,
+		fs:                 afero.NewOsFs(),
 		ws:                 ws,
 		newInterpolator:    newManifestInterpolator,
 		sessProvider:       sessProvider,
@@ -183,7 +228,11 @@ func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
 		dockerEngine:       dockerengine.New(exec.NewCmd()),
 		labeledTermPrinter: labeledTermPrinter,
 		prog:               termprogress.NewSpinner(log.DiagnosticWriter),
+		queueClient:        queueClient,
+		localWorkers:       make(map[string]localqueue.Worker),
+		jobRetryInterval:   jobRetryInterval,
 	}
+	colorGen := termcolor.ColorGenerator()
 	o.configureClients = func() error {
 		defaultSessEnvRegion, err := o.sessProvider.DefaultWithRegion(o.targetEnv.Region)
 		if err != nil {
@@ -202,22 +251,37 @@ func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
 		o.ecsExecutor = awsecs.New(o.envManagerSess)
 		o.secretsManager = secretsmanager.New(defaultSessEnvRegion)
 
-		resources, err := cloudformation.New(o.sess, cloudformation.WithProgressTracker(os.Stderr)).GetAppResourcesByRegion(o.targetApp, o.targetEnv.Region)
-		if err != nil {
-			return fmt.Errorf("get application %s resources from region %s: %w", o.appName, o.envName, err)
-		}
 		repoName := clideploy.RepoName(o.appName, o.wkldName)
-		o.repository = repository.NewWithURI(ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[o.wkldName])
+		if o.fromManifest {
+			// The workload may have never been deployed, so its ECR repository might not exist yet.
+			o.repository = &localRepository{
+				uri:    repoName,
+				docker: o.dockerEngine,
+			}
+		} else {
+			resources, err := cloudformation.New(o.sess, cloudformation.WithProgressTracker(os.Stderr)).GetAppResourcesByRegion(o.targetApp, o.targetEnv.Region)
+			if err != nil {
+				return fmt.Errorf("get application %s resources from region %s: %w", o.appName, o.envName, err)
+			}
+			o.repository = repository.NewWithURI(ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[o.wkldName])
+		}
 
 		idPrefix := fmt.Sprintf("%s-%s-%s-", o.appName, o.envName, o.wkldName)
-		colorGen := termcolor.ColorGenerator()
-		o.orchestrator = orchestrator.New(o.dockerEngine, idPrefix, func(name string, ctr orchestrator.ContainerDefinition) dockerengine.RunLogOptions {
-			return dockerengine.RunLogOptions{
-				Color:      colorGen(),
-				Output:     os.Stderr,
-				LinePrefix: fmt.Sprintf("[%s] ", name),
-			}
-		})
+		wkldName := o.wkldName
+		o.newOrchestrator = func() containerOrchestrator {
+			return orchestrator.New(o.dockerEngine, idPrefix, func(name string, ctr orchestrator.ContainerDefinition) dockerengine.RunLogOptions {
+				linePrefix := fmt.Sprintf("[%s] ", name)
+				if o.runsMultipleWorkloads() {
+					linePrefix = fmt.Sprintf("[%s/%s] ", wkldName, name)
+				}
+				return dockerengine.RunLogOptions{
+					Color:      colorGen(),
+					Output:     os.Stderr,
+					LinePrefix: linePrefix,
+				}
+			})
+		}
+		o.orchestrator = o.newOrchestrator()
 
 		o.hostFinder = &hostDiscoverer{
 			app:  o.appName,
@@ -321,14 +385,135 @@ func (o *runLocalOpts) Validate() error {
 		return fmt.Errorf("get application %s: %w", o.appName, err)
 	}
 	o.targetApp = app
+	if o.allWkld && len(o.wkldNames) > 0 {
+		return fmt.Errorf("cannot specify both --%s and --%s", nameFlag, allFlag)
+	}
+	if o.runsMultipleWorkloads() {
+		if o.watch {
+			return fmt.Errorf("--%s cannot be used with multiple workloads", watchFlag)
+		}
+		if o.proxy {
+			return fmt.Errorf("--%s cannot be used with multiple workloads", proxyFlag)
+		}
+		if o.schedule {
+			return fmt.Errorf("--%s cannot be used with multiple workloads", scheduleFlag)
+		}
+	}
+	if o.schedule && o.watch {
+		return fmt.Errorf("cannot specify both --%s and --%s", scheduleFlag, watchFlag)
+	}
+	if o.fromManifest {
+		if o.useTaskRole {
+			return fmt.Errorf("cannot specify both --%s and --%s", fromManifestFlag, useTaskRoleFlag)
+		}
+		if o.proxy {
+			return fmt.Errorf("cannot specify both --%s and --%s", fromManifestFlag, proxyFlag)
+		}
+	}
 	return nil
 }
 
 // Ask prompts the user for any unprovided required fields and validates them.
 func (o *runLocalOpts) Ask() error {
+	if o.runsMultipleWorkloads() {
+		return o.validateAndAskWkldNames()
+	}
+	if o.fromManifest {
+		return o.validateAndAskLocalWkldEnvName()
+	}
 	return o.validateAndAskWkldEnvName()
 }
 
+// runsMultipleWorkloads returns true if more than one workload should be run together.
+func (o *runLocalOpts) runsMultipleWorkloads() bool {
+	return o.allWkld || len(o.wkldNames) > 1
+}
+
+// validateAndAskWkldNames validates the workloads to run together and selects the environment whose configuration they run with.
+// With --all, every workload in the workspace that runs on ECS is selected.
+func (o *runLocalOpts) validateAndAskWkldNames() error {
+	if o.envName == "" {
+		name, err := o.wsSel.Environment(runLocalEnvAskPrompt, "", o.appName)
+		if err != nil {
+			return fmt.Errorf("select an environment: %w", err)
+		}
+		o.envName = name
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %q configuration: %w", o.envName, err)
+	}
+	o.targetEnv = env
+
+	names := o.wkldNames
+	if o.allWkld {
+		names, err = o.ws.ListWorkloads()
+		if err != nil {
+			return fmt.Errorf("list workloads in the workspace: %w", err)
+		}
+	}
+	o.wkldNames = nil
+	o.wkldTypes = make(map[string]string, len(names))
+	for _, name := range names {
+		wkld, err := o.store.GetWorkload(o.appName, name)
+		if err != nil {
+			return fmt.Errorf("get workload %q configuration: %w", name, err)
+		}
+		if !slices.Contains(runLocalWorkloadTypes, wkld.Type) {
+			if o.allWkld {
+				log.Infof("Skipping %q: %s workloads cannot be run locally.\n", name, wkld.Type)
+				continue
+			}
+			return fmt.Errorf("%s %q cannot be run locally", wkld.Type, name)
+		}
+		if wkld.Type == manifestinfo.ScheduledJobType {
+			// Jobs exit once done, which would stop the other workloads.
+			if o.allWkld {
+				log.Infof("Skipping %q: Scheduled Jobs must be run on their own.\n", name)
+				continue
+			}
+			return fmt.Errorf("%s %q must be run on its own", wkld.Type, name)
+		}
+		o.wkldNames = append(o.wkldNames, name)
+		o.wkldTypes[name] = wkld.Type
+	}
+	if len(o.wkldNames) == 0 {
+		return errors.New("no workloads in the workspace can be run locally")
+	}
+	return nil
+}
+
+// validateAndAskLocalWkldEnvName selects a workload from the workspace and any environment
+// in the application, since running from the manifest doesn't require a deployment.
+func (o *runLocalOpts) validateAndAskLocalWkldEnvName() error {
+	if o.wkldName == "" {
+		name, err := o.wsSel.Workload(workloadAskPrompt, "")
+		if err != nil {
+			return fmt.Errorf("select a workload from the workspace: %w", err)
+		}
+		o.wkldName = name
+	}
+	wkld, err := o.store.GetWorkload(o.appName, o.wkldName)
+	if err != nil {
+		return fmt.Errorf("get workload %q configuration: %w", o.wkldName, err)
+	}
+	o.wkldType = wkld.Type
+
+	if o.envName == "" {
+		name, err := o.wsSel.Environment(runLocalEnvAskPrompt, "", o.appName)
+		if err != nil {
+			return fmt.Errorf("select an environment: %w", err)
+		}
+		o.envName = name
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %q configuration: %w", o.envName, err)
+	}
+	o.targetEnv = env
+	return nil
+}
+
 func (o *runLocalOpts) validateAndAskWkldEnvName() error {
 	if o.envName != "" {
 		env, err := o.store.GetEnvironment(o.appName, o.envName)
@@ -363,13 +548,27 @@ func (o *runLocalOpts) validateAndAskWkldEnvName() error {
 
 // Execute builds and runs the workload images locally.
 func (o *runLocalOpts) Execute() error {
+	ctx := context.Background()
+	if o.runsMultipleWorkloads() {
+		return o.executeMultiple(ctx)
+	}
+
 	if err := o.configureClients(); err != nil {
 		return err
 	}
 
-	ctx := context.Background()
+	isJob := o.wkldType == manifestinfo.ScheduledJobType
+	if isJob && o.watch {
+		return fmt.Errorf("--%s cannot be used with a %s", watchFlag, manifestinfo.ScheduledJobType)
+	}
+	if !isJob && o.schedule {
+		return fmt.Errorf("--%s can only be used with a %s", scheduleFlag, manifestinfo.ScheduledJobType)
+	}
+	if isJob && o.restart {
+		return fmt.Errorf("--%s cannot be used with a %s, use its retries instead", restartFlag, manifestinfo.ScheduledJobType)
+	}
 
-	task, err := o.prepareTask(ctx)
+	task, mft, err := o.prepareTaskAndManifest(ctx)
 	if err != nil {
 		return err
 	}
@@ -392,14 +591,40 @@ func (o *runLocalOpts) Execute() error {
 		}
 	}
 
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
-
-	errCh := o.orchestrator.Start()
 	var runTaskOpts []orchestrator.RunTaskOption
 	if o.proxy {
 		runTaskOpts = append(runTaskOpts, orchestrator.RunTaskWithProxy(ssmTarget, o.proxyNetwork, hosts...))
 	}
+	runTaskOpts = append(runTaskOpts, o.restartOpts()...)
+	if isJob {
+		return o.executeJob(ctx, task, mft, runTaskOpts)
+	}
+	cleanUp := func() error { return nil }
+	if o.localQueues && len(o.localWorkers) > 0 {
+		network := o.localNetworkName()
+		if err := o.dockerEngine.CreateNetwork(ctx, network); err != nil {
+			return fmt.Errorf("create network %q: %w", network, err)
+		}
+		stopLocalQueues, err := o.startLocalQueues(ctx, network)
+		if err != nil {
+			return err
+		}
+		cleanUp = func() error {
+			if err := stopLocalQueues(); err != nil {
+				return err
+			}
+			if err := o.dockerEngine.RemoveNetwork(context.Background(), network); err != nil {
+				return fmt.Errorf("remove network %q: %w", network, err)
+			}
+			return nil
+		}
+		runTaskOpts = append(runTaskOpts, orchestrator.RunTaskWithNetwork(network, o.localNetworkAliases(mft)...))
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+
+	errCh := o.orchestrator.Start()
 	o.orchestrator.RunTask(task, runTaskOpts...)
 
 	var watchCh <-chan interface{}
@@ -419,7 +644,7 @@ func (o *runLocalOpts) Execute() error {
 			// closes errCh when the orchestrator is completely done.
 			if !ok {
 				close(stopCh)
-				return nil
+				return cleanUp()
 			}
 
 			log.Errorf("error: %s\n", err)
@@ -437,16 +662,168 @@ func (o *runLocalOpts) Execute() error {
 				o.orchestrator.Stop()
 				break
 			}
+			if o.localQueues && len(o.localWorkers) > 0 {
+				// Subscriptions may have changed, so create any new queues before restarting the task.
+				queueCtx, cancel := context.WithTimeout(ctx, localQueueEmulatorTimeout)
+				err := o.createLocalQueues(queueCtx, nil)
+				cancel()
+				if err != nil {
+					log.Errorf("rerun task: %s\n", err)
+					o.orchestrator.Stop()
+					break
+				}
+			}
 
 			// If TaskRole is retrieved through ECS Exec, OS signals are no longer provided to the channel.
 			// We reset this channel connection through this call as a short term fix that allows
 			// the interrupt and terminate signal to stop tasks after the task has been restarted by --watch.
 			signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
-			o.orchestrator.RunTask(task)
+			o.orchestrator.RunTask(task, o.restartOpts()...)
 		}
 	}
 }
 
+// localRun is a task to run locally for a workload, along with the Orchestrator running it.
+type localRun struct {
+	wkld         string
+	task         orchestrator.Task
+	aliases      []string
+	orchestrator containerOrchestrator
+}
+
+// executeMultiple runs the tasks of all the selected workloads together on a shared docker network,
+// so that they can reach each other through their Service Connect and service discovery names.
+func (o *runLocalOpts) executeMultiple(ctx context.Context) error {
+	var runs []localRun
+	for _, name := range o.wkldNames {
+		o.wkldName, o.wkldType = name, o.wkldTypes[name]
+		if err := o.configureClients(); err != nil {
+			return fmt.Errorf("configure clients for %q: %w", name, err)
+		}
+		task, mft, err := o.prepareTaskAndManifest(ctx)
+		if err != nil {
+			return fmt.Errorf("prepare task for %q: %w", name, err)
+		}
+		if !o.bindsHostPorts(name) {
+			// Workloads reach each other on the shared network, and binding all their ports
+			// on localhost would fail as soon as two of them listen on the same port.
+			for ctrName, ctr := range task.Containers {
+				ctr.Ports = nil
+				task.Containers[ctrName] = ctr
+			}
+		}
+		runs = append(runs, localRun{
+			wkld:         name,
+			task:         task,
+			aliases:      o.localNetworkAliases(mft),
+			orchestrator: o.orchestrator,
+		})
+	}
+
+	network := o.localNetworkName()
+	if err := o.dockerEngine.CreateNetwork(ctx, network); err != nil {
+		return fmt.Errorf("create network %q: %w", network, err)
+	}
+	stopLocalQueues := func() error { return nil }
+	if o.localQueues && len(o.localWorkers) > 0 {
+		stop, err := o.startLocalQueues(ctx, network)
+		if err != nil {
+			return err
+		}
+		stopLocalQueues = stop
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+
+	errCh := make(chan error)
+	var wg sync.WaitGroup
+	for _, run := range runs {
+		run := run
+		runErrCh := run.orchestrator.Start()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// forward errors until runErrCh closes, since Start()
+			// closes it when the orchestrator is completely done.
+			for err := range runErrCh {
+				errCh <- fmt.Errorf("%s: %w", run.wkld, err)
+			}
+		}()
+		run.orchestrator.RunTask(run.task, append(o.restartOpts(), orchestrator.RunTaskWithNetwork(network, run.aliases...))...)
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	stopAll := func() {
+		for _, run := range runs {
+			run.orchestrator.Stop()
+		}
+	}
+	for {
+		select {
+		case <-done:
+			if err := stopLocalQueues(); err != nil {
+				return err
+			}
+			if err := o.dockerEngine.RemoveNetwork(context.Background(), network); err != nil {
+				return fmt.Errorf("remove network %q: %w", network, err)
+			}
+			return nil
+		case err := <-errCh:
+			log.Errorf("error: %s\n", err)
+			stopAll()
+		case <-sigCh:
+			signal.Stop(sigCh)
+			stopAll()
+		}
+	}
+}
+
+// bindsHostPorts returns true if the ports of the workload should be bound on localhost when running multiple workloads:
+// Load Balanced Web Services are reached from outside of the environment, and other workloads only when named in a --port-override.
+func (o *runLocalOpts) bindsHostPorts(wkld string) bool {
+	return o.wkldTypes[wkld] == manifestinfo.LoadBalancedWebServiceType || o.portOverrides.namesWorkload(wkld)
+}
+
+// restartOpts returns the options that restart the containers of a task with --restart.
+func (o *runLocalOpts) restartOpts() []orchestrator.RunTaskOption {
+	if !o.restart {
+		return nil
+	}
+	return []orchestrator.RunTaskOption{
+		orchestrator.RunTaskWithRestartPolicy(orchestrator.RestartPolicy{
+			InitialBackoff: localRestartInitialBackoff,
+			MaxBackoff:     localRestartMaxBackoff,
+		}),
+	}
+}
+
+// localNetworkAliases returns the names other workloads use to reach the workload described by mft:
+// its name, its service discovery name and, if set, its Service Connect alias.
+func (o *runLocalOpts) localNetworkAliases(mft manifest.DynamicWorkload) []string {
+	aliases := []string{
+		o.wkldName,
+		fmt.Sprintf("%s.%s.%s.local", o.wkldName, o.envName, o.appName),
+	}
+	var connect manifest.ServiceConnectBoolOrArgs
+	switch m := mft.Manifest().(type) {
+	case *manifest.LoadBalancedWebService:
+		connect = m.Network.Connect
+	case *manifest.BackendService:
+		connect = m.Network.Connect
+	case *manifest.WorkerService:
+		connect = m.Network.Connect
+	}
+	if alias := aws.StringValue(connect.Alias); alias != "" && alias != o.wkldName {
+		aliases = append(aliases, alias)
+	}
+	return aliases
+}
+
 // getSSMTarget returns a AWS SSM target for a running container
 // that supports ECS Service Exec.
 func (o *runLocalOpts) getSSMTarget(ctx context.Context) (string, error) {
@@ -533,6 +910,7 @@ func (o *runLocalOpts) getTask(ctx context.Context) (orchestrator.Task, error) {
 			Ports:       make(map[string]string, len(ctr.PortMappings)),
 			IsEssential: containerDeps[name].isEssential,
 			DependsOn:   containerDeps[name].dependsOn,
+			HealthCheck: taskDefHealthCheck(ctr.HealthCheck),
 		}
 
 		for _, port := range ctr.PortMappings {
@@ -543,7 +921,7 @@ func (o *runLocalOpts) getTask(ctx context.Context) (orchestrator.Task, error) {
 			}
 
 			for _, override := range o.portOverrides {
-				if override.container == ctrPort {
+				if override.appliesTo(o.wkldName, ctrPort) {
 					hostPort = override.host
 					break
 				}
@@ -558,10 +936,179 @@ func (o *runLocalOpts) getTask(ctx context.Context) (orchestrator.Task, error) {
 	return task, nil
 }
 
-func (o *runLocalOpts) prepareTask(ctx context.Context) (orchestrator.Task, error) {
-	task, err := o.getTask(ctx)
+// getTaskFromManifest returns the task defined by the workload manifest, after environment overrides
+// have been applied, instead of the workload's deployed task definition.
+func (o *runLocalOpts) getTaskFromManifest(ctx context.Context, mft manifest.DynamicWorkload) (orchestrator.Task, error) {
+	ctrs := manifest.ContainerConfigs(mft.Manifest())
+	if len(ctrs) == 0 {
+		return orchestrator.Task{}, fmt.Errorf("workload type %q cannot be run from its manifest", o.wkldType)
+	}
+
+	envVars, err := o.getEnvVarsFromManifest(ctx, ctrs)
 	if err != nil {
-		return orchestrator.Task{}, fmt.Errorf("get task: %w", err)
+		return orchestrator.Task{}, fmt.Errorf("get env vars: %w", err)
+	}
+
+	deps := manifest.ContainerDependencies(mft.Manifest())
+	task := orchestrator.Task{
+		Containers: make(map[string]orchestrator.ContainerDefinition, len(ctrs)),
+	}
+	for name, ctr := range ctrs {
+		def := orchestrator.ContainerDefinition{
+			ImageURI:    ctr.Image,
+			EnvVars:     envVars[name].EnvVars(),
+			Secrets:     envVars[name].Secrets(),
+			Ports:       make(map[string]string),
+			IsEssential: deps[name].IsEssential,
+			HealthCheck: manifestHealthCheck(ctr.HealthCheck),
+		}
+		if len(deps[name].DependsOn) > 0 {
+			def.DependsOn = deps[name].DependsOn
+		}
+		if ctr.Port != "" {
+			hostPort := ctr.Port
+			for _, override := range o.portOverrides {
+				if override.appliesTo(o.wkldName, ctr.Port) {
+					hostPort = override.host
+					break
+				}
+			}
+			def.Ports[hostPort] = ctr.Port
+		}
+		task.Containers[name] = def
+	}
+	return task, nil
+}
+
+// getEnvVarsFromManifest returns a set of environment variables for each container defined in ctrs,
+// in the same format as getEnvVars. Variables are resolved in the same order as ECS:
+// values in env files are overwritten by the manifest variables, which are overwritten by flags.
+func (o *runLocalOpts) getEnvVarsFromManifest(ctx context.Context, ctrs map[string]manifest.ContainerConfig) (map[string]containerEnv, error) {
+	envVars := make(map[string]containerEnv, len(ctrs))
+	var secrets []*awsecs.ContainerSecret
+	for name, ctr := range ctrs {
+		env := make(containerEnv)
+		if ctr.EnvFile != "" {
+			vars, err := o.readEnvFile(ctr.EnvFile)
+			if err != nil {
+				return nil, fmt.Errorf("read env file for container %q: %w", name, err)
+			}
+			for k, v := range vars {
+				env[k] = envVarValue{
+					Value: v,
+				}
+			}
+		}
+		for k, v := range o.copilotEnvVars() {
+			env[k] = envVarValue{
+				Value: v,
+			}
+		}
+		for k, v := range ctr.Variables {
+			if v.RequiresImport() {
+				return nil, fmt.Errorf("variable %q in container %q imports a value from CloudFormation, which cannot be resolved locally", k, name)
+			}
+			env[k] = envVarValue{
+				Value: v.Value(),
+			}
+		}
+		envVars[name] = env
+
+		for k, v := range ctr.Secrets {
+			valueFrom, err := o.secretValueFrom(v)
+			if err != nil {
+				return nil, fmt.Errorf("secret %q in container %q: %w", k, name, err)
+			}
+			secrets = append(secrets, &awsecs.ContainerSecret{
+				Name:      k,
+				Container: name,
+				ValueFrom: valueFrom,
+			})
+		}
+	}
+
+	if err := o.fillEnvOverrides(envVars); err != nil {
+		return nil, fmt.Errorf("parse env overrides: %w", err)
+	}
+
+	if err := o.fillSecrets(ctx, envVars, secrets); err != nil {
+		return nil, fmt.Errorf("get secrets: %w", err)
+	}
+
+	if err := o.fillSessionVars(ctx, envVars); err != nil {
+		return nil, err
+	}
+	return envVars, nil
+}
+
+// copilotEnvVars returns the environment variables that Copilot injects into every container of a deployed task.
+func (o *runLocalOpts) copilotEnvVars() map[string]string {
+	return map[string]string{
+		"COPILOT_APPLICATION_NAME":           o.appName,
+		"COPILOT_ENVIRONMENT_NAME":           o.envName,
+		"COPILOT_SERVICE_NAME":               o.wkldName,
+		"COPILOT_SERVICE_DISCOVERY_ENDPOINT": fmt.Sprintf("%s.%s.local", o.envName, o.appName),
+	}
+}
+
+// secretValueFrom returns the SSM parameter name or ARN that a secret in the manifest refers to.
+func (o *runLocalOpts) secretValueFrom(secret manifest.Secret) (string, error) {
+	if secret.RequiresImport() {
+		return "", errors.New("secrets imported from CloudFormation cannot be resolved locally")
+	}
+	if !secret.IsSecretsManagerName() {
+		return secret.Value(), nil
+	}
+	partition, err := partitions.Region(o.targetEnv.Region).Partition()
+	if err != nil {
+		return "", err
+	}
+	return arn.ARN{
+		Partition: partition.ID(),
+		Service:   sdksecretsmanager.ServiceName,
+		Region:    o.targetEnv.Region,
+		AccountID: o.targetEnv.AccountID,
+		Resource:  fmt.Sprintf("secret:%s", secret.Value()),
+	}.String(), nil
+}
+
+// readEnvFile parses an env file relative to the workspace root.
+// Like ECS, each line is in the format KEY=VALUE, and lines starting with "#" are ignored.
+func (o *runLocalOpts) readEnvFile(path string) (map[string]string, error) {
+	content, err := afero.ReadFile(o.fs, filepath.Join(o.ws.Path(), path))
+	if err != nil {
+		return nil, err
+	}
+	vars := make(map[string]string)
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d of %s is not in the format KEY=VALUE", i+1, path)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
+
+func (o *runLocalOpts) prepareTask(ctx context.Context) (orchestrator.Task, error) {
+	task, _, err := o.prepareTaskAndManifest(ctx)
+	return task, err
+}
+
+// prepareTaskAndManifest returns the task to run locally with locally built images,
+// along with the interpolated workload manifest it was prepared from.
+func (o *runLocalOpts) prepareTaskAndManifest(ctx context.Context) (orchestrator.Task, manifest.DynamicWorkload, error) {
+	var task orchestrator.Task
+	if !o.fromManifest {
+		var err error
+		task, err = o.getTask(ctx)
+		if err != nil {
+			return orchestrator.Task{}, nil, fmt.Errorf("get task: %w", err)
+		}
 	}
 
 	mft, _, err := workloadManifest(&workloadManifestInput{
@@ -574,19 +1121,25 @@ func (o *runLocalOpts) prepareTask(ctx context.Context) (orchestrator.Task, erro
 		sess:         o.envManagerSess,
 	})
 	if err != nil {
-		return orchestrator.Task{}, err
+		return orchestrator.Task{}, nil, err
+	}
+	if o.fromManifest {
+		task, err = o.getTaskFromManifest(ctx, mft)
+		if err != nil {
+			return orchestrator.Task{}, nil, fmt.Errorf("get task from manifest: %w", err)
+		}
 	}
 
 	containerURIs, err := o.buildContainerImages(mft)
 	if err != nil {
-		return orchestrator.Task{}, fmt.Errorf("build images: %w", err)
+		return orchestrator.Task{}, nil, fmt.Errorf("build images: %w", err)
 	}
 
 	// replace built images with the local built URI
 	for name, uri := range containerURIs {
 		ctr, ok := task.Containers[name]
 		if !ok {
-			return orchestrator.Task{}, fmt.Errorf("built an image for %q, which doesn't exist in the task", name)
+			return orchestrator.Task{}, nil, fmt.Errorf("built an image for %q, which doesn't exist in the task", name)
 		}
 
 		ctr.ImageURI = uri
@@ -596,15 +1149,146 @@ func (o *runLocalOpts) prepareTask(ctx context.Context) (orchestrator.Task, erro
 	containerDeps := manifest.ContainerDependencies(mft.Manifest())
 	for name, dep := range containerDeps {
 		ctr, ok := task.Containers[name]
+		if !ok && o.fromManifest && name == manifest.FirelensContainerName {
+			// The log router requires the ECS agent, so it isn't run locally.
+			continue
+		}
 		if !ok {
-			return orchestrator.Task{}, fmt.Errorf("missing container: %q is listed as a dependency, which doesn't exist in the task", name)
+			return orchestrator.Task{}, nil, fmt.Errorf("missing container: %q is listed as a dependency, which doesn't exist in the task", name)
 		}
 		ctr.IsEssential = dep.IsEssential
 		ctr.DependsOn = dep.DependsOn
 		task.Containers[name] = ctr
 	}
 
-	return task, nil
+	if o.localQueues {
+		if err := o.setLocalQueueEnvVars(task, mft); err != nil {
+			return orchestrator.Task{}, nil, err
+		}
+	}
+	return task, mft, nil
+}
+
+// setLocalQueueEnvVars points the main container of a Worker Service to its queues on the local queue emulator,
+// and records the queues that need to be created.
+func (o *runLocalOpts) setLocalQueueEnvVars(task orchestrator.Task, mft manifest.DynamicWorkload) error {
+	worker, ok := mft.Manifest().(*manifest.WorkerService)
+	if !ok {
+		return nil
+	}
+	ctr, ok := task.Containers[o.wkldName]
+	if !ok {
+		return fmt.Errorf("missing main container %q in the task", o.wkldName)
+	}
+	w := localqueue.NewWorker(o.wkldName, worker)
+	envVars, err := w.EnvVars()
+	if err != nil {
+		return fmt.Errorf("get local queue environment variables: %w", err)
+	}
+	if ctr.EnvVars == nil {
+		ctr.EnvVars = make(map[string]string)
+	}
+	for k, v := range envVars {
+		ctr.EnvVars[k] = v
+	}
+	task.Containers[o.wkldName] = ctr
+	o.localWorkers[o.wkldName] = w
+	return nil
+}
+
+// startLocalQueues runs the queue emulator on network and creates the queues of the Worker Services being run.
+// It returns a function that stops the emulator.
+func (o *runLocalOpts) startLocalQueues(ctx context.Context, network string) (stop func() error, err error) {
+	ctrName := fmt.Sprintf("%s-%s-local-sqs", o.appName, o.envName)
+	runErrCh := make(chan error, 1)
+	go func() {
+		runErrCh <- o.dockerEngine.Run(ctx, &dockerengine.RunOptions{
+			ImageURI:      localqueue.EmulatorImage,
+			ContainerName: ctrName,
+			ContainerPorts: map[string]string{
+				localqueue.EmulatorPort: localqueue.EmulatorPort,
+			},
+			Network:        network,
+			NetworkAliases: []string{localqueue.EmulatorHost},
+			LogOptions: dockerengine.RunLogOptions{
+				Output:     os.Stderr,
+				LinePrefix: "[local-sqs] ",
+			},
+		})
+	}()
+	stop = func() error {
+		if err := o.dockerEngine.Stop(context.Background(), ctrName); err != nil {
+			return fmt.Errorf("stop queue emulator: %w", err)
+		}
+		if err := o.dockerEngine.Rm(context.Background(), ctrName); err != nil {
+			return fmt.Errorf("remove queue emulator: %w", err)
+		}
+		return nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, localQueueEmulatorTimeout)
+	defer cancel()
+	if err := o.createLocalQueues(waitCtx, runErrCh); err != nil {
+		if stopErr := stop(); stopErr != nil {
+			log.Errorf("%s\n", stopErr)
+		}
+		return nil, err
+	}
+	return stop, nil
+}
+
+// createLocalQueues creates the queues of the Worker Services, retrying until the emulator accepts requests.
+func (o *runLocalOpts) createLocalQueues(ctx context.Context, emulatorErrCh <-chan error) error {
+	var queues []string
+	for _, name := range o.wkldNamesToRun() {
+		if w, ok := o.localWorkers[name]; ok {
+			queues = append(queues, w.Queues()...)
+		}
+	}
+	ticker := time.NewTicker(localQueueRetryInterval)
+	defer ticker.Stop()
+	for _, queue := range queues {
+		for {
+			_, err := o.queueClient.CreateQueue(ctx, queue)
+			if err == nil {
+				log.Successf("Created local queue %q.\n", queue)
+				break
+			}
+			select {
+			case <-ticker.C:
+			case err := <-emulatorErrCh:
+				return fmt.Errorf("run queue emulator: %w", err)
+			case <-ctx.Done():
+				return fmt.Errorf("create local queue %q: %w", queue, err)
+			}
+		}
+	}
+	return nil
+}
+
+// newLocalQueueClient returns a client to the queue emulator published on the host.
+func newLocalQueueClient() (*sqs.SQS, error) {
+	sess, err := session.NewSession(aws.NewConfig().
+		WithEndpoint(fmt.Sprintf("http://localhost:%s", localqueue.EmulatorPort)).
+		WithRegion(localqueue.Region).
+		WithCredentials(credentials.NewStaticCredentials("copilot", "local", "")))
+	if err != nil {
+		return nil, fmt.Errorf("create session for the local queue emulator: %w", err)
+	}
+	return sqs.New(sess), nil
+}
+
+// wkldNamesToRun returns the names of the workloads being run.
+func (o *runLocalOpts) wkldNamesToRun() []string {
+	if o.runsMultipleWorkloads() {
+		return o.wkldNames
+	}
+	return []string{o.wkldName}
+}
+
+// localNetworkName returns the name of the docker network shared by the containers run locally.
+func (o *runLocalOpts) localNetworkName() string {
+	return fmt.Sprintf("copilot-%s-%s", o.appName, o.envName)
 }
 
 func (o *runLocalOpts) filterDockerExcludes() {
@@ -930,14 +1614,22 @@ func (o *runLocalOpts) getEnvVars(ctx context.Context, taskDef *awsecs.TaskDefin
 		return nil, fmt.Errorf("parse env overrides: %w", err)
 	}
 
-	if err := o.fillSecrets(ctx, envVars, taskDef); err != nil {
+	if err := o.fillSecrets(ctx, envVars, taskDef.Secrets()); err != nil {
 		return nil, fmt.Errorf("get secrets: %w", err)
 	}
 
-	// inject session variables if they haven't been already set
+	if err := o.fillSessionVars(ctx, envVars); err != nil {
+		return nil, err
+	}
+	return envVars, nil
+}
+
+// fillSessionVars injects the credentials of the default session into
+// each container, unless they have already been set.
+func (o *runLocalOpts) fillSessionVars(ctx context.Context, envVars map[string]containerEnv) error {
 	sessionVars, err := sessionEnvVars(ctx, o.sess)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	for ctr := range envVars {
@@ -950,8 +1642,7 @@ func (o *runLocalOpts) getEnvVars(ctx context.Context, taskDef *awsecs.TaskDefin
 			}
 		}
 	}
-
-	return envVars, nil
+	return nil
 }
 
 // fillEnvOverrides parses environment variable overrides passed via flag.
@@ -986,12 +1677,12 @@ func (o *runLocalOpts) fillEnvOverrides(envVars map[string]containerEnv) error {
 	return nil
 }
 
-// fillSecrets collects non-overridden secrets from the task definition and
-// makes requests to SSM and Secrets Manager to get their value.
-func (o *runLocalOpts) fillSecrets(ctx context.Context, envVars map[string]containerEnv, taskDef *awsecs.TaskDefinition) error {
+// fillSecrets collects non-overridden secrets and makes requests
+// to SSM and Secrets Manager to get their value.
+func (o *runLocalOpts) fillSecrets(ctx context.Context, envVars map[string]containerEnv, secrets []*awsecs.ContainerSecret) error {
 	// figure out which secrets we need to get, set value to ValueFrom
 	unique := make(map[string]string)
-	for _, s := range taskDef.Secrets() {
+	for _, s := range secrets {
 		cur, ok := envVars[s.Container][s.Name]
 		if cur.Override {
 			// ignore secrets that were overridden
@@ -1084,6 +1775,59 @@ func (o *runLocalOpts) getContainerDependencies(taskDef *awsecs.TaskDefinition)
 	return dependencies
 }
 
+// taskDefHealthCheck returns the health check of a container in a task definition, or nil if it has none.
+func taskDefHealthCheck(hc *sdkecs.HealthCheck) *dockerengine.HealthCheck {
+	if hc == nil {
+		return nil
+	}
+	return &dockerengine.HealthCheck{
+		Command:     aws.StringValueSlice(hc.Command),
+		Interval:    time.Duration(aws.Int64Value(hc.Interval)) * time.Second,
+		Timeout:     time.Duration(aws.Int64Value(hc.Timeout)) * time.Second,
+		StartPeriod: time.Duration(aws.Int64Value(hc.StartPeriod)) * time.Second,
+		Retries:     int(aws.Int64Value(hc.Retries)),
+	}
+}
+
+// manifestHealthCheck returns the health check of a container in a manifest with the same defaults as ECS, or nil if it has none.
+func manifestHealthCheck(hc manifest.ContainerHealthCheck) *dockerengine.HealthCheck {
+	if hc.IsEmpty() {
+		return nil
+	}
+	hc.ApplyIfNotSet(manifest.NewDefaultContainerHealthCheck())
+	return &dockerengine.HealthCheck{
+		Command:     hc.Command,
+		Interval:    *hc.Interval,
+		Timeout:     *hc.Timeout,
+		StartPeriod: *hc.StartPeriod,
+		Retries:     aws.IntValue(hc.Retries),
+	}
+}
+
+// localRepository builds images without pushing them to a remote repository.
+type localRepository struct {
+	uri    string
+	docker dockerEngineRunner
+}
+
+// Login returns the local name for images built by the repository.
+func (r *localRepository) Login() (string, error) {
+	return r.uri, nil
+}
+
+// Build builds the image from Dockerfile.
+func (r *localRepository) Build(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (string, error) {
+	if err := r.docker.Build(ctx, args, w); err != nil {
+		return "", fmt.Errorf("build from Dockerfile at %s: %w", args.Dockerfile, err)
+	}
+	return "", nil
+}
+
+// BuildAndPush builds the image from Dockerfile. The image is never pushed.
+func (r *localRepository) BuildAndPush(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (string, error) {
+	return r.Build(ctx, args, w)
+}
+
 type hostDiscoverer struct {
 	ecs  ecsClient
 	app  string
@@ -1223,7 +1967,7 @@ func (h *hostDiscoverer) rdsHosts(ctx context.Context) ([]orchestrator.Host, err
 func BuildRunLocalCmd() *cobra.Command {
 	vars := runLocalVars{}
 	cmd := &cobra.Command{
-		Use:   "run local",
+		Use:   "local",
 		Short: "Run the workload locally.",
 		Long:  "Run the workload locally.",
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
@@ -1233,20 +1977,23 @@ func BuildRunLocalCmd() *cobra.Command {
 			}
 			return run(opts)
 		}),
-		Annotations: map[string]string{
-			"group": group.Develop,
-		},
 	}
 	cmd.SetUsageTemplate(cmdtemplate.Usage)
+	cmd.AddCommand(buildRunLocalPublishCmd())
 
-	cmd.Flags().StringVarP(&vars.wkldName, nameFlag, nameFlagShort, "", workloadFlagDescription)
+	cmd.Flags().StringSliceVarP(&vars.wkldNames, nameFlag, nameFlagShort, nil, runLocalWorkloadsFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.allWkld, allFlag, false, runLocalAllFlagDescription)
 	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, watchFlagDescription)
 	cmd.Flags().BoolVar(&vars.useTaskRole, useTaskRoleFlag, false, useTaskRoleFlagDescription)
 	cmd.Flags().Var(&vars.portOverrides, portOverrideFlag, portOverridesFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.envOverrides, envVarOverrideFlag, nil, envVarOverrideFlagDescription)
 	cmd.Flags().BoolVar(&vars.proxy, proxyFlag, false, proxyFlagDescription)
+	cmd.Flags().BoolVar(&vars.fromManifest, fromManifestFlag, false, fromManifestFlagDescription)
+	cmd.Flags().BoolVar(&vars.localQueues, localQueuesFlag, false, localQueuesFlagDescription)
+	cmd.Flags().BoolVar(&vars.schedule, scheduleFlag, false, runLocalScheduleFlagDescription)
+	cmd.Flags().BoolVar(&vars.restart, restartFlag, false, restartFlagDescription)
 	cmd.Flags().IPNetVar(&vars.proxyNetwork, proxyNetworkFlag, net.IPNet{
 		// docker uses 172.17.0.0/16 for networking by default
 		// so we'll default to different /16 from the 172.16.0.0/12
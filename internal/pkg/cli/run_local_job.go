@@ -0,0 +1,271 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/signal"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/docker/orchestrator"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/robfig/cron/v3"
+)
+
+// Retry settings of the state machine that runs Scheduled Jobs in ECS.
+const (
+	jobRetryInterval    = 10 * time.Second
+	jobRetryBackoffRate = 1.5
+)
+
+const (
+	jobScheduleNone = "none"
+	awsCronYearAny  = "*"
+)
+
+var localJobRateRegexp = regexp.MustCompile(`^rate\(\s*(\d+)\s+(minutes?|hours?|days?)\s*\)$`)
+
+// localJob holds the configuration of a Scheduled Job that run local honors.
+type localJob struct {
+	schedule cron.Schedule // Nil if the job is only run on demand.
+	retries  int
+	timeout  time.Duration // Zero if the job has no timeout.
+}
+
+// newLocalJob returns the local configuration of the Scheduled Job manifest.
+// The schedule is only parsed if withSchedule is true.
+func newLocalJob(mft *manifest.ScheduledJob, withSchedule bool) (localJob, error) {
+	job := localJob{
+		retries: aws.IntValue(mft.Retries),
+	}
+	if mft.Timeout != nil {
+		timeout, err := time.ParseDuration(aws.StringValue(mft.Timeout))
+		if err != nil {
+			return localJob{}, fmt.Errorf("parse timeout %q: %w", aws.StringValue(mft.Timeout), err)
+		}
+		job.timeout = timeout
+	}
+	if !withSchedule {
+		return job, nil
+	}
+	schedule, err := localJobSchedule(aws.StringValue(mft.On.Schedule))
+	if err != nil {
+		return localJob{}, err
+	}
+	job.schedule = schedule
+	return job, nil
+}
+
+// localJobSchedule parses a manifest schedule expression: a rate or cron expression in the
+// EventBridge format, a predefined schedule such as "@daily" or "@every 1h", or a standard cron expression.
+func localJobSchedule(expr string) (cron.Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	switch {
+	case expr == "" || expr == jobScheduleNone:
+		return nil, errors.New(`the job has no schedule, remove --schedule to run it once`)
+	case strings.HasPrefix(expr, "rate("):
+		matches := localJobRateRegexp.FindStringSubmatch(expr)
+		if matches == nil {
+			return nil, fmt.Errorf("rate expression %q must be of the form rate(value unit)", expr)
+		}
+		value, _ := strconv.Atoi(matches[1])
+		if value <= 0 {
+			return nil, fmt.Errorf("rate expression %q must have a positive value", expr)
+		}
+		unit := time.Minute
+		switch strings.TrimSuffix(matches[2], "s") {
+		case "hour":
+			unit = time.Hour
+		case "day":
+			unit = 24 * time.Hour
+		}
+		return cron.Every(time.Duration(value) * unit), nil
+	case strings.HasPrefix(expr, "cron("):
+		return parseAWSCron(expr)
+	default:
+		schedule, err := cron.ParseStandard(expr)
+		if err != nil {
+			return nil, fmt.Errorf("parse schedule %q: %w", expr, err)
+		}
+		return schedule, nil
+	}
+}
+
+// parseAWSCron parses an EventBridge cron expression "cron(minutes hours day-of-month month day-of-week year)".
+// Only expressions that run every year are supported, and days of the week are numbered from 1 (Sunday) to 7.
+func parseAWSCron(expr string) (cron.Schedule, error) {
+	fields := strings.Fields(strings.TrimSuffix(strings.TrimPrefix(expr, "cron("), ")"))
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("cron expression %q must have 6 fields", expr)
+	}
+	if fields[5] != awsCronYearAny {
+		return nil, fmt.Errorf("cron expression %q must run every year to be run locally", expr)
+	}
+	dow, err := zeroIndexedDaysOfWeek(fields[4])
+	if err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+	}
+	fields[4] = dow
+	schedule, err := cron.ParseStandard(strings.Join(fields[:5], " "))
+	if err != nil {
+		return nil, fmt.Errorf("parse cron expression %q: %w", expr, err)
+	}
+	return schedule, nil
+}
+
+// zeroIndexedDaysOfWeek converts the numeric days of the week in a day-of-week field
+// from the EventBridge range 1-7 to the standard cron range 0-6.
+func zeroIndexedDaysOfWeek(field string) (string, error) {
+	items := strings.Split(field, ",")
+	for i, item := range items {
+		// Only the range of an item is made of days, not its step increment.
+		rng, step, hasStep := strings.Cut(item, "/")
+		days := strings.Split(rng, "-")
+		for j, day := range days {
+			num, err := strconv.Atoi(day)
+			if err != nil {
+				continue
+			}
+			if num < 1 || num > 7 {
+				return "", fmt.Errorf("day of week %d must be between 1 and 7", num)
+			}
+			days[j] = strconv.Itoa(num - 1)
+		}
+		items[i] = strings.Join(days, "-")
+		if hasStep {
+			items[i] += "/" + step
+		}
+	}
+	return strings.Join(items, ","), nil
+}
+
+// executeJob runs a Scheduled Job until it completes, or on its schedule until interrupted if --schedule is set.
+func (o *runLocalOpts) executeJob(ctx context.Context, task orchestrator.Task, mft manifest.DynamicWorkload, runTaskOpts []orchestrator.RunTaskOption) error {
+	jobMft, ok := mft.Manifest().(*manifest.ScheduledJob)
+	if !ok {
+		return fmt.Errorf("workload %q is not a Scheduled Job", o.wkldName)
+	}
+	job, err := newLocalJob(jobMft, o.schedule)
+	if err != nil {
+		return fmt.Errorf("read job %q configuration: %w", o.wkldName, err)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	if job.schedule == nil {
+		if err := o.runJob(ctx, task, job, runTaskOpts); err != nil && ctx.Err() == nil {
+			return err
+		}
+		return nil
+	}
+	for {
+		next := job.schedule.Next(time.Now())
+		log.Infof("Next execution of job %q is at %s.\n", o.wkldName, next.Format(time.RFC1123))
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+		if err := o.runJob(ctx, task, job, runTaskOpts); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Errorf("%s\n", err)
+		}
+	}
+}
+
+// runJob executes the job once the way its state machine does in ECS: failed attempts are retried
+// up to the job's retries with a backoff, and the execution fails once its timeout is reached.
+func (o *runLocalOpts) runJob(ctx context.Context, task orchestrator.Task, job localJob, runTaskOpts []orchestrator.RunTaskOption) error {
+	if job.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, job.timeout)
+		defer cancel()
+	}
+	timedOut := func() error {
+		return fmt.Errorf("job %q timed out after %s", o.wkldName, job.timeout)
+	}
+
+	attempts := job.retries + 1
+	interval := o.jobRetryInterval
+	for attempt := 1; ; attempt++ {
+		exitCode, err := o.runJobAttempt(ctx, task, runTaskOpts)
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			return timedOut()
+		case err != nil && ctx.Err() != nil:
+			return err
+		case err != nil:
+			log.Errorf("Job %q attempt %d of %d failed: %s\n", o.wkldName, attempt, attempts, err)
+		case exitCode == 0:
+			log.Successf("Job %q attempt %d of %d exited with code 0.\n", o.wkldName, attempt, attempts)
+			return nil
+		default:
+			log.Errorf("Job %q attempt %d of %d exited with code %d.\n", o.wkldName, attempt, attempts, exitCode)
+		}
+		if attempt == attempts {
+			return fmt.Errorf("job %q failed after %d attempt(s)", o.wkldName, attempts)
+		}
+
+		log.Infof("Retrying job %q in %s.\n", o.wkldName, interval)
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return timedOut()
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+		interval = time.Duration(float64(interval) * jobRetryBackoffRate)
+	}
+}
+
+// runJobAttempt runs the job's task until an essential container exits and returns its exit code.
+func (o *runLocalOpts) runJobAttempt(ctx context.Context, task orchestrator.Task, runTaskOpts []orchestrator.RunTaskOption) (int, error) {
+	orch := o.newOrchestrator()
+	errCh := orch.Start()
+	orch.RunTask(task, append(runTaskOpts, orchestrator.RunTaskToCompletion())...)
+
+	var exitCode int
+	var runErr error
+	select {
+	case err, ok := <-errCh:
+		var exited *orchestrator.ErrTaskExited
+		switch {
+		case !ok:
+			runErr = errors.New("task stopped unexpectedly")
+		case errors.As(err, &exited):
+			exitCode = exited.ExitCode
+		default:
+			runErr = err
+		}
+	case <-ctx.Done():
+		runErr = ctx.Err()
+	}
+
+	// Drain errors while stopping, since Start() closes errCh when the orchestrator is completely done.
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		for err := range errCh {
+			log.Errorf("stop job: %s\n", err)
+		}
+	}()
+	orch.Stop()
+	<-drained
+	return exitCode, runErr
+}
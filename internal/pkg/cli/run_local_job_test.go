@@ -0,0 +1,165 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/orchestrator"
+	"github.com/aws/copilot-cli/internal/pkg/docker/orchestrator/orchestratortest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalJobSchedule(t *testing.T) {
+	// Thursday.
+	now := time.Date(2024, time.February, 1, 10, 30, 0, 0, time.Local)
+	testCases := map[string]struct {
+		inSchedule string
+
+		wantedNext  time.Time
+		wantedError error
+	}{
+		"rate": {
+			inSchedule: "rate(2 hours)",
+			wantedNext: now.Add(2 * time.Hour),
+		},
+		"cron with one-indexed days of the week": {
+			inSchedule: "cron(0 12 ? * 2 *)",
+			wantedNext: time.Date(2024, time.February, 5, 12, 0, 0, 0, time.Local),
+		},
+		"cron with a range of days of the week": {
+			inSchedule: "cron(15 9 ? * 2-6 *)",
+			wantedNext: time.Date(2024, time.February, 2, 9, 15, 0, 0, time.Local),
+		},
+		"predefined schedule": {
+			inSchedule: "@daily",
+			wantedNext: time.Date(2024, time.February, 2, 0, 0, 0, 0, time.Local),
+		},
+		"standard cron": {
+			inSchedule: "*/20 * * * *",
+			wantedNext: time.Date(2024, time.February, 1, 10, 40, 0, 0, time.Local),
+		},
+		"error if the job has no schedule": {
+			inSchedule:  "none",
+			wantedError: errors.New("the job has no schedule, remove --schedule to run it once"),
+		},
+		"error on invalid rate": {
+			inSchedule:  "rate(1 week)",
+			wantedError: errors.New(`rate expression "rate(1 week)" must be of the form rate(value unit)`),
+		},
+		"error on cron with a specific year": {
+			inSchedule:  "cron(0 12 * * ? 2025)",
+			wantedError: errors.New(`cron expression "cron(0 12 * * ? 2025)" must run every year to be run locally`),
+		},
+		"error on day of week out of range": {
+			inSchedule:  "cron(0 12 ? * 0 *)",
+			wantedError: errors.New(`cron expression "cron(0 12 ? * 0 *)": day of week 0 must be between 1 and 7`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := localJobSchedule(tc.inSchedule)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNext, got.Next(now))
+		})
+	}
+}
+
+func TestRunLocalOpts_runJob(t *testing.T) {
+	exited := func(code int) error {
+		return &orchestrator.ErrTaskExited{
+			Container: "main",
+			ExitCode:  code,
+		}
+	}
+	testCases := map[string]struct {
+		inJob      localJob
+		inAttempts []error // nil attempts never exit.
+
+		wantedAttempts int
+		wantedError    error
+	}{
+		"succeeds on the first attempt": {
+			inJob:          localJob{retries: 2},
+			inAttempts:     []error{exited(0)},
+			wantedAttempts: 1,
+		},
+		"retries failed attempts": {
+			inJob:          localJob{retries: 2},
+			inAttempts:     []error{exited(1), errors.New("some error"), exited(0)},
+			wantedAttempts: 3,
+		},
+		"fails once out of retries": {
+			inJob:          localJob{retries: 1},
+			inAttempts:     []error{exited(1), exited(2)},
+			wantedAttempts: 2,
+			wantedError:    errors.New(`job "job" failed after 2 attempt(s)`),
+		},
+		"fails without retries": {
+			inAttempts:     []error{exited(137)},
+			wantedAttempts: 1,
+			wantedError:    errors.New(`job "job" failed after 1 attempt(s)`),
+		},
+		"times out": {
+			inJob: localJob{
+				retries: 3,
+				timeout: 10 * time.Millisecond,
+			},
+			inAttempts:     []error{exited(1), nil},
+			wantedAttempts: 2,
+			wantedError:    errors.New(`job "job" timed out after 10ms`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var attempts int
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					wkldName: "job",
+				},
+				newOrchestrator: func() containerOrchestrator {
+					attempt := tc.inAttempts[attempts]
+					attempts++
+					errCh := make(chan error, 1)
+					return &orchestratortest.Double{
+						StartFn: func() <-chan error {
+							return errCh
+						},
+						RunTaskFn: func(task orchestrator.Task, opts ...orchestrator.RunTaskOption) {
+							require.Len(t, opts, 1)
+							if attempt != nil {
+								errCh <- attempt
+							}
+						},
+						StopFn: func() {
+							close(errCh)
+						},
+					}
+				},
+			}
+
+			// WHEN
+			err := opts.runJob(context.Background(), orchestrator.Task{}, tc.inJob, nil)
+
+			// THEN
+			require.Equal(t, tc.wantedAttempts, attempts)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
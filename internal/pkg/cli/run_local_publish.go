@@ -0,0 +1,251 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/localqueue"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// localTopicEnvName is the environment name used in topic ARNs when no environment is selected.
+const localTopicEnvName = "local"
+
+type runLocalPublishVars struct {
+	appName    string
+	envName    string
+	publisher  string
+	topic      string
+	message    string
+	attributes []string
+}
+
+type runLocalPublishOpts struct {
+	runLocalPublishVars
+
+	ws              wsWlDirReader
+	queueClient     localQueueClient
+	newInterpolator func(app, env string) interpolator
+	unmarshal       func([]byte) (manifest.DynamicWorkload, error)
+}
+
+func newRunLocalPublishOpts(vars runLocalPublishVars) (*runLocalPublishOpts, error) {
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	queueClient, err := newLocalQueueClient()
+	if err != nil {
+		return nil, err
+	}
+	return &runLocalPublishOpts{
+		runLocalPublishVars: vars,
+		ws:                  ws,
+		queueClient:         queueClient,
+		newInterpolator:     newManifestInterpolator,
+		unmarshal:           manifest.UnmarshalWorkload,
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *runLocalPublishOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.topic == "" {
+		return fmt.Errorf("--%s is required", topicFlag)
+	}
+	_, err := messageAttributes(o.attributes)
+	return err
+}
+
+// Ask is a no-op for this command.
+func (o *runLocalPublishOpts) Ask() error {
+	return nil
+}
+
+// Execute sends the message to the local queues of the Worker Services subscribed to the topic
+// whose filter policies match the message attributes.
+func (o *runLocalPublishOpts) Execute() error {
+	attrs, err := messageAttributes(o.attributes)
+	if err != nil {
+		return err
+	}
+	subs, err := o.subscriptions()
+	if err != nil {
+		return err
+	}
+	if len(subs) == 0 {
+		return fmt.Errorf("no Worker Services in the workspace subscribe to topic %q", o.topic)
+	}
+	publishers := make(map[string]struct{})
+	for _, sub := range subs {
+		publishers[sub.Service] = struct{}{}
+	}
+	if len(publishers) > 1 {
+		var names []string
+		for name := range publishers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("topic %q is published by multiple services (%s), specify one with --%s", o.topic, strings.Join(names, ", "), nameFlag)
+	}
+
+	env := o.envName
+	if env == "" {
+		env = localTopicEnvName
+	}
+	ctx := context.Background()
+	for _, sub := range subs {
+		matches, err := localqueue.MatchesFilterPolicy(sub.FilterPolicy, attrs)
+		if err != nil {
+			return fmt.Errorf("evaluate the filter policy of %q's subscription to topic %q: %w", sub.worker, o.topic, err)
+		}
+		if !matches {
+			log.Infof("Skipped %q: the message doesn't match its filter policy.\n", sub.worker)
+			continue
+		}
+		body, err := localqueue.Notification(localqueue.TopicARN(o.appName, env, sub.Service, o.topic), o.message, attrs)
+		if err != nil {
+			return err
+		}
+		if err := o.queueClient.SendMessage(ctx, localqueue.QueueURL("localhost", sub.Queue), body); err != nil {
+			return fmt.Errorf("publish message to %q: %w", sub.worker, err)
+		}
+		log.Successf("Published the message to %q's queue %q.\n", sub.worker, sub.Queue)
+	}
+	return nil
+}
+
+type workerSubscription struct {
+	localqueue.Subscription
+	worker string
+}
+
+// subscriptions returns the subscriptions of the Worker Services in the workspace to the topic.
+func (o *runLocalPublishOpts) subscriptions() ([]workerSubscription, error) {
+	names, err := o.ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	var subs []workerSubscription
+	for _, name := range names {
+		worker, err := o.workerManifest(name)
+		if err != nil {
+			return nil, err
+		}
+		if worker == nil {
+			continue
+		}
+		for _, sub := range localqueue.NewWorker(name, worker).Subscriptions {
+			if sub.Topic != o.topic || (o.publisher != "" && sub.Service != o.publisher) {
+				continue
+			}
+			subs = append(subs, workerSubscription{
+				Subscription: sub,
+				worker:       name,
+			})
+		}
+	}
+	return subs, nil
+}
+
+// workerManifest returns the manifest of the workload named name with the environment overrides applied,
+// or nil if the workload isn't a Worker Service.
+func (o *runLocalPublishOpts) workerManifest(name string) (*manifest.WorkerService, error) {
+	raw, err := o.ws.ReadWorkloadManifest(name)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest file for %s: %w", name, err)
+	}
+	interpolated, err := o.newInterpolator(o.appName, o.envName).Interpolate(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", name, err)
+	}
+	mft, err := o.unmarshal([]byte(interpolated))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for %s: %w", name, err)
+	}
+	if o.envName != "" {
+		mft, err = mft.ApplyEnv(o.envName)
+		if err != nil {
+			return nil, fmt.Errorf("apply environment %s override: %w", o.envName, err)
+		}
+	}
+	worker, ok := mft.Manifest().(*manifest.WorkerService)
+	if !ok {
+		return nil, nil
+	}
+	return worker, nil
+}
+
+// messageAttributes parses attributes in the KEY=VALUE format, and infers the data type of each attribute from its value:
+// JSON arrays are string arrays, numbers are numbers, and anything else is a string.
+func messageAttributes(in []string) (map[string]localqueue.MessageAttribute, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	attrs := make(map[string]localqueue.MessageAttribute, len(in))
+	for _, attr := range in {
+		key, val, ok := strings.Cut(attr, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("attribute %q must be in the KEY=VALUE format", attr)
+		}
+		typ := localqueue.AttributeTypeString
+		var arr []interface{}
+		if json.Unmarshal([]byte(val), &arr) == nil {
+			typ = localqueue.AttributeTypeStringArray
+		} else if _, err := strconv.ParseFloat(val, 64); err == nil {
+			typ = localqueue.AttributeTypeNumber
+		}
+		attrs[key] = localqueue.MessageAttribute{
+			Type:  typ,
+			Value: val,
+		}
+	}
+	return attrs, nil
+}
+
+// buildRunLocalPublishCmd builds the command for publishing a message to the local queues of Worker Services.
+func buildRunLocalPublishCmd() *cobra.Command {
+	vars := runLocalPublishVars{}
+	cmd := &cobra.Command{
+		Use:   "publish",
+		Short: "Publish a message to a topic's subscribers running locally.",
+		Long: `Publish a message to a topic's subscribers running locally.
+The message is sent to the local queues of the Worker Services that subscribe to the topic
+and whose filter policies match the message attributes.`,
+		Example: `
+  Publish a message to the "orders" topic.
+  /code $ copilot run local publish --topic orders --message '{"id": 1}'
+  Publish a message with attributes to the "orders" topic of the "api" service.
+  /code $ copilot run local publish --name api --topic orders --message '{"id": 1}' --attributes store=example_corp --attributes price=100`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunLocalPublishOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.SetUsageTemplate(template.Usage)
+
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", publishEnvFlagDescription)
+	cmd.Flags().StringVarP(&vars.publisher, nameFlag, nameFlagShort, "", publisherFlagDescription)
+	cmd.Flags().StringVar(&vars.topic, topicFlag, "", topicFlagDescription)
+	cmd.Flags().StringVar(&vars.message, messageFlag, "", messageFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.attributes, attributesFlag, nil, attributesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,215 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type runLocalPublishMocks struct {
+	ws          *mocks.MockwsWlDirReader
+	queueClient *mocks.MocklocalQueueClient
+}
+
+func TestRunLocalPublishOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars runLocalPublishVars
+
+		wantedError error
+	}{
+		"no app in workspace": {
+			inVars: runLocalPublishVars{
+				topic: "orders",
+			},
+			wantedError: errNoAppInWorkspace,
+		},
+		"topic is required": {
+			inVars: runLocalPublishVars{
+				appName: "app",
+			},
+			wantedError: errors.New("--topic is required"),
+		},
+		"invalid attribute": {
+			inVars: runLocalPublishVars{
+				appName:    "app",
+				topic:      "orders",
+				attributes: []string{"store"},
+			},
+			wantedError: errors.New(`attribute "store" must be in the KEY=VALUE format`),
+		},
+		"valid": {
+			inVars: runLocalPublishVars{
+				appName:    "app",
+				topic:      "orders",
+				attributes: []string{"store=example_corp"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := runLocalPublishOpts{
+				runLocalPublishVars: tc.inVars,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRunLocalPublishOpts_Execute(t *testing.T) {
+	const (
+		ordersWorker = `name: orders-worker
+type: Worker Service
+image:
+  build: Dockerfile
+subscribe:
+  topics:
+    - name: orders
+      service: api
+      filter_policy:
+        store: ["example_corp"]
+environments:
+  test:
+    subscribe:
+      topics:
+        - name: orders
+          service: api`
+		auditWorker = `name: audit
+type: Worker Service
+image:
+  build: Dockerfile
+subscribe:
+  topics:
+    - name: orders
+      service: api
+      queue: true
+    - name: orders
+      service: legacy`
+		api = `name: api
+type: Backend Service
+image:
+  build: Dockerfile`
+	)
+	testCases := map[string]struct {
+		inVars     runLocalPublishVars
+		setupMocks func(m *runLocalPublishMocks)
+
+		wantedError error
+	}{
+		"sends the message to subscribers whose filter policy matches": {
+			inVars: runLocalPublishVars{
+				publisher:  "api",
+				topic:      "orders",
+				message:    "hello",
+				attributes: []string{"store=other_corp"},
+			},
+			setupMocks: func(m *runLocalPublishMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "audit", "orders-worker"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(api), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("audit").Return(workspace.WorkloadManifest(auditWorker), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("orders-worker").Return(workspace.WorkloadManifest(ordersWorker), nil)
+				m.queueClient.EXPECT().SendMessage(gomock.Any(), "http://localhost:9324/000000000000/audit-api-orders", gomock.Any()).
+					DoAndReturn(func(_ interface{}, _, body string) error {
+						var got map[string]interface{}
+						require.NoError(t, json.Unmarshal([]byte(body), &got))
+						require.Equal(t, "arn:aws:sns:us-east-1:000000000000:app-local-api-orders", got["TopicArn"])
+						require.Equal(t, "hello", got["Message"])
+						return nil
+					})
+			},
+		},
+		"applies environment overrides to filter policies": {
+			inVars: runLocalPublishVars{
+				envName:    "test",
+				publisher:  "api",
+				topic:      "orders",
+				attributes: []string{"store=other_corp"},
+			},
+			setupMocks: func(m *runLocalPublishMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"orders-worker"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("orders-worker").Return(workspace.WorkloadManifest(ordersWorker), nil)
+				m.queueClient.EXPECT().SendMessage(gomock.Any(), "http://localhost:9324/000000000000/orders-worker-events", gomock.Any()).Return(nil)
+			},
+		},
+		"error if multiple services publish to the topic": {
+			inVars: runLocalPublishVars{
+				topic: "orders",
+			},
+			setupMocks: func(m *runLocalPublishMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"audit"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("audit").Return(workspace.WorkloadManifest(auditWorker), nil)
+			},
+			wantedError: errors.New(`topic "orders" is published by multiple services (api, legacy), specify one with --name`),
+		},
+		"error if no workers subscribe to the topic": {
+			inVars: runLocalPublishVars{
+				topic: "refunds",
+			},
+			setupMocks: func(m *runLocalPublishMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"audit"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("audit").Return(workspace.WorkloadManifest(auditWorker), nil)
+			},
+			wantedError: errors.New(`no Worker Services in the workspace subscribe to topic "refunds"`),
+		},
+		"wraps error sending the message": {
+			inVars: runLocalPublishVars{
+				publisher: "legacy",
+				topic:     "orders",
+			},
+			setupMocks: func(m *runLocalPublishMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"audit"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("audit").Return(workspace.WorkloadManifest(auditWorker), nil)
+				m.queueClient.EXPECT().SendMessage(gomock.Any(), "http://localhost:9324/000000000000/audit-events", gomock.Any()).Return(testError)
+			},
+			wantedError: errors.New(`publish message to "audit": some error`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &runLocalPublishMocks{
+				ws:          mocks.NewMockwsWlDirReader(ctrl),
+				queueClient: mocks.NewMocklocalQueueClient(ctrl),
+			}
+			tc.setupMocks(m)
+			tc.inVars.appName = "app"
+			opts := runLocalPublishOpts{
+				runLocalPublishVars: tc.inVars,
+				ws:                  m.ws,
+				queueClient:         m.queueClient,
+				newInterpolator:     newManifestInterpolator,
+				unmarshal:           manifest.UnmarshalWorkload,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -24,13 +25,18 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/docker/orchestrator"
 	"github.com/aws/copilot-cli/internal/pkg/docker/orchestrator/orchestratortest"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/localqueue"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/fsnotify/fsnotify"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,11 +45,18 @@ var testError = errors.New("some error")
 type runLocalAskMocks struct {
 	store *mocks.Mockstore
 	sel   *mocks.MockdeploySelector
+	wsSel *mocks.MockwsSelector
+	ws    *mocks.MockwsWlDirReader
 }
 
 func TestRunLocalOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName   string
+		inWkldNames []string
+		inAll       bool
+		inWatch     bool
+		inProxy     bool
+		inSchedule  bool
 		setupMocks  func(m *runLocalAskMocks)
 		wantAppName string
 		wantError   error
@@ -58,6 +71,50 @@ func TestRunLocalOpts_Validate(t *testing.T) {
 			},
 			wantError: fmt.Errorf("get application testApp: %w", testError),
 		},
+		"error if both --name and --all are specified": {
+			inAppName:   "testApp",
+			inWkldNames: []string{"fe"},
+			inAll:       true,
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetApplication("testApp").Return(&config.Application{Name: "testApp"}, nil)
+			},
+			wantError: errors.New("cannot specify both --name and --all"),
+		},
+		"error if --watch is used with multiple workloads": {
+			inAppName:   "testApp",
+			inWkldNames: []string{"fe", "be"},
+			inWatch:     true,
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetApplication("testApp").Return(&config.Application{Name: "testApp"}, nil)
+			},
+			wantError: errors.New("--watch cannot be used with multiple workloads"),
+		},
+		"error if --proxy is used with all workloads": {
+			inAppName: "testApp",
+			inAll:     true,
+			inProxy:   true,
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetApplication("testApp").Return(&config.Application{Name: "testApp"}, nil)
+			},
+			wantError: errors.New("--proxy cannot be used with multiple workloads"),
+		},
+		"error if both --schedule and --watch are specified": {
+			inAppName:   "testApp",
+			inWkldNames: []string{"report"},
+			inWatch:     true,
+			inSchedule:  true,
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetApplication("testApp").Return(&config.Application{Name: "testApp"}, nil)
+			},
+			wantError: errors.New("cannot specify both --schedule and --watch"),
+		},
+		"success with multiple workloads": {
+			inAppName:   "testApp",
+			inWkldNames: []string{"fe", "be"},
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetApplication("testApp").Return(&config.Application{Name: "testApp"}, nil)
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -72,7 +129,12 @@ func TestRunLocalOpts_Validate(t *testing.T) {
 			}
 			opts := runLocalOpts{
 				runLocalVars: runLocalVars{
-					appName: tc.inAppName,
+					appName:   tc.inAppName,
+					wkldNames: tc.inWkldNames,
+					allWkld:   tc.inAll,
+					watch:     tc.inWatch,
+					proxy:     tc.inProxy,
+					schedule:  tc.inSchedule,
 				},
 				store: m.store,
 			}
@@ -201,6 +263,321 @@ func TestRunLocalOpts_Ask(t *testing.T) {
 	}
 }
 
+func TestRunLocalOpts_AskMultipleWorkloads(t *testing.T) {
+	const (
+		testAppName = "testApp"
+		testEnvName = "testEnv"
+	)
+	testCases := map[string]struct {
+		inEnvName   string
+		inWkldNames []string
+		inAll       bool
+
+		setupMocks      func(m *runLocalAskMocks)
+		wantedEnvName   string
+		wantedWkldNames []string
+		wantedWkldTypes map[string]string
+		wantedError     error
+	}{
+		"prompt for the environment": {
+			inWkldNames: []string{"fe", "be"},
+			setupMocks: func(m *runLocalAskMocks) {
+				m.wsSel.EXPECT().Environment(runLocalEnvAskPrompt, "", testAppName).Return(testEnvName, nil)
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(&config.Environment{Name: testEnvName}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "fe").Return(&config.Workload{Name: "fe", Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "be").Return(&config.Workload{Name: "be", Type: manifestinfo.BackendServiceType}, nil)
+			},
+			wantedEnvName:   testEnvName,
+			wantedWkldNames: []string{"fe", "be"},
+			wantedWkldTypes: map[string]string{
+				"fe": manifestinfo.LoadBalancedWebServiceType,
+				"be": manifestinfo.BackendServiceType,
+			},
+		},
+		"error if a workload is not in the application": {
+			inEnvName:   testEnvName,
+			inWkldNames: []string{"fe", "be"},
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(&config.Environment{Name: testEnvName}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "fe").Return(&config.Workload{Name: "fe", Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "be").Return(nil, testError)
+			},
+			wantedError: fmt.Errorf(`get workload "be" configuration: %w`, testError),
+		},
+		"error if a named workload cannot be run locally": {
+			inEnvName:   testEnvName,
+			inWkldNames: []string{"fe", "site"},
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(&config.Environment{Name: testEnvName}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "fe").Return(&config.Workload{Name: "fe", Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "site").Return(&config.Workload{Name: "site", Type: manifestinfo.StaticSiteType}, nil)
+			},
+			wantedError: errors.New(`Static Site "site" cannot be run locally`),
+		},
+		"error if a named workload is a Scheduled Job": {
+			inEnvName:   testEnvName,
+			inWkldNames: []string{"fe", "report"},
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(&config.Environment{Name: testEnvName}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "fe").Return(&config.Workload{Name: "fe", Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "report").Return(&config.Workload{Name: "report", Type: manifestinfo.ScheduledJobType}, nil)
+			},
+			wantedError: errors.New(`Scheduled Job "report" must be run on its own`),
+		},
+		"--all skips workloads that cannot be run locally": {
+			inEnvName: testEnvName,
+			inAll:     true,
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(&config.Environment{Name: testEnvName}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"fe", "rdws", "worker"}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "fe").Return(&config.Workload{Name: "fe", Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "rdws").Return(&config.Workload{Name: "rdws", Type: manifestinfo.RequestDrivenWebServiceType}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, "worker").Return(&config.Workload{Name: "worker", Type: manifestinfo.WorkerServiceType}, nil)
+			},
+			wantedEnvName:   testEnvName,
+			wantedWkldNames: []string{"fe", "worker"},
+			wantedWkldTypes: map[string]string{
+				"fe":     manifestinfo.LoadBalancedWebServiceType,
+				"rdws":   "",
+				"worker": manifestinfo.WorkerServiceType,
+			},
+		},
+		"error if no workloads can be run locally": {
+			inEnvName: testEnvName,
+			inAll:     true,
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(&config.Environment{Name: testEnvName}, nil)
+				m.ws.EXPECT().ListWorkloads().Return(nil, nil)
+			},
+			wantedError: errors.New("no workloads in the workspace can be run locally"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &runLocalAskMocks{
+				store: mocks.NewMockstore(ctrl),
+				wsSel: mocks.NewMockwsSelector(ctrl),
+				ws:    mocks.NewMockwsWlDirReader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:   testAppName,
+					envName:   tc.inEnvName,
+					wkldNames: tc.inWkldNames,
+					allWkld:   tc.inAll,
+				},
+				store: m.store,
+				wsSel: m.wsSel,
+				ws:    m.ws,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnvName, opts.envName)
+			require.Equal(t, tc.wantedWkldNames, opts.wkldNames)
+			for wkld, typ := range tc.wantedWkldTypes {
+				require.Equal(t, typ, opts.wkldTypes[wkld])
+			}
+		})
+	}
+}
+
+func TestRunLocalOpts_localNetworkAliases(t *testing.T) {
+	testCases := map[string]struct {
+		inMft  string
+		wanted []string
+	}{
+		"backend service with a Service Connect alias": {
+			inMft: `name: svc
+type: Backend Service
+image:
+  location: nginx
+network:
+  connect:
+    alias: api`,
+			wanted: []string{"svc", "svc.test.app.local", "api"},
+		},
+		"scheduled job only has service discovery names": {
+			inMft: `name: svc
+type: Scheduled Job
+image:
+  location: nginx
+on:
+  schedule: "@daily"`,
+			wanted: []string{"svc", "svc.test.app.local"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft, err := manifest.UnmarshalWorkload([]byte(tc.inMft))
+			require.NoError(t, err)
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:  "app",
+					envName:  "test",
+					wkldName: "svc",
+				},
+			}
+
+			// WHEN
+			got := opts.localNetworkAliases(mft)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestRunLocalOpts_setLocalQueueEnvVars(t *testing.T) {
+	testCases := map[string]struct {
+		inMft string
+
+		wantedEnvVars map[string]string
+		wantedQueues  []string
+	}{
+		"points a worker to its local queues": {
+			inMft: `name: worker
+type: Worker Service
+image:
+  build: Dockerfile
+variables:
+  LOG_LEVEL: info
+subscribe:
+  topics:
+    - name: orders
+      service: api`,
+			wantedEnvVars: map[string]string{
+				"LOG_LEVEL":            "info",
+				"COPILOT_QUEUE_URI":    "http://copilot-local-sqs:9324/000000000000/worker-events",
+				"AWS_ENDPOINT_URL_SQS": "http://copilot-local-sqs:9324",
+			},
+			wantedQueues: []string{"worker-events"},
+		},
+		"ignores other workload types": {
+			inMft: `name: worker
+type: Backend Service
+image:
+  build: Dockerfile
+variables:
+  LOG_LEVEL: info`,
+			wantedEnvVars: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft, err := manifest.UnmarshalWorkload([]byte(tc.inMft))
+			require.NoError(t, err)
+			task := orchestrator.Task{
+				Containers: map[string]orchestrator.ContainerDefinition{
+					"worker": {
+						EnvVars: map[string]string{
+							"LOG_LEVEL": "info",
+						},
+					},
+				},
+			}
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					wkldName: "worker",
+				},
+				localWorkers: make(map[string]localqueue.Worker),
+			}
+
+			// WHEN
+			err = opts.setLocalQueueEnvVars(task, mft)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnvVars, task.Containers["worker"].EnvVars)
+			if tc.wantedQueues == nil {
+				require.Empty(t, opts.localWorkers)
+				return
+			}
+			require.Equal(t, tc.wantedQueues, opts.localWorkers["worker"].Queues())
+		})
+	}
+}
+
+func TestRunLocalOpts_createLocalQueues(t *testing.T) {
+	workers := map[string]localqueue.Worker{
+		"worker": {
+			Name:  "worker",
+			Queue: "worker-events",
+			Subscriptions: []localqueue.Subscription{
+				{Service: "api", Topic: "orders", Queue: "worker-api-orders"},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks    func(m *mocks.MocklocalQueueClient)
+		inEmulatorErr error
+
+		wantedError error
+	}{
+		"retries until the emulator accepts requests": {
+			setupMocks: func(m *mocks.MocklocalQueueClient) {
+				gomock.InOrder(
+					m.EXPECT().CreateQueue(gomock.Any(), "worker-events").Return("", testError),
+					m.EXPECT().CreateQueue(gomock.Any(), "worker-events").Return("http://localhost:9324/000000000000/worker-events", nil),
+					m.EXPECT().CreateQueue(gomock.Any(), "worker-api-orders").Return("http://localhost:9324/000000000000/worker-api-orders", nil),
+				)
+			},
+		},
+		"error if the emulator exits": {
+			setupMocks: func(m *mocks.MocklocalQueueClient) {
+				m.EXPECT().CreateQueue(gomock.Any(), "worker-events").Return("", testError)
+			},
+			inEmulatorErr: errors.New("port is already allocated"),
+			wantedError:   errors.New("run queue emulator: port is already allocated"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocklocalQueueClient(ctrl)
+			tc.setupMocks(m)
+			emulatorErrCh := make(chan error, 1)
+			if tc.inEmulatorErr != nil {
+				emulatorErrCh <- tc.inEmulatorErr
+			}
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					wkldName: "worker",
+				},
+				queueClient:  m,
+				localWorkers: workers,
+			}
+
+			// WHEN
+			err := opts.createLocalQueues(context.Background(), emulatorErrCh)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 type runLocalExecuteMocks struct {
 	ecsClient      *mocks.MockecsClient
 	ecsExecutor    *mocks.MockecsCommandExecutor
@@ -304,6 +681,12 @@ func TestRunLocalOpts_Execute(t *testing.T) {
 						ContainerName: aws.String("bar"),
 					},
 				},
+				HealthCheck: &sdkecs.HealthCheck{
+					Command:  aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost:8080/ || exit 1"}),
+					Interval: aws.Int64(10),
+					Retries:  aws.Int64(2),
+					Timeout:  aws.Int64(5),
+				},
 			},
 			{
 				Name:      aws.String("bar"),
@@ -406,6 +789,12 @@ func TestRunLocalOpts_Execute(t *testing.T) {
 				DependsOn: map[string]string{
 					"bar": "start",
 				},
+				HealthCheck: &dockerengine.HealthCheck{
+					Command:  []string{"CMD-SHELL", "curl -f http://localhost:8080/ || exit 1"},
+					Interval: 10 * time.Second,
+					Retries:  2,
+					Timeout:  5 * time.Second,
+				},
 			},
 			"bar": {
 				ImageURI: "image2",
@@ -458,6 +847,12 @@ func TestRunLocalOpts_Execute(t *testing.T) {
 				DependsOn: map[string]string{
 					"bar": "start",
 				},
+				HealthCheck: &dockerengine.HealthCheck{
+					Command:  []string{"CMD-SHELL", "curl -f http://localhost:8080/ || exit 1"},
+					Interval: 10 * time.Second,
+					Retries:  2,
+					Timeout:  5 * time.Second,
+				},
 			},
 			"bar": {
 				ImageURI: "image2",
@@ -1230,6 +1625,267 @@ ecs exec: all containers failed to retrieve credentials`),
 	}
 }
 
+func TestRunLocalOpts_ExecuteMultipleWorkloads(t *testing.T) {
+	const (
+		testAppName = "app"
+		testEnvName = "test"
+		testNetwork = "copilot-app-test"
+	)
+	manifests := map[string]string{
+		"fe": `name: fe
+type: Load Balanced Web Service
+image:
+  location: fe:latest
+  port: 8080
+http:
+  path: '/'
+`,
+		"api": `name: api
+type: Backend Service
+image:
+  location: api:latest
+  port: 8080
+network:
+  connect:
+    alias: backend
+`,
+		"admin": `name: admin
+type: Backend Service
+image:
+  location: admin:latest
+  port: 8080
+`,
+	}
+	taskDef := func(name string) *awsecs.TaskDefinition {
+		return &awsecs.TaskDefinition{
+			ContainerDefinitions: []*sdkecs.ContainerDefinition{
+				{
+					Name:      aws.String(name),
+					Image:     aws.String(name + ":latest"),
+					Essential: aws.Bool(true),
+					PortMappings: []*sdkecs.PortMapping{
+						{
+							HostPort:      aws.Int64(8080),
+							ContainerPort: aws.Int64(8080),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// localRunDouble records the task and the shared network that a workload is run with.
+	type localRunDouble struct {
+		orchestrator *orchestratortest.Double
+		errCh        chan error
+		task         orchestrator.Task
+		network      string
+		aliases      []string
+		stopped      bool
+	}
+	testCases := map[string]struct {
+		inWkldNames     []string
+		inPortOverrides portOverrides
+		setupMocks      func(m *runLocalExecuteMocks)
+		runTask         func(wkld string, runs map[string]*localRunDouble)
+
+		wantedPorts   map[string]map[string]string // workload -> host port -> container port
+		wantedAliases map[string][]string
+		wantedError   error
+	}{
+		"error if the task of a workload can't be prepared": {
+			inWkldNames: []string{"fe", "api"},
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, "fe").Return(taskDef("fe"), nil)
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, "api").Return(nil, testError)
+			},
+			wantedError: errors.New(`prepare task for "api": get task: get task definition: some error`),
+		},
+		"error if the shared network can't be created": {
+			inWkldNames: []string{"fe", "api"},
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, gomock.Any()).DoAndReturn(func(_, _, name string) (*awsecs.TaskDefinition, error) {
+					return taskDef(name), nil
+				}).Times(2)
+				m.dockerEngine.EXPECT().CreateNetwork(gomock.Any(), testNetwork).Return(testError)
+			},
+			wantedError: errors.New(`create network "copilot-app-test": some error`),
+		},
+		"runs the workloads on a shared network and only binds the ports of the workloads that need host access": {
+			inWkldNames: []string{"fe", "api", "admin"},
+			inPortOverrides: portOverrides{
+				{
+					wkld:      "admin",
+					host:      "8081",
+					container: "8080",
+				},
+			},
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, gomock.Any()).DoAndReturn(func(_, _, name string) (*awsecs.TaskDefinition, error) {
+					return taskDef(name), nil
+				}).Times(3)
+				m.dockerEngine.EXPECT().CreateNetwork(gomock.Any(), testNetwork).Return(nil)
+				m.dockerEngine.EXPECT().RemoveNetwork(gomock.Any(), testNetwork).Return(nil)
+			},
+			runTask: func(wkld string, runs map[string]*localRunDouble) {
+				if wkld == "admin" {
+					syscall.Kill(syscall.Getpid(), syscall.SIGINT)
+				}
+			},
+			wantedPorts: map[string]map[string]string{
+				"fe":    {"8080": "8080"},
+				"api":   nil,
+				"admin": {"8081": "8080"},
+			},
+			wantedAliases: map[string][]string{
+				"fe":    {"fe", "fe.test.app.local"},
+				"api":   {"api", "api.test.app.local", "backend"},
+				"admin": {"admin", "admin.test.app.local"},
+			},
+		},
+		"stops all the workloads when one of them fails": {
+			inWkldNames: []string{"fe", "api"},
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, gomock.Any()).DoAndReturn(func(_, _, name string) (*awsecs.TaskDefinition, error) {
+					return taskDef(name), nil
+				}).Times(2)
+				m.dockerEngine.EXPECT().CreateNetwork(gomock.Any(), testNetwork).Return(nil)
+				m.dockerEngine.EXPECT().RemoveNetwork(gomock.Any(), testNetwork).Return(nil)
+			},
+			runTask: func(wkld string, runs map[string]*localRunDouble) {
+				if wkld == "api" {
+					runs["api"].errCh <- testError
+				}
+			},
+			wantedPorts: map[string]map[string]string{
+				"fe":  {"8080": "8080"},
+				"api": nil,
+			},
+			wantedAliases: map[string][]string{
+				"fe":  {"fe", "fe.test.app.local"},
+				"api": {"api", "api.test.app.local", "backend"},
+			},
+		},
+		"error if the shared network can't be removed": {
+			inWkldNames: []string{"fe", "api"},
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.ecsClient.EXPECT().TaskDefinition(testAppName, testEnvName, gomock.Any()).DoAndReturn(func(_, _, name string) (*awsecs.TaskDefinition, error) {
+					return taskDef(name), nil
+				}).Times(2)
+				m.dockerEngine.EXPECT().CreateNetwork(gomock.Any(), testNetwork).Return(nil)
+				m.dockerEngine.EXPECT().RemoveNetwork(gomock.Any(), testNetwork).Return(testError)
+			},
+			runTask: func(wkld string, runs map[string]*localRunDouble) {
+				if wkld == "api" {
+					syscall.Kill(syscall.Getpid(), syscall.SIGINT)
+				}
+			},
+			wantedError: errors.New(`remove network "copilot-app-test": some error`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &runLocalExecuteMocks{
+				ecsClient:    mocks.NewMockecsClient(ctrl),
+				ws:           mocks.NewMockwsWlDirReader(ctrl),
+				dockerEngine: mocks.NewMockdockerEngineRunner(ctrl),
+			}
+			m.ws.EXPECT().ReadWorkloadManifest(gomock.Any()).DoAndReturn(func(name string) (workspace.WorkloadManifest, error) {
+				return workspace.WorkloadManifest(manifests[name]), nil
+			}).AnyTimes()
+			tc.setupMocks(m)
+			sess, err := session.NewSession(&aws.Config{
+				Region:      aws.String("us-west-2"),
+				Credentials: credentials.NewStaticCredentials("myEnvID", "myEnvSecret", "myEnvToken"),
+			})
+			require.NoError(t, err)
+
+			runs := make(map[string]*localRunDouble)
+			wkldTypes := make(map[string]string)
+			for _, wkld := range tc.inWkldNames {
+				wkld := wkld
+				run := &localRunDouble{
+					orchestrator: &orchestratortest.Double{},
+					errCh:        make(chan error, 1),
+				}
+				run.orchestrator.StartFn = func() <-chan error {
+					return run.errCh
+				}
+				run.orchestrator.RunTaskFn = func(task orchestrator.Task, opts ...orchestrator.RunTaskOption) {
+					run.task = task
+					run.network, run.aliases = orchestrator.RunTaskNetwork(opts...)
+					if tc.runTask != nil {
+						tc.runTask(wkld, runs)
+					}
+				}
+				run.orchestrator.StopFn = func() {
+					if !run.stopped {
+						run.stopped = true
+						close(run.errCh)
+					}
+				}
+				runs[wkld] = run
+				wkldTypes[wkld] = manifestinfo.BackendServiceType
+			}
+			wkldTypes["fe"] = manifestinfo.LoadBalancedWebServiceType
+
+			opts := &runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:       testAppName,
+					envName:       testEnvName,
+					wkldNames:     tc.inWkldNames,
+					portOverrides: tc.inPortOverrides,
+				},
+				wkldTypes: wkldTypes,
+				newInterpolator: func(app, env string) interpolator {
+					return manifest.NewInterpolator(app, env)
+				},
+				unmarshal: manifest.UnmarshalWorkload,
+				buildContainerImages: func(mft manifest.DynamicWorkload) (map[string]string, error) {
+					return nil, nil
+				},
+				ws:           m.ws,
+				ecsClient:    m.ecsClient,
+				dockerEngine: m.dockerEngine,
+				sess: &session.Session{
+					Config: &aws.Config{
+						Credentials: credentials.NewStaticCredentials("myID", "mySecret", "myToken"),
+					},
+				},
+				envManagerSess: sess,
+				targetEnv: &config.Environment{
+					App:    testAppName,
+					Name:   testEnvName,
+					Region: "us-west-2",
+				},
+			}
+			opts.configureClients = func() error {
+				opts.orchestrator = runs[opts.wkldName].orchestrator
+				return nil
+			}
+
+			// WHEN
+			err = opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			for wkld, run := range runs {
+				require.True(t, run.stopped, "workload %q should be stopped", wkld)
+				require.Equal(t, testNetwork, run.network)
+				require.Equal(t, tc.wantedAliases[wkld], run.aliases)
+				require.Equal(t, tc.wantedPorts[wkld], run.task.Containers[wkld].Ports)
+			}
+		})
+	}
+}
+
 func TestRunLocalOpts_getEnvVars(t *testing.T) {
 	newVar := func(v string, overridden, secret bool) envVarValue {
 		return envVarValue{
@@ -1629,6 +2285,208 @@ func TestRunLocalOpts_getEnvVars(t *testing.T) {
 	}
 }
 
+func TestRunLocalOpts_getTaskFromManifest(t *testing.T) {
+	const mft = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+  healthcheck:
+    command: ["CMD-SHELL", "curl -f http://localhost:8080/ || exit 1"]
+    interval: 5s
+env_file: api.env
+variables:
+  LOG_LEVEL: debug
+secrets:
+  DB_PASSWORD: /db/password
+  API_KEY:
+    secretsmanager: api-key
+sidecars:
+  nginx:
+    image: public.ecr.aws/nginx/nginx:latest
+    port: 80/tcp
+    essential: false
+    depends_on:
+      api: start
+    variables:
+      LOG_LEVEL: info
+`
+	tests := map[string]struct {
+		inMft         string
+		inWkldType    string
+		inEnvFile     string
+		portOverrides portOverrides
+		envOverrides  map[string]string
+		setupMocks    func(m *runLocalExecuteMocks)
+
+		want      orchestrator.Task
+		wantError string
+	}{
+		"error if workload type cannot be run from its manifest": {
+			inMft: `name: api
+type: Static Site
+`,
+			inWkldType: "Static Site",
+			wantError:  `workload type "Static Site" cannot be run from its manifest`,
+		},
+		"error if env file is malformed": {
+			inMft:     mft,
+			inEnvFile: "bad line",
+			wantError: `get env vars: read env file for container "api": line 1 of api.env is not in the format KEY=VALUE`,
+		},
+		"error if a variable is imported from CloudFormation": {
+			inMft: `name: api
+type: Backend Service
+image:
+  location: api:latest
+variables:
+  QUEUE:
+    from_cfn: queue-url
+`,
+			wantError: `get env vars: variable "QUEUE" in container "api" imports a value from CloudFormation, which cannot be resolved locally`,
+		},
+		"builds the task from the manifest": {
+			inMft: mft,
+			inEnvFile: `# comment
+LOG_LEVEL=warn
+FROM_FILE=hello
+`,
+			portOverrides: portOverrides{
+				{
+					wkld:      "frontend",
+					host:      "9001",
+					container: "8080",
+				},
+				{
+					host:      "9000",
+					container: "8080",
+				},
+			},
+			envOverrides: map[string]string{
+				"nginx:EXTRA": "extra",
+			},
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.ssm.EXPECT().GetSecretValue(gomock.Any(), "/db/password").Return("hunter2", nil)
+				m.secretsManager.EXPECT().GetSecretValue(gomock.Any(), "arn:aws:secretsmanager:us-west-2:123456789012:secret:api-key").Return("key", nil)
+			},
+			want: orchestrator.Task{
+				Containers: map[string]orchestrator.ContainerDefinition{
+					"api": {
+						EnvVars: map[string]string{
+							"COPILOT_APPLICATION_NAME":           "app",
+							"COPILOT_ENVIRONMENT_NAME":           "test",
+							"COPILOT_SERVICE_NAME":               "api",
+							"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "test.app.local",
+							"LOG_LEVEL":                          "debug",
+							"FROM_FILE":                          "hello",
+						},
+						Secrets: map[string]string{
+							"DB_PASSWORD":           "hunter2",
+							"API_KEY":               "key",
+							"AWS_ACCESS_KEY_ID":     "myID",
+							"AWS_SECRET_ACCESS_KEY": "mySecret",
+							"AWS_SESSION_TOKEN":     "myToken",
+						},
+						Ports: map[string]string{
+							"9000": "8080",
+						},
+						IsEssential: true,
+						HealthCheck: &dockerengine.HealthCheck{
+							Command:  []string{"CMD-SHELL", "curl -f http://localhost:8080/ || exit 1"},
+							Interval: 5 * time.Second,
+							Timeout:  5 * time.Second,
+							Retries:  2,
+						},
+					},
+					"nginx": {
+						ImageURI: "public.ecr.aws/nginx/nginx:latest",
+						EnvVars: map[string]string{
+							"COPILOT_APPLICATION_NAME":           "app",
+							"COPILOT_ENVIRONMENT_NAME":           "test",
+							"COPILOT_SERVICE_NAME":               "api",
+							"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "test.app.local",
+							"LOG_LEVEL":                          "info",
+							"EXTRA":                              "extra",
+						},
+						Secrets: map[string]string{
+							"AWS_ACCESS_KEY_ID":     "myID",
+							"AWS_SECRET_ACCESS_KEY": "mySecret",
+							"AWS_SESSION_TOKEN":     "myToken",
+						},
+						Ports: map[string]string{
+							"80": "80",
+						},
+						DependsOn: map[string]string{
+							"api": "start",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := &runLocalExecuteMocks{
+				ssm:            mocks.NewMocksecretGetter(ctrl),
+				secretsManager: mocks.NewMocksecretGetter(ctrl),
+				ws:             mocks.NewMockwsWlDirReader(ctrl),
+				sessCreds: &mockProvider{
+					FnRetrieve: func() (credentials.Value, error) {
+						return credentials.Value{
+							AccessKeyID:     "myID",
+							SecretAccessKey: "mySecret",
+							SessionToken:    "myToken",
+						}, nil
+					},
+				},
+			}
+			m.ws.EXPECT().Path().Return("/ws").AnyTimes()
+			if tc.setupMocks != nil {
+				tc.setupMocks(m)
+			}
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "/ws/api.env", []byte(tc.inEnvFile), 0644))
+			mft, err := manifest.UnmarshalWorkload([]byte(tc.inMft))
+			require.NoError(t, err)
+
+			o := &runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:       "app",
+					envName:       "test",
+					wkldName:      "api",
+					wkldType:      tc.inWkldType,
+					envOverrides:  tc.envOverrides,
+					portOverrides: tc.portOverrides,
+					fromManifest:  true,
+				},
+				sess: &session.Session{
+					Config: &aws.Config{
+						Credentials: credentials.NewCredentials(m.sessCreds),
+					},
+				},
+				targetEnv: &config.Environment{
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				},
+				ws:             m.ws,
+				fs:             fs,
+				ssm:            m.ssm,
+				secretsManager: m.secretsManager,
+			}
+
+			got, err := o.getTaskFromManifest(context.Background(), mft)
+			if tc.wantError != "" {
+				require.EqualError(t, err, tc.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
 type taggedResourceGetterDouble struct {
 	GetResourcesByTagsFn func(string, map[string]string) ([]*resourcegroups.Resource, error)
 }
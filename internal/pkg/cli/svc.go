@@ -26,11 +26,13 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcInitCmd())
 	cmd.AddCommand(buildSvcListCmd())
 	cmd.AddCommand(buildSvcPackageCmd())
+	cmd.AddCommand(buildSvcDiffCmd())
 	cmd.AddCommand(buildSvcOverrideCmd())
 	cmd.AddCommand(buildSvcDeployCmd())
 	cmd.AddCommand(buildSvcDeleteCmd())
 	cmd.AddCommand(buildSvcShowCmd())
 	cmd.AddCommand(buildSvcStatusCmd())
+	cmd.AddCommand(buildSvcDriftCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
 	cmd.AddCommand(buildSvcExecCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
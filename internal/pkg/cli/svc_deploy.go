@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -17,11 +18,13 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	awsssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/afero"
 	"golang.org/x/mod/semver"
@@ -51,6 +54,7 @@ type deployWkldVars struct {
 	forceNewUpdate     bool // NOTE: this variable is not applicable for a job workload currently.
 	disableRollback    bool
 	showDiff           bool
+	diffJSON           bool
 	skipDiffPrompt     bool
 	allowWkldDowngrade bool
 	detach             bool
@@ -117,12 +121,14 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		spinner:         termprogress.NewSpinner(log.DiagnosticWriter),
 		sel:             selector.NewLocalWorkloadSelector(prompter, store, ws, selector.OnlyInitializedWorkloads),
 		prompt:          prompter,
-		newInterpolator: newManifestInterpolator,
 		cmd:             exec.NewCmd(),
 		sessProvider:    sessProvider,
 		diffWriter:      os.Stdout,
 		templateVersion: version.LatestTemplateVersion(),
 	}
+	opts.newInterpolator = newResolvingManifestInterpolator(func() (*session.Session, error) {
+		return opts.envSess, nil
+	})
 	opts.newSvcDeployer = func() (workloadDeployer, error) {
 		// NOTE: Defined as a struct member to facilitate unit testing.
 		return newSvcDeployer(opts)
@@ -135,7 +141,15 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 	if err != nil {
 		return nil, err
 	}
-	ovrdr, err := clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider)
+	sharedOverrides, err := o.ws.SharedWorkloadOverridesPaths()
+	if err != nil {
+		return nil, fmt.Errorf("get shared overrides directories: %w", err)
+	}
+	ovrdr, err := clideploy.NewLayeredOverrider(append(sharedOverrides, o.ws.WorkloadOverridesPath(o.name)), o.appName, o.envName, afero.NewOsFs(), o.sessProvider)
+	if err != nil {
+		return nil, err
+	}
+	ignoreRules, err := clideploy.NewDiffIgnoreRules(afero.NewOsFs(), o.ws.DiffIgnorePath(), o.ws.EnvDiffIgnorePath(o.envName), o.ws.WorkloadDiffIgnorePath(o.name))
 	if err != nil {
 		return nil, err
 	}
@@ -155,6 +169,7 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 		RawMft:           o.rawMft,
 		EnvVersionGetter: o.envFeaturesDescriber,
 		Overrider:        ovrdr,
+		DiffIgnoreRules:  ignoreRules,
 	}
 	switch t := content.(type) {
 	case *manifest.LoadBalancedWebService:
@@ -180,9 +195,48 @@ func newManifestInterpolator(app, env string) interpolator {
 	return manifest.NewInterpolator(app, env)
 }
 
+// newResolvingManifestInterpolator returns a constructor for interpolators that also resolve the SSM parameters
+// and CloudFormation exports referenced by a manifest, with the session returned by sess.
+// The session is retrieved only once a reference needs to be resolved.
+func newResolvingManifestInterpolator(sess func() (*session.Session, error)) func(app, env string) interpolator {
+	resolver := func(lookup func(s *session.Session, name string) (string, error)) manifest.ResolverFunc {
+		return func(name string) (string, error) {
+			s, err := sess()
+			if err != nil {
+				return "", err
+			}
+			return lookup(s, name)
+		}
+	}
+	return func(app, env string) interpolator {
+		return manifest.NewInterpolator(app, env,
+			manifest.WithResolver(manifest.ReferencePrefixSSM, resolver(func(s *session.Session, name string) (string, error) {
+				return resolveSSMReference(awsssm.New(s), name)
+			})),
+			manifest.WithResolver(manifest.ReferencePrefixCFNExport, resolver(func(s *session.Session, name string) (string, error) {
+				return awscfn.New(s).ExportValue(name)
+			})),
+		)
+	}
+}
+
+// resolveSSMReference returns the value of the plaintext SSM parameter referenced by a manifest.
+// SecureString parameters are refused, since the resolved value ends up in plain text in the template.
+func resolveSSMReference(getter ssmParameterGetter, name string) (string, error) {
+	val, err := getter.GetParameterValue(context.Background(), name)
+	var errSecureString *awsssm.ErrSecureStringParameter
+	if errors.As(err, &errSecureString) {
+		return "", fmt.Errorf(`%w: reference it under the "secrets" field of the manifest instead`, err)
+	}
+	if err != nil {
+		return "", err
+	}
+	return val, nil
+}
+
 // Validate returns an error for any invalid optional flags.
 func (o *deploySvcOpts) Validate() error {
-	return nil
+	return validateDiffJSON(o.showDiff, o.diffJSON)
 }
 
 // Ask prompts for and validates any required flags.
@@ -274,7 +328,7 @@ func (o *deploySvcOpts) Execute() error {
 		if err != nil {
 			return fmt.Errorf("generate the template for workload %q against environment %q: %w", o.name, o.envName, err)
 		}
-		if err := diff(deployer, output.Template, o.diffWriter); err != nil {
+		if err := diff(deployer, output.Template, o.diffWriter, o.diffJSON); err != nil {
 			var errHasDiff *errHasDiff
 			if !errors.As(err, &errHasDiff) {
 				return err
@@ -490,6 +544,7 @@ type workloadManifestInput struct {
 	interpolator interpolator
 	sess         *session.Session
 	unmarshal    func([]byte) (manifest.DynamicWorkload, error)
+	fixture      *clideploy.OfflineFixture // Loads the manifest without AWS access if set.
 }
 
 func workloadManifest(in *workloadManifestInput) (manifest.DynamicWorkload, string, error) {
@@ -512,7 +567,12 @@ func workloadManifest(in *workloadManifestInput) (manifest.DynamicWorkload, stri
 	if err := envMft.Validate(); err != nil {
 		return nil, "", fmt.Errorf("validate manifest against environment %q: %w", in.envName, err)
 	}
-	if err := envMft.Load(in.sess); err != nil {
+	if in.fixture != nil {
+		err = envMft.LoadOffline(in.fixture.Env.Subnets)
+	} else {
+		err = envMft.Load(in.sess)
+	}
+	if err != nil {
 		return nil, "", fmt.Errorf("load dynamic content: %w", err)
 	}
 	return envMft, interpolated, nil
@@ -685,8 +745,16 @@ func (e *errHasDiff) ExitCode() int {
 	return 1
 }
 
-func diff(differ templateDiffer, tmpl string, writer io.Writer) error {
-	if out, err := differ.DeployDiff(tmpl); err != nil {
+// diff writes the diff of the template against the deployed stack followed by a summary of the resource changes,
+// or a JSON array of changes if asJSON is true. It returns an errHasDiff if there are changes.
+func diff(differ templateDiffer, tmpl string, writer io.Writer, asJSON bool) error {
+	opts := []templatediff.WriteOption{templatediff.WithSummary()}
+	noChanges := "No changes.\n"
+	if asJSON {
+		opts = []templatediff.WriteOption{templatediff.WithJSON()}
+		noChanges = "[]\n"
+	}
+	if out, err := differ.DeployDiff(tmpl, opts...); err != nil {
 		return err
 	} else if out != "" {
 		if _, err := writer.Write([]byte(out)); err != nil {
@@ -694,12 +762,20 @@ func diff(differ templateDiffer, tmpl string, writer io.Writer) error {
 		}
 		return &errHasDiff{}
 	}
-	if _, err := writer.Write([]byte("No changes.\n")); err != nil {
+	if _, err := writer.Write([]byte(noChanges)); err != nil {
 		return err
 	}
 	return nil
 }
 
+// validateDiffJSON returns an error if the diff is requested in JSON without being requested at all.
+func validateDiffJSON(showDiff, diffJSON bool) error {
+	if diffJSON && !showDiff {
+		return fmt.Errorf("--%s must be used with --%s", jsonFlag, diffFlag)
+	}
+	return nil
+}
+
 // buildSvcDeployCmd builds the `svc deploy` subcommand.
 func buildSvcDeployCmd() *cobra.Command {
 	vars := deployWkldVars{}
@@ -728,6 +804,7 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.diffJSON, jsonFlag, false, diffJSONFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipDiffPrompt, diffAutoApproveFlag, false, diffAutoApproveFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 	cmd.Flags().BoolVar(&vars.detach, detachFlag, false, detachFlagDescription)
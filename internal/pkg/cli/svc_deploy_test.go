@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awsssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -24,7 +25,43 @@ import (
 )
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
-	// There is no validation for svc deploy.
+	testCases := map[string]struct {
+		inShowDiff bool
+		inDiffJSON bool
+
+		wantedError error
+	}{
+		"valid without flags": {},
+		"valid diff in JSON": {
+			inShowDiff: true,
+			inDiffJSON: true,
+		},
+		"error if JSON diff is requested without --diff": {
+			inDiffJSON:  true,
+			wantedError: errors.New("--json must be used with --diff"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					showDiff: tc.inShowDiff,
+					diffJSON: tc.inDiffJSON,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
 }
 
 type svcDeployAskMocks struct {
@@ -153,6 +190,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 	mockErrStackNotFound := cloudformation.ErrStackNotFound{}
 	testCases := map[string]struct {
 		inShowDiff       bool
+		inDiffJSON       bool
 		inSkipDiffPrompt bool
 		inForceFlag      bool
 		inAllowDowngrade bool
@@ -283,7 +321,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("", errors.New("some error"))
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
 			},
 			wantedError: errors.New("some error"),
 		},
@@ -303,7 +341,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
 			},
@@ -325,12 +363,35 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("mock diff", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
 			},
 			wantedDiff: "mock diff",
 		},
+		"write an empty JSON array if there is no diff in JSON": {
+			inShowDiff: true,
+			inDiffJSON: true,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1"}, nil)
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("", nil)
+				m.mockDiffWriter = &strings.Builder{}
+				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedDiff: "[]\n",
+		},
 		"error if fail to ask whether to continue the deployment": {
 			inShowDiff: true,
 			mock: func(m *deployMocks) {
@@ -347,7 +408,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("mock diff", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
 			},
@@ -369,7 +430,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("mock diff", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
@@ -391,7 +452,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("mock diff", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(true, nil)
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(1)
@@ -414,7 +475,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any(), gomock.Any()).Return("mock diff", nil)
 				m.mockDiffWriter = &strings.Builder{}
 				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Times(0)
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(1)
@@ -498,6 +559,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 					name:               mockSvcName,
 					envName:            mockEnvName,
 					showDiff:           tc.inShowDiff,
+					diffJSON:           tc.inDiffJSON,
 					skipDiffPrompt:     tc.inSkipDiffPrompt,
 					forceNewUpdate:     tc.inForceFlag,
 					allowWkldDowngrade: tc.inAllowDowngrade,
@@ -638,6 +700,10 @@ func (m *mockWorkloadMft) Load(sess *session.Session) error {
 	return nil
 }
 
+func (m *mockWorkloadMft) LoadOffline(subnetIDs []string) error {
+	return nil
+}
+
 func (m *mockWorkloadMft) Manifest() interface{} {
 	return nil
 }
@@ -645,3 +711,93 @@ func (m *mockWorkloadMft) Manifest() interface{} {
 func (m *mockWorkloadMft) RequiredEnvironmentFeatures() []string {
 	return m.mockRequiredEnvironmentFeatures()
 }
+
+func Test_newResolvingManifestInterpolator(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+
+		wantedSessionCalls int
+		wantedInterpolated string
+		wantedError        error
+	}{
+		"does not retrieve a session if there are no references": {
+			inManifest:         "name: ${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}\n",
+			wantedInterpolated: "name: phonetool-test\n",
+		},
+		"error if the session cannot be retrieved to resolve a reference": {
+			inManifest:         "variables:\n  TABLE: ${cfn-export:${COPILOT_ENVIRONMENT_NAME}-TableName}\n",
+			wantedSessionCalls: 1,
+			wantedError:        errors.New("resolve ${cfn-export:test-TableName}: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var sessionCalls int
+			newInterpolator := newResolvingManifestInterpolator(func() (*session.Session, error) {
+				sessionCalls++
+				return nil, errors.New("some error")
+			})
+
+			// WHEN
+			interpolated, err := newInterpolator("phonetool", "test").Interpolate(tc.inManifest)
+
+			// THEN
+			require.Equal(t, tc.wantedSessionCalls, sessionCalls)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedInterpolated, interpolated)
+		})
+	}
+}
+
+func Test_resolveSSMReference(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockssmParameterGetter)
+
+		wantedValue string
+		wantedError error
+	}{
+		"error if the parameter cannot be retrieved": {
+			setupMocks: func(m *mocks.MockssmParameterGetter) {
+				m.EXPECT().GetParameterValue(gomock.Any(), "/phonetool/test/url").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error if the parameter is a SecureString": {
+			setupMocks: func(m *mocks.MockssmParameterGetter) {
+				m.EXPECT().GetParameterValue(gomock.Any(), "/phonetool/test/url").Return("", &awsssm.ErrSecureStringParameter{Name: "/phonetool/test/url"})
+			},
+			wantedError: errors.New(`parameter /phonetool/test/url is a SecureString: reference it under the "secrets" field of the manifest instead`),
+		},
+		"success": {
+			setupMocks: func(m *mocks.MockssmParameterGetter) {
+				m.EXPECT().GetParameterValue(gomock.Any(), "/phonetool/test/url").Return("https://example.com", nil)
+			},
+			wantedValue: "https://example.com",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockssmParameterGetter(ctrl)
+			tc.setupMocks(m)
+
+			// WHEN
+			val, err := resolveSSMReference(m, "/phonetool/test/url")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedValue, val)
+		})
+	}
+}
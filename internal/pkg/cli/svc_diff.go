@@ -0,0 +1,301 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDiffSvcNamePrompt     = "Which service would you like to compare across environments?"
+	svcDiffFromEnvNamePrompt = "Which environment would you like to compare from?"
+	svcDiffToEnvNamePrompt   = "Which environment would you like to compare to?"
+)
+
+type diffSvcVars struct {
+	appName string
+	name    string
+	fromEnv string
+	toEnv   string
+}
+
+type diffSvcOpts struct {
+	diffSvcVars
+
+	// Interfaces to interact with dependencies.
+	ws              wsWlDirReader
+	fs              afero.Fs
+	store           store
+	sel             wsSelector
+	diffWriter      io.Writer
+	newInterpolator func(app, env string) interpolator
+	renderTemplate  func(o *diffSvcOpts, envName string) (string, error) // Overridden in tests.
+	sessProvider    *sessions.Provider
+}
+
+func newDiffSvcOpts(vars diffSvcVars) (*diffSvcOpts, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc diff"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &diffSvcOpts{
+		diffSvcVars:     vars,
+		ws:              ws,
+		fs:              fs,
+		store:           store,
+		sel:             selector.NewLocalWorkloadSelector(prompt.New(), store, ws, selector.OnlyInitializedWorkloads),
+		diffWriter:      os.Stdout,
+		newInterpolator: newManifestInterpolator,
+		renderTemplate:  renderSvcTemplate,
+		sessProvider:    sessProvider,
+	}, nil
+}
+
+// renderSvcTemplate generates the CloudFormation template of the service for the environment the same way as "svc package".
+func renderSvcTemplate(o *diffSvcOpts, envName string) (string, error) {
+	pkgOpts := &packageSvcOpts{
+		packageSvcVars: packageSvcVars{
+			name:    o.name,
+			envName: envName,
+			appName: o.appName,
+		},
+		ws:                o.ws,
+		fs:                o.fs,
+		store:             o.store,
+		runner:            exec.NewCmd(),
+		unmarshal:         manifest.UnmarshalWorkload,
+		newInterpolator:   o.newInterpolator,
+		sessProvider:      o.sessProvider,
+		newStackGenerator: newWorkloadStackGenerator,
+		templateVersion:   version.LatestTemplateVersion(),
+	}
+	if err := pkgOpts.configureClients(); err != nil {
+		return "", err
+	}
+	env, err := pkgOpts.getTargetEnv()
+	if err != nil {
+		return "", err
+	}
+	gen, err := pkgOpts.getStackGenerator(env)
+	if err != nil {
+		return "", err
+	}
+	stack, err := pkgOpts.getWorkloadStack(gen)
+	if err != nil {
+		return "", err
+	}
+	return stack.template, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *diffSvcOpts) Validate() error {
+	if o.fromEnv != "" && o.fromEnv == o.toEnv {
+		return fmt.Errorf("--%s and --%s must be different environments", fromEnvFlag, toEnvFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *diffSvcOpts) Ask() error {
+	if o.appName == "" {
+		// NOTE: This command is required to be executed under a workspace. We don't prompt for it.
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	if err := o.validateOrAskSvcName(); err != nil {
+		return err
+	}
+	fromEnv, err := o.validateOrAskEnvName(o.fromEnv, svcDiffFromEnvNamePrompt)
+	if err != nil {
+		return err
+	}
+	o.fromEnv = fromEnv
+	toEnv, err := o.validateOrAskEnvName(o.toEnv, svcDiffToEnvNamePrompt)
+	if err != nil {
+		return err
+	}
+	o.toEnv = toEnv
+	return o.Validate()
+}
+
+// Execute writes the differences of the manifest and of the CloudFormation template of the service between the two environments.
+func (o *diffSvcOpts) Execute() error {
+	fromMft, err := o.renderManifest(o.fromEnv)
+	if err != nil {
+		return err
+	}
+	toMft, err := o.renderManifest(o.toEnv)
+	if err != nil {
+		return err
+	}
+	mftTree, err := templatediff.From(fromMft).Parse([]byte(toMft))
+	if err != nil {
+		return fmt.Errorf("parse the manifest differences: %w", err)
+	}
+
+	fromTmpl, err := o.renderTemplate(o, o.fromEnv)
+	if err != nil {
+		return fmt.Errorf("generate the template of service %s for environment %s: %w", o.name, o.fromEnv, err)
+	}
+	toTmpl, err := o.renderTemplate(o, o.toEnv)
+	if err != nil {
+		return fmt.Errorf("generate the template of service %s for environment %s: %w", o.name, o.toEnv, err)
+	}
+	// The rules of both environments apply, since a difference comes from either of them.
+	ignoreRules, err := clideploy.NewDiffIgnoreRules(o.fs, o.ws.DiffIgnorePath(), o.ws.EnvDiffIgnorePath(o.fromEnv), o.ws.EnvDiffIgnorePath(o.toEnv), o.ws.WorkloadDiffIgnorePath(o.name))
+	if err != nil {
+		return err
+	}
+	tmplTree, err := templatediff.From(fromTmpl).ParseWithCFNOverriders([]byte(toTmpl), ignoreRules...)
+	if err != nil {
+		return fmt.Errorf("parse the template differences: %w", err)
+	}
+
+	var hasDiff bool
+	for _, section := range []struct {
+		title string
+		tree  templatediff.Tree
+		opts  []templatediff.WriteOption
+	}{
+		{
+			title: "Manifest",
+			tree:  mftTree,
+		},
+		{
+			title: "CloudFormation template",
+			tree:  tmplTree,
+			opts:  []templatediff.WriteOption{templatediff.WithSummary()},
+		},
+	} {
+		var out strings.Builder
+		if err := section.tree.Write(&out, section.opts...); err != nil {
+			return err
+		}
+		diff := out.String()
+		if diff == "" {
+			diff = "No changes.\n"
+		} else {
+			hasDiff = true
+		}
+		if _, err := fmt.Fprintf(o.diffWriter, "%s of service %s from %s to %s:\n%s\n", section.title, o.name, o.fromEnv, o.toEnv, diff); err != nil {
+			return err
+		}
+	}
+	if hasDiff {
+		return &errHasDiff{}
+	}
+	return nil
+}
+
+// RecommendActions is a no-op.
+func (o *diffSvcOpts) RecommendActions() error {
+	return nil
+}
+
+// renderManifest returns the interpolated manifest of the service with the overrides of the environment applied.
+func (o *diffSvcOpts) renderManifest(envName string) (string, error) {
+	raw, err := o.ws.ReadWorkloadManifest(o.name)
+	if err != nil {
+		return "", fmt.Errorf("read manifest file for %s: %w", o.name, err)
+	}
+	interpolated, err := o.newInterpolator(o.appName, envName).Interpolate(string(raw))
+	if err != nil {
+		return "", fmt.Errorf("interpolate environment variables for %s manifest: %w", o.name, err)
+	}
+	mft, err := manifest.RenderEnv([]byte(interpolated), envName)
+	if err != nil {
+		return "", fmt.Errorf("apply environment %s override: %w", envName, err)
+	}
+	return string(mft), nil
+}
+
+func (o *diffSvcOpts) validateOrAskSvcName() error {
+	if o.name != "" {
+		names, err := o.ws.ListServices()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		if !slices.Contains(names, o.name) {
+			return fmt.Errorf("service '%s' does not exist in the workspace", o.name)
+		}
+		return nil
+	}
+	name, err := o.sel.Service(svcDiffSvcNamePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select service: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *diffSvcOpts) validateOrAskEnvName(name, msg string) (string, error) {
+	if name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, name); err != nil {
+			return "", fmt.Errorf("get environment %s: %w", name, err)
+		}
+		return name, nil
+	}
+	name, err := o.sel.Environment(msg, "", o.appName)
+	if err != nil {
+		return "", fmt.Errorf("select environment: %w", err)
+	}
+	return name, nil
+}
+
+// buildSvcDiffCmd builds the command for comparing a service across two environments.
+func buildSvcDiffCmd() *cobra.Command {
+	vars := diffSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare a service across two environments.",
+		Long: `Compare the manifest and the CloudFormation template of a service across two environments.
+The manifest is compared after applying the overrides of each environment.`,
+		Example: `
+  Compare the "frontend" service in the "test" environment to the "prod" environment.
+  /code $ copilot svc diff -n frontend --from-env test --to-env prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDiffSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.fromEnv, fromEnvFlag, "", fromEnvFlagDescription)
+	cmd.Flags().StringVar(&vars.toEnv, toEnvFlag, "", toEnvFlagDescription)
+	return cmd
+}
@@ -0,0 +1,276 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+)
+
+type svcDiffAskMock struct {
+	store *mocks.Mockstore
+	sel   *mocks.MockwsSelector
+	ws    *mocks.MockwsWlDirReader
+}
+
+func TestDiffSvcOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inSvcName string
+		inFromEnv string
+		inToEnv   string
+
+		setupMocks func(m svcDiffAskMock)
+
+		wantedSvcName string
+		wantedFromEnv string
+		wantedToEnv   string
+		wantedError   error
+	}{
+		"error if not in a workspace": {
+			setupMocks:  func(m svcDiffAskMock) {},
+			wantedError: errNoAppInWorkspace,
+		},
+		"error if the service is not in the workspace": {
+			inAppName: "phonetool",
+			inSvcName: "api",
+			setupMocks: func(m svcDiffAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.ws.EXPECT().ListServices().Return([]string{"frontend"}, nil)
+			},
+			wantedError: errors.New("service 'api' does not exist in the workspace"),
+		},
+		"prompt for the service and the environments": {
+			inAppName: "phonetool",
+			setupMocks: func(m svcDiffAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.sel.EXPECT().Service(svcDiffSvcNamePrompt, "").Return("api", nil)
+				m.sel.EXPECT().Environment(svcDiffFromEnvNamePrompt, "", "phonetool").Return("test", nil)
+				m.sel.EXPECT().Environment(svcDiffToEnvNamePrompt, "", "phonetool").Return("prod", nil)
+			},
+			wantedSvcName: "api",
+			wantedFromEnv: "test",
+			wantedToEnv:   "prod",
+		},
+		"validate the flags": {
+			inAppName: "phonetool",
+			inSvcName: "api",
+			inFromEnv: "test",
+			inToEnv:   "prod",
+			setupMocks: func(m svcDiffAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.ws.EXPECT().ListServices().Return([]string{"api"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{}, nil)
+			},
+			wantedSvcName: "api",
+			wantedFromEnv: "test",
+			wantedToEnv:   "prod",
+		},
+		"error if the same environment is selected twice": {
+			inAppName: "phonetool",
+			inSvcName: "api",
+			inFromEnv: "test",
+			setupMocks: func(m svcDiffAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.ws.EXPECT().ListServices().Return([]string{"api"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.sel.EXPECT().Environment(svcDiffToEnvNamePrompt, "", "phonetool").Return("test", nil)
+			},
+			wantedError: errors.New("--from-env and --to-env must be different environments"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcDiffAskMock{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockwsSelector(ctrl),
+				ws:    mocks.NewMockwsWlDirReader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &diffSvcOpts{
+				diffSvcVars: diffSvcVars{
+					appName: tc.inAppName,
+					name:    tc.inSvcName,
+					fromEnv: tc.inFromEnv,
+					toEnv:   tc.inToEnv,
+				},
+				store: m.store,
+				sel:   m.sel,
+				ws:    m.ws,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvcName, opts.name)
+			require.Equal(t, tc.wantedFromEnv, opts.fromEnv)
+			require.Equal(t, tc.wantedToEnv, opts.toEnv)
+		})
+	}
+}
+
+func TestDiffSvcOpts_Execute(t *testing.T) {
+	const mft = `name: api
+type: Backend Service
+count: 1
+environments:
+  prod:
+    count: 3
+`
+	testCases := map[string]struct {
+		templates      map[string]string
+		ignoreRules    string
+		envIgnoreRules string
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"write the differences of the manifest and the template": {
+			templates: map[string]string{
+				"test": `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+      Metadata: test`,
+				"prod": `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 3
+      Metadata: prod`,
+			},
+			ignoreRules: `paths: [/Resources/Service/Properties/Metadata]`,
+			wantedOutput: `Manifest of service api from test to prod:
+~ count: 1 -> 3
+
+CloudFormation template of service api from test to prod:
+~ Resources/Service/Properties:
+    ~ DesiredCount: 1 -> 3
+
+Resource changes:
+~ Service (AWS::ECS::Service) will be updated in place
+
+`,
+			wantedError: &errHasDiff{},
+		},
+		"ignore the differences matched by the rules of an environment": {
+			templates: map[string]string{
+				"test": `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1`,
+				"prod": `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 3`,
+			},
+			envIgnoreRules: `paths: [/Resources/Service/Properties/DesiredCount]`,
+			wantedOutput: `Manifest of service api from test to prod:
+~ count: 1 -> 3
+
+CloudFormation template of service api from test to prod:
+No changes.
+
+`,
+			wantedError: &errHasDiff{},
+		},
+		"no changes to the template": {
+			templates: map[string]string{
+				"test": `Resources: {}`,
+				"prod": `Resources: {}`,
+			},
+			wantedOutput: `Manifest of service api from test to prod:
+~ count: 1 -> 3
+
+CloudFormation template of service api from test to prod:
+No changes.
+
+`,
+			wantedError: &errHasDiff{},
+		},
+		"error if the template cannot be generated": {
+			templates: map[string]string{
+				"test": `Resources: {}`,
+			},
+			wantedError: errors.New("generate the template of service api for environment prod: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsWlDirReader(ctrl)
+			ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(mft), nil).Times(2)
+			ws.EXPECT().DiffIgnorePath().Return("copilot/diffignore.yml").AnyTimes()
+			ws.EXPECT().EnvDiffIgnorePath(gomock.Any()).DoAndReturn(func(env string) string {
+				return "copilot/environments/" + env + "/diffignore.yml"
+			}).AnyTimes()
+			ws.EXPECT().WorkloadDiffIgnorePath("api").Return("copilot/api/diffignore.yml").AnyTimes()
+			fs := afero.NewMemMapFs()
+			if tc.ignoreRules != "" {
+				require.NoError(t, afero.WriteFile(fs, "copilot/api/diffignore.yml", []byte(tc.ignoreRules), 0644))
+			}
+			if tc.envIgnoreRules != "" {
+				require.NoError(t, afero.WriteFile(fs, "copilot/environments/prod/diffignore.yml", []byte(tc.envIgnoreRules), 0644))
+			}
+			mockInterpolator := mocks.NewMockinterpolator(ctrl)
+			mockInterpolator.EXPECT().Interpolate(mft).Return(mft, nil).Times(2)
+			buf := &bytes.Buffer{}
+			opts := &diffSvcOpts{
+				diffSvcVars: diffSvcVars{
+					appName: "phonetool",
+					name:    "api",
+					fromEnv: "test",
+					toEnv:   "prod",
+				},
+				ws:         ws,
+				fs:         fs,
+				diffWriter: buf,
+				newInterpolator: func(app, env string) interpolator {
+					return mockInterpolator
+				},
+				renderTemplate: func(_ *diffSvcOpts, envName string) (string, error) {
+					tmpl, ok := tc.templates[envName]
+					if !ok {
+						return "", errors.New("some error")
+					}
+					return tmpl, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, buf.String())
+		})
+	}
+}
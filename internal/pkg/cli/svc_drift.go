@@ -0,0 +1,297 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	svcDriftNamePrompt     = "Which deployed service would you like to detect the drift of?"
+	svcDriftNameHelpPrompt = "The drift of a service is the changes made to its resources outside of Copilot, for example in the AWS console."
+	svcDriftRedeployPrompt = "Would you like to force a redeployment of service %s to environment %s?"
+	svcDriftRedeployHelp   = `Redeploying forces a new deployment of the service with the current manifest in your workspace.
+It does not revert the drifted properties: CloudFormation only updates the resources whose template changed.`
+)
+
+const nestedStackResourceType = "AWS::CloudFormation::Stack"
+
+type driftSvcVars struct {
+	appName string
+	name    string
+	envName string
+}
+
+type driftSvcOpts struct {
+	driftSvcVars
+
+	// Interfaces to interact with dependencies.
+	store            store
+	sel              deploySelector
+	prompt           prompter
+	driftWriter      io.Writer
+	newDriftDetector func(env *config.Environment) (stackDriftDetector, error)
+	newSvcDeployCmd  func(vars deployWkldVars) (cmd, error)
+}
+
+func newDriftSvcOpts(vars driftSvcVars) (*driftSvcOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc drift"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &driftSvcOpts{
+		driftSvcVars:     vars,
+		store:            store,
+		sel:              selector.NewDeploySelect(prompter, store, deployStore),
+		prompt:           prompter,
+		driftWriter:      log.OutputWriter,
+		newDriftDetector: newStackDriftDetector(sessProvider),
+		newSvcDeployCmd: func(vars deployWkldVars) (cmd, error) {
+			return newSvcDeployOpts(vars)
+		},
+	}, nil
+}
+
+// newStackDriftDetector returns a function that creates a drift detector for the stacks of an environment.
+func newStackDriftDetector(sessProvider *sessions.Provider) func(env *config.Environment) (stackDriftDetector, error) {
+	return func(env *config.Environment) (stackDriftDetector, error) {
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		return awscfn.New(sess), nil
+	}
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *driftSvcOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *driftSvcOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	} else {
+		app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	deployed, err := o.sel.DeployedService(svcDriftNamePrompt, svcDriftNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.name = deployed.Name
+	o.envName = deployed.Env
+	return nil
+}
+
+// Execute detects the drift of the service's stacks, and offers to redeploy the service if it drifted.
+func (o *driftSvcOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	detector, err := o.newDriftDetector(env)
+	if err != nil {
+		return err
+	}
+	drifted, err := writeStackDrift(detector, stack.NameForWorkload(o.appName, o.envName, o.name), o.driftWriter)
+	if err != nil {
+		return fmt.Errorf("detect drift of service %s in environment %s: %w", o.name, o.envName, err)
+	}
+	if !drifted {
+		return nil
+	}
+	redeploy, err := o.prompt.Confirm(fmt.Sprintf(svcDriftRedeployPrompt, o.name, o.envName), svcDriftRedeployHelp)
+	if err != nil {
+		return fmt.Errorf("confirm redeployment: %w", err)
+	}
+	if !redeploy {
+		return nil
+	}
+	deployCmd, err := o.newSvcDeployCmd(deployWkldVars{
+		appName:        o.appName,
+		name:           o.name,
+		envName:        o.envName,
+		forceNewUpdate: true,
+	})
+	if err != nil {
+		return err
+	}
+	return run(deployCmd)
+}
+
+type driftStack struct {
+	title string // Describes the stack in the output.
+	name  string // Name or ID of the stack.
+}
+
+// writeStackDrift detects the drift of a stack and of its nested stacks, such as the addons stack,
+// and writes the drifted properties of each stack in the same format as a diff.
+// It returns true if any of the resources drifted.
+func writeStackDrift(detector stackDriftDetector, stackName string, w io.Writer) (bool, error) {
+	stacks := []driftStack{
+		{
+			title: fmt.Sprintf("stack %s", stackName),
+			name:  stackName,
+		},
+	}
+	resources, err := detector.StackResources(stackName)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range resources {
+		if aws.StringValue(r.ResourceType) != nestedStackResourceType || aws.StringValue(r.PhysicalResourceId) == "" {
+			continue
+		}
+		stacks = append(stacks, driftStack{
+			title: fmt.Sprintf("nested stack %s", aws.StringValue(r.LogicalResourceId)),
+			name:  aws.StringValue(r.PhysicalResourceId),
+		})
+	}
+	var drifted bool
+	for _, s := range stacks {
+		drifts, err := detector.DetectDrift(context.Background(), s.name)
+		if err != nil {
+			return false, err
+		}
+		if len(drifts) == 0 {
+			continue
+		}
+		tree, err := driftTree(drifts)
+		if err != nil {
+			return false, fmt.Errorf("parse drift of %s: %w", s.title, err)
+		}
+		var out strings.Builder
+		if err := tree.Write(&out); err != nil {
+			return false, err
+		}
+		if _, err := fmt.Fprintf(w, "Drift of %s:\n%s\n", s.title, out.String()); err != nil {
+			return false, err
+		}
+		drifted = true
+	}
+	if !drifted {
+		if _, err := fmt.Fprintln(w, "No drift detected."); err != nil {
+			return false, err
+		}
+	}
+	return drifted, nil
+}
+
+// driftTree returns the differences from the expected to the actual properties of the drifted resources.
+func driftTree(drifts []*awscfn.StackResourceDrift) (templatediff.Tree, error) {
+	expected, actual := make(map[string]any), make(map[string]any)
+	for _, d := range drifts {
+		id := aws.StringValue(d.LogicalResourceId)
+		expectedProps, err := unmarshalDriftProperties(d.ExpectedProperties)
+		if err != nil {
+			return templatediff.Tree{}, fmt.Errorf("unmarshal expected properties of %s: %w", id, err)
+		}
+		expected[id] = map[string]any{
+			"Type":       aws.StringValue(d.ResourceType),
+			"Properties": expectedProps,
+		}
+		if aws.StringValue(d.StackResourceDriftStatus) == sdkcloudformation.StackResourceDriftStatusDeleted {
+			continue
+		}
+		actualProps, err := unmarshalDriftProperties(d.ActualProperties)
+		if err != nil {
+			return templatediff.Tree{}, fmt.Errorf("unmarshal actual properties of %s: %w", id, err)
+		}
+		actual[id] = map[string]any{
+			"Type":       aws.StringValue(d.ResourceType),
+			"Properties": actualProps,
+		}
+	}
+	from, err := yaml.Marshal(map[string]any{"Resources": expected})
+	if err != nil {
+		return templatediff.Tree{}, err
+	}
+	to, err := yaml.Marshal(map[string]any{"Resources": actual})
+	if err != nil {
+		return templatediff.Tree{}, err
+	}
+	return templatediff.From(string(from)).Parse(to)
+}
+
+// unmarshalDriftProperties unmarshals the properties of a resource drift, which are a JSON object.
+func unmarshalDriftProperties(props *string) (any, error) {
+	if aws.StringValue(props) == "" {
+		return nil, nil
+	}
+	var out any
+	if err := json.Unmarshal([]byte(aws.StringValue(props)), &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// buildSvcDriftCmd builds the command for detecting the drift of a deployed service.
+func buildSvcDriftCmd() *cobra.Command {
+	vars := driftSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects changes made to a deployed service outside of Copilot.",
+		Long: `Detects changes made to a deployed service outside of Copilot, for example in the AWS console.
+Runs CloudFormation drift detection on the stack of the service and on its addons stack.`,
+		Example: `
+  Detect the drift of the "frontend" service in the "prod" environment.
+  /code $ copilot svc drift -n frontend -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDriftSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+)
+
+type svcDriftExecuteMocks struct {
+	store    *mocks.Mockstore
+	detector *mocks.MockstackDriftDetector
+	prompt   *mocks.Mockprompter
+	deploy   *mocks.Mockcmd
+}
+
+func TestDriftSvcOpts_Execute(t *testing.T) {
+	modifiedService := &awscfn.StackResourceDrift{
+		LogicalResourceId:        aws.String("Service"),
+		ResourceType:             aws.String("AWS::ECS::Service"),
+		StackResourceDriftStatus: aws.String(sdkcloudformation.StackResourceDriftStatusModified),
+		ExpectedProperties:       aws.String(`{"DesiredCount":1,"LaunchType":"FARGATE"}`),
+		ActualProperties:         aws.String(`{"DesiredCount":3,"LaunchType":"FARGATE"}`),
+	}
+	deletedQueue := &awscfn.StackResourceDrift{
+		LogicalResourceId:        aws.String("Queue"),
+		ResourceType:             aws.String("AWS::SQS::Queue"),
+		StackResourceDriftStatus: aws.String(sdkcloudformation.StackResourceDriftStatusDeleted),
+		ExpectedProperties:       aws.String(`{"MessageRetentionPeriod":345600}`),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m svcDriftExecuteMocks)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"no drift": {
+			setupMocks: func(m svcDriftExecuteMocks) {
+				m.detector.EXPECT().StackResources("phonetool-test-api").Return(nil, nil)
+				m.detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test-api").Return(nil, nil)
+			},
+			wantedOutput: "No drift detected.\n",
+		},
+		"write the drift of the stack and of the addons stack without redeploying": {
+			setupMocks: func(m svcDriftExecuteMocks) {
+				m.detector.EXPECT().StackResources("phonetool-test-api").Return([]*awscfn.StackResource{
+					{
+						LogicalResourceId:  aws.String("Service"),
+						ResourceType:       aws.String("AWS::ECS::Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:service/api"),
+					},
+					{
+						LogicalResourceId:  aws.String("AddonsStack"),
+						ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+						PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:1111:stack/addons/1234"),
+					},
+				}, nil)
+				m.detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test-api").Return([]*awscfn.StackResourceDrift{modifiedService}, nil)
+				m.detector.EXPECT().DetectDrift(gomock.Any(), "arn:aws:cloudformation:us-west-2:1111:stack/addons/1234").Return([]*awscfn.StackResourceDrift{deletedQueue}, nil)
+				m.prompt.EXPECT().Confirm("Would you like to force a redeployment of service api to environment test?", gomock.Any()).Return(false, nil)
+			},
+			wantedOutput: `Drift of stack phonetool-test-api:
+~ Resources/Service/Properties:
+    ~ DesiredCount: 1 -> 3
+
+Drift of nested stack AddonsStack:
+~ Resources:
+    - Queue:
+    -     Properties:
+    -         MessageRetentionPeriod: 345600
+    -     Type: AWS::SQS::Queue
+
+`,
+		},
+		"force a redeployment of the service": {
+			setupMocks: func(m svcDriftExecuteMocks) {
+				m.detector.EXPECT().StackResources("phonetool-test-api").Return(nil, nil)
+				m.detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test-api").Return([]*awscfn.StackResourceDrift{modifiedService}, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				gomock.InOrder(
+					m.deploy.EXPECT().Validate().Return(nil),
+					m.deploy.EXPECT().Ask().Return(nil),
+					m.deploy.EXPECT().Execute().Return(nil),
+				)
+			},
+			wantedOutput: `Drift of stack phonetool-test-api:
+~ Resources/Service/Properties:
+    ~ DesiredCount: 1 -> 3
+
+`,
+		},
+		"error if the drift cannot be detected": {
+			setupMocks: func(m svcDriftExecuteMocks) {
+				m.detector.EXPECT().StackResources("phonetool-test-api").Return(nil, nil)
+				m.detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test-api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("detect drift of service api in environment test: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcDriftExecuteMocks{
+				store:    mocks.NewMockstore(ctrl),
+				detector: mocks.NewMockstackDriftDetector(ctrl),
+				prompt:   mocks.NewMockprompter(ctrl),
+				deploy:   mocks.NewMockcmd(ctrl),
+			}
+			m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			tc.setupMocks(m)
+			buf := &bytes.Buffer{}
+			opts := &driftSvcOpts{
+				driftSvcVars: driftSvcVars{
+					appName: "phonetool",
+					name:    "api",
+					envName: "test",
+				},
+				store:       m.store,
+				prompt:      m.prompt,
+				driftWriter: buf,
+				newDriftDetector: func(env *config.Environment) (stackDriftDetector, error) {
+					return m.detector, nil
+				},
+				newSvcDeployCmd: func(vars deployWkldVars) (cmd, error) {
+					require.Equal(t, deployWkldVars{
+						appName:        "phonetool",
+						name:           "api",
+						envName:        "test",
+						forceNewUpdate: true,
+					}, vars)
+					return m.deploy, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, buf.String())
+		})
+	}
+}
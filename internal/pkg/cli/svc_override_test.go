@@ -126,8 +126,8 @@ func TestOverrideSvc_Validate(t *testing.T) {
 			wanted error
 		}{
 			"return an error when an unknown language is selected": {
-				lang:   "python",
-				wanted: errors.New(`"python" is not a valid CDK language: must be one of: "typescript"`),
+				lang:   "java",
+				wanted: errors.New(`"java" is not a valid CDK language: must be one of: "typescript", "python", "go"`),
 			},
 			"typescript is a valid CDK language": {
 				lang: "typescript",
@@ -402,10 +402,27 @@ Resources:
 func TestOverrideSvc_Execute(t *testing.T) {
 	t.Run("with the CDK", func(t *testing.T) {
 		testCases := map[string]struct {
+			lang      string
 			resources []template.CFNResource
 			initMocks func(ctrl *gomock.Controller, cmd *overrideWorkloadOpts)
 			wanted    error
 		}{
+			"should succeed creating a Python CDK application": {
+				lang: "python",
+				resources: []template.CFNResource{
+					{
+						Type:      "AWS::ECS::Service",
+						LogicalID: "Service",
+					},
+				},
+				initMocks: func(ctrl *gomock.Controller, cmd *overrideWorkloadOpts) {
+					fs := afero.NewMemMapFs()
+					ws := mocks.NewMockwsWlDirReader(ctrl)
+					ws.EXPECT().WorkloadOverridesPath(gomock.Any()).Return(filepath.Join("copilot", "frontend", "overrides"))
+					cmd.ws = ws
+					cmd.fs = fs
+				},
+			},
 			"should succeed creating IaC files without any resources": {
 				initMocks: func(ctrl *gomock.Controller, cmd *overrideWorkloadOpts) {
 					fs := afero.NewMemMapFs()
@@ -451,7 +468,11 @@ func TestOverrideSvc_Execute(t *testing.T) {
 				ctrl := gomock.NewController(t)
 				defer ctrl.Finish()
 
-				vars := overrideVars{appName: "demo", name: "frontend", iacTool: "cdk", resources: tc.resources}
+				lang := tc.lang
+				if lang == "" {
+					lang = "typescript"
+				}
+				vars := overrideVars{appName: "demo", name: "frontend", iacTool: "cdk", cdkLang: lang, resources: tc.resources}
 				cmd := &overrideWorkloadOpts{
 					overrideOpts: &overrideOpts{
 						overrideVars: vars,
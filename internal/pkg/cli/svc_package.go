@@ -44,7 +44,9 @@ type packageSvcVars struct {
 	outputDir          string
 	uploadAssets       bool
 	showDiff           bool
+	diffJSON           bool
 	allowWkldDowngrade bool
+	offlineFixture     string
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -70,6 +72,7 @@ type packageSvcOpts struct {
 	newStackGenerator    func(*packageSvcOpts) (workloadStackGenerator, error)
 	envFeaturesDescriber versionCompatibilityChecker
 	gitShortCommit       string
+	fixture              *clideploy.OfflineFixture // Set when the template is generated offline.
 
 	// cached variables
 	targetApp         *config.Application
@@ -91,6 +94,9 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 	}
 
 	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc package"))
+	if vars.offlineFixture != "" {
+		return newOfflinePackageSvcOpts(vars, fs, ws, sessProvider)
+	}
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %v", err)
@@ -111,14 +117,81 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		addonsWriter:      discardFile{},
 		diffWriter:        os.Stdout,
 		templateVersion:   version.LatestTemplateVersion(),
-		newInterpolator:   newManifestInterpolator,
 		sessProvider:      sessProvider,
 		newStackGenerator: newWorkloadStackGenerator,
 	}
+	opts.newInterpolator = newResolvingManifestInterpolator(func() (*session.Session, error) {
+		return opts.envSess, nil
+	})
 	return opts, nil
 }
 
+// newOfflinePackageSvcOpts returns the options to generate the template of a service without AWS access,
+// where the application and environment are read from the offline fixture instead of the config store.
+func newOfflinePackageSvcOpts(vars packageSvcVars, fs afero.Fs, ws *workspace.Workspace, sessProvider *sessions.Provider) (*packageSvcOpts, error) {
+	fixture, err := clideploy.ReadOfflineFixture(fs, vars.offlineFixture)
+	if err != nil {
+		return nil, err
+	}
+	return &packageSvcOpts{
+		packageSvcVars:    vars,
+		ws:                ws,
+		fs:                fs,
+		unmarshal:         manifest.UnmarshalWorkload,
+		runner:            exec.NewCmd(),
+		templateWriter:    os.Stdout,
+		paramsWriter:      discardFile{},
+		addonsWriter:      discardFile{},
+		diffWriter:        os.Stdout,
+		templateVersion:   version.LatestTemplateVersion(),
+		newInterpolator:   newManifestInterpolator,
+		sessProvider:      sessProvider,
+		newStackGenerator: newOfflineWorkloadStackGenerator,
+		fixture:           fixture,
+	}, nil
+}
+
 func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error) {
+	in, err := o.workloadDeployerInput()
+	if err != nil {
+		return nil, err
+	}
+	var deployer workloadStackGenerator
+	switch t := in.Mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		deployer, err = clideploy.NewLBWSDeployer(in)
+	case *manifest.BackendService:
+		deployer, err = clideploy.NewBackendDeployer(in)
+	case *manifest.RequestDrivenWebService:
+		deployer, err = clideploy.NewRDWSDeployer(in)
+	case *manifest.WorkerService:
+		deployer, err = clideploy.NewWorkerSvcDeployer(in)
+	case *manifest.ScheduledJob:
+		deployer, err = clideploy.NewJobDeployer(in)
+	case *manifest.StaticSite:
+		deployer, err = clideploy.NewStaticSiteDeployer(in)
+	default:
+		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("initiate workload template generator: %w", err)
+	}
+	return deployer, nil
+}
+
+func newOfflineWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error) {
+	in, err := o.workloadDeployerInput()
+	if err != nil {
+		return nil, err
+	}
+	gen, err := clideploy.NewOfflineWorkloadStackGenerator(in, o.fixture)
+	if err != nil {
+		return nil, fmt.Errorf("initiate offline workload template generator: %w", err)
+	}
+	return gen, nil
+}
+
+func (o *packageSvcOpts) workloadDeployerInput() (*clideploy.WorkloadDeployerInput, error) {
 	targetApp, err := o.getTargetApp()
 	if err != nil {
 		return nil, err
@@ -127,14 +200,20 @@ func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error
 	if err != nil {
 		return nil, err
 	}
-	ovrdr, err := clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, o.fs, o.sessProvider)
+	sharedOverrides, err := o.ws.SharedWorkloadOverridesPaths()
+	if err != nil {
+		return nil, fmt.Errorf("get shared overrides directories: %w", err)
+	}
+	ovrdr, err := clideploy.NewLayeredOverrider(append(sharedOverrides, o.ws.WorkloadOverridesPath(o.name)), o.appName, o.envName, o.fs, o.sessProvider)
+	if err != nil {
+		return nil, err
+	}
+	ignoreRules, err := clideploy.NewDiffIgnoreRules(o.fs, o.ws.DiffIgnorePath(), o.ws.EnvDiffIgnorePath(o.envName), o.ws.WorkloadDiffIgnorePath(o.name))
 	if err != nil {
 		return nil, err
 	}
 
-	content := o.appliedDynamicMft.Manifest()
-	var deployer workloadStackGenerator
-	in := clideploy.WorkloadDeployerInput{
+	return &clideploy.WorkloadDeployerInput{
 		SessionProvider: o.sessProvider,
 		Name:            o.name,
 		App:             targetApp,
@@ -143,40 +222,27 @@ func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error
 			CustomTag:         o.tag,
 			GitShortCommitTag: o.gitShortCommit,
 		},
-		Mft:              content,
+		Mft:              o.appliedDynamicMft.Manifest(),
 		RawMft:           o.rawMft,
 		EnvVersionGetter: o.envFeaturesDescriber,
 		Overrider:        ovrdr,
-	}
-	switch t := content.(type) {
-	case *manifest.LoadBalancedWebService:
-		deployer, err = clideploy.NewLBWSDeployer(&in)
-	case *manifest.BackendService:
-		deployer, err = clideploy.NewBackendDeployer(&in)
-	case *manifest.RequestDrivenWebService:
-		deployer, err = clideploy.NewRDWSDeployer(&in)
-	case *manifest.WorkerService:
-		deployer, err = clideploy.NewWorkerSvcDeployer(&in)
-	case *manifest.ScheduledJob:
-		deployer, err = clideploy.NewJobDeployer(&in)
-	case *manifest.StaticSite:
-		deployer, err = clideploy.NewStaticSiteDeployer(&in)
-	default:
-		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
-	}
-	if err != nil {
-		return nil, fmt.Errorf("initiate workload template generator: %w", err)
-	}
-	return deployer, nil
+		DiffIgnoreRules:  ignoreRules,
+	}, nil
 }
 
 // Validate returns an error for any invalid optional flags.
 func (o *packageSvcOpts) Validate() error {
-	return nil
+	if o.offlineFixture != "" && o.name == "" {
+		return fmt.Errorf("--%s is required when --%s is used", nameFlag, offlineFixtureFlag)
+	}
+	return validateDiffJSON(o.showDiff, o.diffJSON)
 }
 
 // Ask prompts for and validates any required flags.
 func (o *packageSvcOpts) Ask() error {
+	if o.fixture != nil {
+		return o.askOffline()
+	}
 	if o.appName != "" {
 		if _, err := o.getTargetApp(); err != nil {
 			return err
@@ -201,7 +267,7 @@ func (o *packageSvcOpts) Execute() error {
 			return err
 		}
 	}
-	if !o.allowWkldDowngrade {
+	if !o.allowWkldDowngrade && o.fixture == nil {
 		if err := validateWkldVersion(o.svcVersionGetter, o.name, o.templateVersion); err != nil {
 			return err
 		}
@@ -224,7 +290,7 @@ func (o *packageSvcOpts) Execute() error {
 		return err
 	}
 	if o.showDiff {
-		if err := diff(gen, stack.template, o.diffWriter); err != nil {
+		if err := diff(gen, stack.template, o.diffWriter, o.diffJSON); err != nil {
 			var errHasDiff *errHasDiff
 			if errors.As(err, &errHasDiff) {
 				return err
@@ -257,6 +323,41 @@ func (o *packageSvcOpts) Execute() error {
 	return o.writeAndClose(o.addonsWriter, addonsTemplate)
 }
 
+// askOffline validates the names of the application and environment against the offline fixture.
+// A name that is missing from the fixture is taken from the flags, and vice versa.
+func (o *packageSvcOpts) askOffline() error {
+	appName, err := offlineName("application", o.appName, o.fixture.App.Name)
+	if err != nil {
+		return err
+	}
+	if appName == "" {
+		return errNoAppInWorkspace
+	}
+	envName, err := offlineName("environment", o.envName, o.fixture.Env.Name)
+	if err != nil {
+		return err
+	}
+	if envName == "" {
+		return fmt.Errorf("--%s is required when the offline fixture has no environment name", envFlag)
+	}
+	o.appName, o.fixture.App.Name = appName, appName
+	o.envName, o.fixture.Env.Name = envName, envName
+	o.targetApp = o.fixture.App.Config()
+	o.targetEnv = o.fixture.Env.Config(appName)
+	return o.validateOrAskSvcName()
+}
+
+func offlineName(kind, flagValue, fixtureValue string) (string, error) {
+	switch {
+	case flagValue == "":
+		return fixtureValue, nil
+	case fixtureValue == "" || fixtureValue == flagValue:
+		return flagValue, nil
+	default:
+		return "", fmt.Errorf("%s %q does not match %s %q of the offline fixture", kind, flagValue, kind, fixtureValue)
+	}
+}
+
 func (o *packageSvcOpts) validateOrAskSvcName() error {
 	if o.name != "" {
 		names, err := o.ws.ListServices()
@@ -293,6 +394,9 @@ func (o *packageSvcOpts) validateOrAskEnvName() error {
 
 func (o *packageSvcOpts) configureClients() error {
 	o.gitShortCommit = imageTagFromGit(o.runner) // Best effort assign git tag.
+	if o.fixture != nil {
+		return o.configureOfflineClients()
+	}
 	// client to retrieve an application's resources created with CloudFormation.
 	defaultSess, err := o.sessProvider.Default()
 	if err != nil {
@@ -337,6 +441,16 @@ func (o *packageSvcOpts) configureClients() error {
 	return nil
 }
 
+func (o *packageSvcOpts) configureOfflineClients() error {
+	rootUserARN, err := o.fixture.RootUserARN()
+	if err != nil {
+		return fmt.Errorf("get root user ARN from the offline fixture: %w", err)
+	}
+	o.rootUserARN = rootUserARN
+	o.envFeaturesDescriber = &o.fixture.Env
+	return nil
+}
+
 type cfnStackConfig struct {
 	template   string
 	parameters string
@@ -351,6 +465,7 @@ func (o *packageSvcOpts) getStackGenerator(env *config.Environment) (workloadSta
 		interpolator: o.newInterpolator(o.appName, o.envName),
 		unmarshal:    o.unmarshal,
 		sess:         o.envSess,
+		fixture:      o.fixture,
 	})
 	if err != nil {
 		return nil, err
@@ -504,7 +619,11 @@ func buildSvcPackageCmd() *cobra.Command {
   $ copilot svc package -n frontend -e test --output-dir ./infrastructure
   $ ls ./infrastructure
   frontend-test.stack.yml      frontend-test.params.json
-  /endcodeblock`,
+  /endcodeblock
+
+  Print the CloudFormation template for the "frontend" service without AWS credentials,
+  reading the application and environment configuration from a fixture file.
+  /code $ copilot svc package -n frontend --offline-fixture ./fixtures/test.yml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPackageSvcOpts(vars)
 			if err != nil {
@@ -520,9 +639,13 @@ func buildSvcPackageCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.diffJSON, jsonFlag, false, diffJSONFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().StringVar(&vars.offlineFixture, offlineFixtureFlag, "", offlineFixtureFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, stackOutputDirFlag)
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, uploadAssetsFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFixtureFlag, diffFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFixtureFlag, uploadAssetsFlag)
 	return cmd
 }
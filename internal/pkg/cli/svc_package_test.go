@@ -30,6 +30,7 @@ func TestPackageSvcOpts_Ask(t *testing.T) {
 		inAppName string
 		inSvcName string
 		inEnvName string
+		inFixture *deploy.OfflineFixture
 
 		setupMocks func(m svcPackageAskMock)
 
@@ -87,6 +88,43 @@ func TestPackageSvcOpts_Ask(t *testing.T) {
 			wantedSvcName: "frontend",
 			wantedEnvName: "prod-iad",
 		},
+		"read the application and environment names from the offline fixture instead of the store": {
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			inFixture: &deploy.OfflineFixture{
+				Env: deploy.OfflineEnvironment{
+					Name:   "test",
+					Region: "us-west-2",
+				},
+			},
+			setupMocks: func(m svcPackageAskMock) {
+				m.store.EXPECT().GetApplication(gomock.Any()).Times(0)
+				m.store.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Times(0)
+				m.ws.EXPECT().ListServices().Return([]string{"frontend"}, nil)
+			},
+			wantedAppName: "phonetool",
+			wantedSvcName: "frontend",
+			wantedEnvName: "test",
+		},
+		"error if the environment name does not match the offline fixture": {
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			inEnvName: "prod",
+			inFixture: &deploy.OfflineFixture{
+				Env: deploy.OfflineEnvironment{
+					Name: "test",
+				},
+			},
+			setupMocks:  func(m svcPackageAskMock) {},
+			wantedError: errors.New(`environment "prod" does not match environment "test" of the offline fixture`),
+		},
+		"error if the offline fixture and the flags have no environment name": {
+			inAppName:   "phonetool",
+			inSvcName:   "frontend",
+			inFixture:   &deploy.OfflineFixture{},
+			setupMocks:  func(m svcPackageAskMock) {},
+			wantedError: errors.New("--env is required when the offline fixture has no environment name"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -107,10 +145,11 @@ func TestPackageSvcOpts_Ask(t *testing.T) {
 					envName: tc.inEnvName,
 					appName: tc.inAppName,
 				},
-				sel:    m.sel,
-				store:  m.store,
-				ws:     m.ws,
-				runner: mocks.NewMockexecRunner(ctrl),
+				sel:     m.sel,
+				store:   m.store,
+				ws:      m.ws,
+				runner:  mocks.NewMockexecRunner(ctrl),
+				fixture: tc.inFixture,
 			}
 
 			// WHEN
@@ -124,6 +163,10 @@ func TestPackageSvcOpts_Ask(t *testing.T) {
 				require.Equal(t, tc.wantedAppName, opts.appName)
 				require.Equal(t, tc.wantedSvcName, opts.name)
 				require.Equal(t, tc.wantedEnvName, opts.envName)
+				if tc.inFixture != nil {
+					require.Equal(t, tc.wantedAppName, opts.targetApp.Name)
+					require.Equal(t, tc.wantedEnvName, opts.targetEnv.Name)
+				}
 			}
 		})
 	}
@@ -131,6 +174,7 @@ func TestPackageSvcOpts_Ask(t *testing.T) {
 
 type svcPackageExecuteMock struct {
 	ws                   *mocks.MockwsWlDirReader
+	runner               *mocks.MockexecRunner
 	generator            *mocks.MockworkloadStackGenerator
 	interpolator         *mocks.Mockinterpolator
 	envFeaturesDescriber *mocks.MockversionCompatibilityChecker
@@ -176,7 +220,8 @@ memory: 512
 count: 1`
 	)
 	testCases := map[string]struct {
-		inVars packageSvcVars
+		inVars    packageSvcVars
+		inFixture *deploy.OfflineFixture
 
 		setupMocks func(m *svcPackageExecuteMock)
 
@@ -218,7 +263,7 @@ count: 1`
 					Template:   "mystack",
 					Parameters: "myparams",
 				}, nil)
-				m.generator.EXPECT().DeployDiff(gomock.Eq("mystack")).Return("", errors.New("some error"))
+				m.generator.EXPECT().DeployDiff(gomock.Eq("mystack"), gomock.Any()).Return("", errors.New("some error"))
 			},
 			wantedErr: &errDiffNotAvailable{parentErr: errors.New("some error")},
 		},
@@ -243,7 +288,7 @@ count: 1`
 					Template:   "mystack",
 					Parameters: "myparams",
 				}, nil)
-				m.generator.EXPECT().DeployDiff(gomock.Eq("mystack")).Return("mock diff", nil)
+				m.generator.EXPECT().DeployDiff(gomock.Eq("mystack"), gomock.Any()).Return("mock diff", nil)
 			},
 			wantedDiff: "mock diff",
 			wantedErr:  &errHasDiff{},
@@ -326,6 +371,43 @@ count: 1`
 			wantedStack:  "mystack",
 			wantedParams: "myparams",
 		},
+		"writes service template offline with the configuration of the fixture": {
+			inVars: packageSvcVars{
+				appName: "ecs-kudos",
+				name:    "api",
+				envName: "test",
+			},
+			inFixture: &deploy.OfflineFixture{
+				Env: deploy.OfflineEnvironment{
+					Name:            "test",
+					Region:          "us-west-2",
+					AccountID:       "123456789012",
+					TemplateVersion: "v1.mock",
+				},
+			},
+			setupMocks: func(m *svcPackageExecuteMock) {
+				m.runner.EXPECT().Run("git", gomock.Any(), gomock.Any()).Return(errors.New("not a git repository"))
+				m.mockVersionGetter.EXPECT().Version().Times(0)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(lbwsMft), nil)
+				m.interpolator.EXPECT().Interpolate(lbwsMft).Return(lbwsMft, nil)
+				m.mft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{}
+					},
+				}
+				m.generator.EXPECT().GenerateCloudFormationTemplate(&deploy.GenerateCloudFormationTemplateInput{
+					StackRuntimeConfiguration: deploy.StackRuntimeConfiguration{
+						RootUserARN: "arn:aws:iam::123456789012:root",
+					},
+				}).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template:   "mystack",
+					Parameters: "myparams",
+				}, nil)
+				m.generator.EXPECT().AddonsTemplate().Return("", nil)
+			},
+			wantedStack:  "mystack",
+			wantedParams: "myparams",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -341,6 +423,7 @@ count: 1`
 
 			m := &svcPackageExecuteMock{
 				ws:                   mocks.NewMockwsWlDirReader(ctrl),
+				runner:               mocks.NewMockexecRunner(ctrl),
 				generator:            mocks.NewMockworkloadStackGenerator(ctrl),
 				interpolator:         mocks.NewMockinterpolator(ctrl),
 				envFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
@@ -370,6 +453,8 @@ count: 1`
 				envFeaturesDescriber: m.envFeaturesDescriber,
 				targetApp:            &config.Application{},
 				targetEnv:            &config.Environment{},
+				runner:               m.runner,
+				fixture:              tc.inFixture,
 			}
 
 			// WHEN
@@ -0,0 +1,320 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	validateEnvFlagDescription = `Optional. Name of the environment to validate the manifests against.
+Defaults to all the environments of the workspace and of the overrides in the manifests.`
+)
+
+type validateManifestsVars struct {
+	appName string
+	envName string
+}
+
+type validateManifestsOpts struct {
+	validateManifestsVars
+
+	ws              wsManifestsReader
+	newInterpolator func(app, env string) interpolator
+	problemsWriter  io.Writer
+}
+
+func newValidateManifestsOpts(vars validateManifestsVars) (*validateManifestsOpts, error) {
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	return &validateManifestsOpts{
+		validateManifestsVars: vars,
+		ws:                    ws,
+		newInterpolator:       newManifestInterpolator,
+		problemsWriter:        os.Stdout,
+	}, nil
+}
+
+// manifestProblem is an error in a manifest file.
+type manifestProblem struct {
+	path string            // Path to the manifest file relative to the workspace.
+	pos  manifest.Position // Position of the error in the file, zero if unknown.
+	envs []string          // Environments that the workload manifest is invalid for, if the error depends on them.
+	err  error
+}
+
+// String returns the problem in the "file:line:column: message" format understood by editors and CI systems.
+func (p *manifestProblem) String() string {
+	loc := p.path
+	if p.pos.Line > 0 {
+		loc = fmt.Sprintf("%s:%d:%d", p.path, p.pos.Line, p.pos.Column)
+	}
+	return fmt.Sprintf("%s: %s", loc, p.message())
+}
+
+// message returns the error of the problem and the environments it occurs in.
+func (p *manifestProblem) message() string {
+	if len(p.envs) > 0 && p.envs[0] != "" {
+		return fmt.Sprintf("%s (environments: %s)", p.err, strings.Join(p.envs, ", "))
+	}
+	return p.err.Error()
+}
+
+// Validate is a no-op for this command.
+func (o *validateManifestsOpts) Validate() error {
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *validateManifestsOpts) Ask() error {
+	return nil
+}
+
+// Execute validates the workload, environment and pipeline manifests of the workspace
+// and returns an error if any of them is invalid.
+func (o *validateManifestsOpts) Execute() error {
+	envs, err := listWorkspaceEnvs(o.ws)
+	if err != nil {
+		return err
+	}
+	var problems []*manifestProblem
+	wkldProblems, err := o.validateWorkloads(envs)
+	if err != nil {
+		return err
+	}
+	problems = append(problems, wkldProblems...)
+	envProblems, err := o.validateEnvironments(envs)
+	if err != nil {
+		return err
+	}
+	problems = append(problems, envProblems...)
+	pipelineProblems, err := o.validatePipelines()
+	if err != nil {
+		return err
+	}
+	problems = append(problems, pipelineProblems...)
+
+	for _, problem := range problems {
+		fmt.Fprintln(o.problemsWriter, problem.String())
+	}
+	switch len(problems) {
+	case 0:
+		log.Successln("All manifests are valid.")
+		return nil
+	case 1:
+		return errors.New("found 1 problem in the manifests")
+	default:
+		return fmt.Errorf("found %d problems in the manifests", len(problems))
+	}
+}
+
+// workspaceEnvs returns the names of the environments with a manifest in the workspace.
+
+func (o *validateManifestsOpts) validateWorkloads(wsEnvs []string) ([]*manifestProblem, error) {
+	names, err := o.ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in workspace: %w", err)
+	}
+	var problems []*manifestProblem
+	for _, name := range names {
+		path := workspaceRelPath(o.ws, o.ws.WorkloadManifestFileAbsPath(name))
+		raw, err := o.ws.ReadWorkloadManifest(name)
+		if err != nil {
+			problems = append(problems, &manifestProblem{path: path, err: err})
+			continue
+		}
+		problems = append(problems, o.workloadProblems(path, raw, wsEnvs)...)
+	}
+	return problems, nil
+}
+
+// workloadProblems returns the problems of the workload manifest at path against each of its environments.
+func (o *validateManifestsOpts) workloadProblems(path string, raw []byte, wsEnvs []string) []*manifestProblem {
+	var envs []string
+	if o.envName != "" {
+		envs = []string{o.envName}
+	} else {
+		envs = append(slices.Clone(wsEnvs), overriddenEnvNames(raw)...)
+		slices.Sort(envs)
+		envs = slices.Compact(envs)
+	}
+	if len(envs) == 0 {
+		// Validate the manifest without any environment override.
+		envs = []string{""}
+	}
+	// The same error is usually returned for all environments: report it only once.
+	var problems []*manifestProblem
+	for _, env := range envs {
+		err := o.validateWorkload(raw, env)
+		if err == nil {
+			continue
+		}
+		idx := slices.IndexFunc(problems, func(p *manifestProblem) bool { return p.err.Error() == err.Error() })
+		if idx != -1 {
+			problems[idx].envs = append(problems[idx].envs, env)
+			continue
+		}
+		pos, _ := manifest.ErrorPosition(raw, err, env)
+		problems = append(problems, &manifestProblem{
+			path: path,
+			pos:  pos,
+			envs: []string{env},
+			err:  err,
+		})
+	}
+	return problems
+}
+
+func (o *validateManifestsOpts) validateWorkload(raw []byte, env string) error {
+	interpolated, err := o.newInterpolator(o.appName, env).Interpolate(string(raw))
+	if err != nil {
+		return fmt.Errorf("interpolate environment variables: %w", err)
+	}
+	mft, err := manifest.UnmarshalWorkload([]byte(interpolated))
+	if err != nil {
+		return manifest.WithManifestLines(raw, []byte(interpolated), err)
+	}
+	envMft, err := mft.ApplyEnv(env)
+	if err != nil {
+		return fmt.Errorf("apply environment %s override: %w", env, err)
+	}
+	return envMft.Validate()
+}
+
+func (o *validateManifestsOpts) validateEnvironments(envs []string) ([]*manifestProblem, error) {
+	if o.envName != "" {
+		if !slices.Contains(envs, o.envName) {
+			return nil, nil
+		}
+		envs = []string{o.envName}
+	}
+	var problems []*manifestProblem
+	for _, env := range envs {
+		path := workspaceRelPath(o.ws, o.ws.EnvManifestFileAbsPath(env))
+		raw, err := o.ws.ReadEnvironmentManifest(env)
+		if err != nil {
+			problems = append(problems, &manifestProblem{path: path, err: err})
+			continue
+		}
+		problems = append(problems, o.environmentProblems(path, env, raw)...)
+	}
+	return problems, nil
+}
+
+// environmentProblems returns the problems of the manifest at path of the environment env.
+func (o *validateManifestsOpts) environmentProblems(path, env string, raw []byte) []*manifestProblem {
+	if err := o.validateEnvironment(raw, env); err != nil {
+		pos, _ := manifest.ErrorPosition(raw, err, "")
+		return []*manifestProblem{{path: path, pos: pos, err: err}}
+	}
+	return nil
+}
+
+func (o *validateManifestsOpts) validateEnvironment(raw []byte, env string) error {
+	interpolated, err := o.newInterpolator(o.appName, env).Interpolate(string(raw))
+	if err != nil {
+		return fmt.Errorf("interpolate environment variables: %w", err)
+	}
+	mft, err := manifest.UnmarshalEnvironment([]byte(interpolated))
+	if err != nil {
+		return manifest.WithManifestLines(raw, []byte(interpolated), err)
+	}
+	return mft.Validate()
+}
+
+func (o *validateManifestsOpts) validatePipelines() ([]*manifestProblem, error) {
+	paths, err := o.ws.ListPipelineManifestPaths()
+	if err != nil {
+		return nil, fmt.Errorf("list pipelines in workspace: %w", err)
+	}
+	var problems []*manifestProblem
+	for _, absPath := range paths {
+		path := workspaceRelPath(o.ws, absPath)
+		raw, err := o.ws.ReadFile(absPath)
+		if err != nil {
+			problems = append(problems, &manifestProblem{path: path, err: err})
+			continue
+		}
+		problems = append(problems, pipelineProblems(path, raw)...)
+	}
+	return problems, nil
+}
+
+// pipelineProblems returns the problems of the pipeline manifest at path.
+func pipelineProblems(path string, raw []byte) []*manifestProblem {
+	mft, err := manifest.UnmarshalPipeline(raw)
+	if err == nil {
+		err = mft.Validate()
+	}
+	if err != nil {
+		pos, _ := manifest.ErrorPosition(raw, err, "")
+		return []*manifestProblem{{path: path, pos: pos, err: err}}
+	}
+	return nil
+}
+
+// overriddenEnvNames returns the names of the environments under the "environments" field of a workload manifest.
+func overriddenEnvNames(raw []byte) []string {
+	var mft struct {
+		Environments map[string]yaml.Node `yaml:"environments"`
+	}
+	if err := yaml.Unmarshal(raw, &mft); err != nil {
+		// The error is reported when the manifest is unmarshaled.
+		return nil
+	}
+	var names []string
+	for name := range mft.Environments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BuildValidateCmd builds the command for validating the manifests of a workspace.
+func BuildValidateCmd() *cobra.Command {
+	vars := validateManifestsVars{}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates the manifests of your workspace.",
+		Long: `Validates the manifests of your workspace without calling AWS.
+Workload manifests are validated against each of their environment overrides.
+Exits with a non-zero status if any manifest is invalid, so it can be used as a pre-commit hook.`,
+		Example: `
+  Validate all the manifests of the workspace.
+  /code $ copilot validate
+  Validate the workload manifests against the "test" environment only.
+  /code $ copilot validate --env test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newValidateManifestsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", validateEnvFlagDescription)
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
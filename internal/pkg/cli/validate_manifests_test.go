@@ -0,0 +1,182 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateManifestsOpts_Execute(t *testing.T) {
+	const (
+		validSvcMft = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+`
+		invalidOverrideSvcMft = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+environments:
+  test:
+    count:
+      range: 1-10
+      requests: 100
+`
+		invalidSvcMft = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+count:
+  range: 1-10
+  requests: 100
+`
+		invalidTypeSvcMft = `# The api service.
+name: api
+type: Backend Service
+
+image:
+  # Built from the root of the repository.
+  build: api/Dockerfile
+  port: 8080
+
+# Resources of the task.
+cpu: abc
+`
+		validEnvMft = `name: test
+type: Environment
+`
+		invalidPipelineMft = `name: release
+version: 1
+stages:
+  - name: test
+   requires_approval: true
+`
+	)
+	mockWorkspace := func(m *mocks.MockwsManifestsReader, wkldMft string, envs []string, pipelineMfts map[string]string) {
+		m.EXPECT().ListEnvironments().Return(envs, nil)
+		m.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+		m.EXPECT().WorkloadManifestFileAbsPath("api").Return("/ws/copilot/api/manifest.yml")
+		m.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(wkldMft), nil)
+		for _, env := range envs {
+			m.EXPECT().EnvManifestFileAbsPath(env).Return(fmt.Sprintf("/ws/copilot/environments/%s/manifest.yml", env)).AnyTimes()
+			m.EXPECT().ReadEnvironmentManifest(env).Return(workspace.EnvironmentManifest(strings.ReplaceAll(validEnvMft, "test", env)), nil).AnyTimes()
+		}
+		var paths []string
+		for path, mft := range pipelineMfts {
+			paths = append(paths, path)
+			m.EXPECT().ReadFile(path).Return([]byte(mft), nil)
+		}
+		m.EXPECT().ListPipelineManifestPaths().Return(paths, nil)
+		m.EXPECT().Rel(gomock.Any()).DoAndReturn(func(path string) (string, error) {
+			return strings.TrimPrefix(path, "/ws/"), nil
+		}).AnyTimes()
+	}
+	testCases := map[string]struct {
+		inEnv       string
+		setupMocks  func(m *mocks.MockwsManifestsReader)
+		wantedOut   string
+		wantedError error
+	}{
+		"error if workloads can't be listed": {
+			setupMocks: func(m *mocks.MockwsManifestsReader) {
+				m.EXPECT().ListEnvironments().Return(nil, os.ErrNotExist)
+				m.EXPECT().ListWorkloads().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list workloads in workspace: some error"),
+		},
+		"all manifests are valid": {
+			setupMocks: func(m *mocks.MockwsManifestsReader) {
+				mockWorkspace(m, validSvcMft, []string{"prod", "test"}, nil)
+			},
+		},
+		"workload manifest without environments": {
+			setupMocks: func(m *mocks.MockwsManifestsReader) {
+				m.EXPECT().ListEnvironments().Return(nil, fmt.Errorf("read directory: %w", os.ErrNotExist))
+				m.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.EXPECT().WorkloadManifestFileAbsPath("api").Return("/ws/copilot/api/manifest.yml")
+				m.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(invalidSvcMft), nil)
+				m.EXPECT().ListPipelineManifestPaths().Return(nil, nil)
+				m.EXPECT().Rel(gomock.Any()).Return("copilot/api/manifest.yml", nil)
+			},
+			wantedOut: `copilot/api/manifest.yml:8:3: "http" must be specified if "count.requests" or "count.response_time" are specified
+`,
+			wantedError: errors.New("found 1 problem in the manifests"),
+		},
+		"errors are reported once for all the environments": {
+			setupMocks: func(m *mocks.MockwsManifestsReader) {
+				mockWorkspace(m, invalidSvcMft, []string{"prod", "test"}, nil)
+			},
+			wantedOut: `copilot/api/manifest.yml:8:3: "http" must be specified if "count.requests" or "count.response_time" are specified (environments: prod, test)
+`,
+			wantedError: errors.New("found 1 problem in the manifests"),
+		},
+		"errors in environment overrides": {
+			setupMocks: func(m *mocks.MockwsManifestsReader) {
+				mockWorkspace(m, invalidOverrideSvcMft, []string{"prod"}, map[string]string{
+					"/ws/copilot/pipelines/release/manifest.yml": invalidPipelineMft,
+				})
+			},
+			wantedOut: `copilot/api/manifest.yml:10:7: "http" must be specified if "count.requests" or "count.response_time" are specified (environments: test)
+copilot/pipelines/release/manifest.yml:3:1: yaml: line 3: did not find expected '-' indicator
+`,
+			wantedError: errors.New("found 2 problems in the manifests"),
+		},
+		"type errors are reported at their line in the manifest file": {
+			setupMocks: func(m *mocks.MockwsManifestsReader) {
+				mockWorkspace(m, invalidTypeSvcMft, []string{"prod"}, nil)
+			},
+			wantedOut:   "copilot/api/manifest.yml:11:1: unmarshal manifest for Backend Service: yaml: unmarshal errors:\n  line 11: cannot unmarshal !!str `abc` into int (environments: prod)\n",
+			wantedError: errors.New("found 1 problem in the manifests"),
+		},
+		"only validate the manifests against the environment flag": {
+			inEnv: "prod",
+			setupMocks: func(m *mocks.MockwsManifestsReader) {
+				mockWorkspace(m, invalidOverrideSvcMft, []string{"prod", "test"}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsManifestsReader(ctrl)
+			tc.setupMocks(ws)
+			out := &strings.Builder{}
+			opts := &validateManifestsOpts{
+				validateManifestsVars: validateManifestsVars{
+					appName: "phonetool",
+					envName: tc.inEnv,
+				},
+				ws:              ws,
+				newInterpolator: newManifestInterpolator,
+				problemsWriter:  out,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOut, out.String())
+		})
+	}
+}
@@ -52,6 +52,10 @@ var (
 	ccRepoExp = regexp.MustCompile(`(https:\/\/(?P<region>.+).console.aws.amazon.com\/codesuite\/codecommit\/repositories\/(?P<repo>.+)(\/browse))`)
 	// Ex: https://bitbucket.org/repoOwner/repoName
 	bbRepoExp = regexp.MustCompile(`(https:\/\/bitbucket.org\/)(?P<owner>.+)\/(?P<repo>.+)`)
+	// Ex: https://gitlab.com/repoOwner/subgroup/repoName
+	glRepoExp = regexp.MustCompile(`(https:\/\/gitlab\.com\/)(?P<owner>.+)\/(?P<repo>.+)`)
+	// Ex: https://git.example.com/repoOwner/repoName
+	selfHostedRepoExp = regexp.MustCompile(`(https:\/\/[^\/]+\/)(?P<owner>.+)\/(?P<repo>.+)`)
 )
 
 // CreatePipelineInput represents the fields required to deploy a pipeline.
@@ -167,6 +171,7 @@ type GitHubSource struct {
 	RepositoryURL        GitHubURL
 	ConnectionARN        string
 	OutputArtifactFormat string
+	Trigger              *PipelineTrigger
 }
 
 // GitHubURL is the common type for repo URLs for both GitHubSource versions:
@@ -188,6 +193,73 @@ type BitbucketSource struct {
 	RepositoryURL        string
 	ConnectionARN        string
 	OutputArtifactFormat string
+	Trigger              *PipelineTrigger
+}
+
+// GitLabSource defines the (GL) source of the artifacts to be built and deployed.
+type GitLabSource struct {
+	ProviderName         string
+	Branch               string
+	RepositoryURL        string
+	ConnectionARN        string
+	OutputArtifactFormat string
+	Trigger              *PipelineTrigger
+}
+
+// SelfHostedSource defines the source of the artifacts to be built and deployed for repositories on
+// GitHub Enterprise Server or GitLab self-managed. The CodeStar Connections connection is made through a host.
+type SelfHostedSource struct {
+	ProviderName         string
+	Branch               string
+	RepositoryURL        string
+	HostARN              string
+	ConnectionARN        string
+	OutputArtifactFormat string
+	Trigger              *PipelineTrigger
+}
+
+// PipelineTrigger represents the filters on the events in the source repository that start the pipeline.
+// Without a trigger, the pipeline starts on every push to the branch.
+type PipelineTrigger struct {
+	FilePaths         *PipelineTriggerFilter
+	Tags              *PipelineTriggerFilter
+	PullRequestEvents []string
+}
+
+// PipelineTriggerFilter represents the glob patterns to include and exclude in a trigger.
+type PipelineTriggerFilter struct {
+	Includes []string
+	Excludes []string
+}
+
+func pipelineTriggerFromManifest(mfSource *manifest.Source) (*PipelineTrigger, error) {
+	mfTrigger, err := mfSource.Trigger()
+	if err != nil {
+		return nil, err
+	}
+	if mfTrigger == nil {
+		return nil, nil
+	}
+	trigger := &PipelineTrigger{}
+	if !mfTrigger.Paths.IsEmpty() {
+		trigger.FilePaths = &PipelineTriggerFilter{
+			Includes: mfTrigger.Paths.Include,
+			Excludes: mfTrigger.Paths.Exclude,
+		}
+	}
+	if !mfTrigger.Tags.IsEmpty() {
+		trigger.Tags = &PipelineTriggerFilter{
+			Includes: mfTrigger.Tags.Include,
+			Excludes: mfTrigger.Tags.Exclude,
+		}
+	}
+	if mfTrigger.PullRequests != nil {
+		for _, event := range mfTrigger.PullRequests.Events {
+			// CodePipeline expects upper-cased event types, e.g. "OPEN".
+			trigger.PullRequestEvents = append(trigger.PullRequestEvents, strings.ToUpper(event))
+		}
+	}
+	return trigger, nil
 }
 
 func convertRequiredProperty(properties map[string]interface{}, key string) (string, error) {
@@ -229,6 +301,10 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 	if err != nil {
 		return nil, false, err
 	}
+	trigger, err := pipelineTriggerFromManifest(mfSource)
+	if err != nil {
+		return nil, false, err
+	}
 	switch mfSource.ProviderName {
 	case manifest.GithubV1ProviderName:
 		token, err := convertRequiredProperty(mfSource.Properties, "access_token_secret")
@@ -260,6 +336,7 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 				Branch:               branch,
 				RepositoryURL:        GitHubURL(repository),
 				OutputArtifactFormat: outputFormat,
+				Trigger:              trigger,
 			}
 			if !ok {
 				return repo, true, nil
@@ -282,12 +359,46 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 			Branch:               branch,
 			RepositoryURL:        repository,
 			OutputArtifactFormat: outputFormat,
+			Trigger:              trigger,
+		}
+		if !ok {
+			return repo, true, nil
+		}
+		repo.ConnectionARN = connection.(string)
+		return repo, false, nil
+	case manifest.GitLabProviderName:
+		connection, ok := mfSource.Properties["connection_arn"]
+		repo := &GitLabSource{
+			ProviderName:         manifest.GitLabProviderName,
+			Branch:               branch,
+			RepositoryURL:        repository,
+			OutputArtifactFormat: outputFormat,
+			Trigger:              trigger,
 		}
 		if !ok {
 			return repo, true, nil
 		}
 		repo.ConnectionARN = connection.(string)
 		return repo, false, nil
+	case manifest.GitHubEnterpriseServerProviderName, manifest.GitLabSelfManagedProviderName:
+		repo := &SelfHostedSource{
+			ProviderName:         mfSource.ProviderName,
+			Branch:               branch,
+			RepositoryURL:        repository,
+			OutputArtifactFormat: outputFormat,
+			Trigger:              trigger,
+		}
+		if connection, ok := mfSource.Properties["connection_arn"]; ok {
+			repo.ConnectionARN = connection.(string)
+			return repo, false, nil
+		}
+		// The host is only needed to create a new connection.
+		host, err := convertRequiredProperty(mfSource.Properties, "host_arn")
+		if err != nil {
+			return nil, false, err
+		}
+		repo.HostARN = host
+		return repo, true, nil
 	default:
 		return nil, false, fmt.Errorf("invalid repo source provider: %s", mfSource.ProviderName)
 	}
@@ -313,6 +424,21 @@ func (s *GitHubSource) Connection() string {
 	return s.ConnectionARN
 }
 
+// Connection returns the ARN correlated with a ConnectionName in the pipeline manifest.
+func (s *GitLabSource) Connection() string {
+	return s.ConnectionARN
+}
+
+// Connection returns the ARN correlated with a ConnectionName in the pipeline manifest.
+func (s *SelfHostedSource) Connection() string {
+	return s.ConnectionARN
+}
+
+// Host returns the ARN of the CodeStar Connections host that the connection to the repository is made through.
+func (s *SelfHostedSource) Host() string {
+	return s.HostARN
+}
+
 // parse parses the owner and repo name from the GH repo URL, which was formatted and assigned in cli/pipeline_init.go.
 func (url GitHubURL) parse() (owner, repo string, err error) {
 	if url == "" {
@@ -374,6 +500,34 @@ func (s *BitbucketSource) parseOwnerAndRepo() (owner, repo string, err error) {
 	return matches["owner"], matches["repo"], nil
 }
 
+// parseOwnerAndRepo parses the owner and repo name from the GL repo URL, which was formatted and assigned in cli/pipeline_init.go.
+// The owner contains the subgroups of the repository, if any.
+func (s *GitLabSource) parseOwnerAndRepo() (owner, repo string, err error) {
+	return parseOwnerAndRepo(glRepoExp, s.RepositoryURL)
+}
+
+// parseOwnerAndRepo parses the owner and repo name from the URL of a self-hosted repo, which was formatted and assigned in cli/pipeline_init.go.
+func (s *SelfHostedSource) parseOwnerAndRepo() (owner, repo string, err error) {
+	return parseOwnerAndRepo(selfHostedRepoExp, s.RepositoryURL)
+}
+
+func parseOwnerAndRepo(exp *regexp.Regexp, url string) (owner, repo string, err error) {
+	if url == "" {
+		return "", "", fmt.Errorf("unable to locate the repository")
+	}
+	match := exp.FindStringSubmatch(url)
+	if len(match) == 0 {
+		return "", "", fmt.Errorf(fmtInvalidRepo, url)
+	}
+	matches := make(map[string]string)
+	for i, name := range exp.SubexpNames() {
+		if i != 0 && name != "" {
+			matches[name] = match[i]
+		}
+	}
+	return matches["owner"], strings.TrimSuffix(matches["repo"], ".git"), nil
+}
+
 // ConnectionName generates a string of maximum length 32 to be used as a CodeStar Connections ConnectionName.
 // If there is a duplicate ConnectionName generated by CFN, the previous one is replaced. (Duplicate names
 // generated by the aws cli don't have to be unique for some reason.)
@@ -402,7 +556,27 @@ func (s *GitHubSource) ConnectionName() (string, error) {
 	return formatConnectionName(owner, repo), nil
 }
 
+// ConnectionName generates a recognizable string by which the connection may be identified.
+func (s *GitLabSource) ConnectionName() (string, error) {
+	owner, repo, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", fmt.Errorf("parse owner and repo to generate connection name: %w", err)
+	}
+	return formatConnectionName(owner, repo), nil
+}
+
+// ConnectionName generates a recognizable string by which the connection may be identified.
+func (s *SelfHostedSource) ConnectionName() (string, error) {
+	owner, repo, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", fmt.Errorf("parse owner and repo to generate connection name: %w", err)
+	}
+	return formatConnectionName(owner, repo), nil
+}
+
 func formatConnectionName(owner, repo string) string {
+	// Owners of GitLab repositories can contain subgroups, only the top-level group is kept.
+	owner, _, _ = strings.Cut(owner, "/")
 	if len(owner) > maxOwnerLength {
 		owner = owner[:maxOwnerLength]
 	}
@@ -442,6 +616,26 @@ func (s *GitHubSource) Repository() (string, error) {
 	return fmt.Sprintf("%s/%s", owner, repo), nil
 }
 
+// Repository returns the repository portion. For CodeStar Connections,
+// this needs to be in the format "some-group/some-subgroup/my-repo."
+func (s *GitLabSource) Repository() (string, error) {
+	owner, repo, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s", owner, repo), nil
+}
+
+// Repository returns the repository portion. For CodeStar Connections,
+// this needs to be in the format "some-user/my-repo."
+func (s *SelfHostedSource) Repository() (string, error) {
+	owner, repo, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s", owner, repo), nil
+}
+
 // Repository returns the repository portion. For example,
 // given "aws/amazon-copilot", this function returns "amazon-copilot".
 func (s *CodeCommitSource) Repository() (string, error) {
@@ -500,6 +694,8 @@ type PipelineStage struct {
 	preDeployments    manifest.PrePostDeployments
 	deployments       manifest.Deployments
 	postDeployments   manifest.PrePostDeployments
+	bake              *manifest.PipelineBake
+	bakeAlarmNames    []string
 }
 
 // Init populates the fields in PipelineStage against a target environment,
@@ -524,6 +720,7 @@ func (stg *PipelineStage) Init(env *config.Environment, mftStage *manifest.Pipel
 	stg.postDeployments = mftStage.PostDeployments
 	stg.requiresApproval = mftStage.RequiresApproval
 	stg.testCommands = mftStage.TestCommands
+	stg.bake = mftStage.Bake
 	stg.execRoleARN = env.ExecutionRoleARN
 	stg.envManagerRoleARN = env.ManagerRoleARN
 }
@@ -771,6 +968,30 @@ func (stg *PipelineStage) Test() (*TestCommandsAction, error) {
 	}, nil
 }
 
+// SetBakeAlarms sets the names of the alarms that must stay OK while the stage bakes.
+func (stg *PipelineStage) SetBakeAlarms(alarmNames []string) {
+	stg.bakeAlarmNames = alarmNames
+}
+
+// Bake returns the condition that the stage must meet after its deployments to move on to the next stage.
+// If the stage does not bake, or there are no alarms to watch, then returns nil.
+func (stg *PipelineStage) Bake() *BakeCondition {
+	if stg.bake == nil || stg.bake.Duration == nil || len(stg.bakeAlarmNames) == 0 {
+		return nil
+	}
+	rules := make([]BakeRule, len(stg.bakeAlarmNames))
+	for i, name := range stg.bakeAlarmNames {
+		rules[i] = BakeRule{
+			Name:      fmt.Sprintf("BakeAlarm%d", i+1),
+			AlarmName: name,
+		}
+	}
+	return &BakeCondition{
+		WaitTimeInMinutes: int(stg.bake.Duration.Minutes()),
+		Rules:             rules,
+	}
+}
+
 type actionGraphNode struct {
 	name       string
 	depends_on []string
@@ -850,6 +1071,11 @@ func (a *DeployAction) Name() string {
 	return fmt.Sprintf("CreateOrUpdate-%s-%s", a.name, a.envName)
 }
 
+// WorkloadName returns the name of the workload to deploy.
+func (a *DeployAction) WorkloadName() string {
+	return a.name
+}
+
 // StackName returns the name of the workload stack to create or update.
 func (a *DeployAction) StackName() string {
 	if a.override != nil && a.override.StackName != "" {
@@ -884,6 +1110,19 @@ func (a *DeployAction) RunOrder() int {
 	return a.action.RunOrder() /* baseline */ + rank
 }
 
+// BakeCondition represents the CloudWatch alarms that must stay OK for a wait time after a stage deploys.
+// If any alarm goes off, the stage rolls back to its previous successful deployment.
+type BakeCondition struct {
+	WaitTimeInMinutes int
+	Rules             []BakeRule
+}
+
+// BakeRule represents a CloudWatch alarm rule of a bake condition.
+type BakeRule struct {
+	Name      string
+	AlarmName string
+}
+
 // TestCommandsAction represents a CodePipeline action of category "Test" to validate deployments.
 type TestCommandsAction struct {
 	action
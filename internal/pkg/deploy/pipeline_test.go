@@ -6,6 +6,7 @@ package deploy
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -64,6 +65,60 @@ func TestPipelineSourceFromManifest(t *testing.T) {
 			expectedShouldPrompt: false,
 			expectedErr:          errors.New("property `repository` is not a string"),
 		},
+		"transforms GitHub (v2) source with a trigger": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GithubProviderName,
+				Properties: map[string]interface{}{
+					"branch":         "test",
+					"repository":     "some/repository/URL",
+					"connection_arn": "arn:aws:codestar-connections:us-west-2:1111:connection/abcd",
+					"trigger": map[string]interface{}{
+						"paths": map[string]interface{}{
+							"include": []interface{}{"frontend/**"},
+							"exclude": []interface{}{"**/*.md"},
+						},
+						"tags": map[string]interface{}{
+							"include": []interface{}{"v*"},
+						},
+						"pull_requests": map[string]interface{}{
+							"events": []interface{}{"open", "updated"},
+						},
+					},
+				},
+			},
+			expectedDeploySource: &GitHubSource{
+				ProviderName:  manifest.GithubProviderName,
+				Branch:        "test",
+				RepositoryURL: "some/repository/URL",
+				ConnectionARN: "arn:aws:codestar-connections:us-west-2:1111:connection/abcd",
+				Trigger: &PipelineTrigger{
+					FilePaths: &PipelineTriggerFilter{
+						Includes: []string{"frontend/**"},
+						Excludes: []string{"**/*.md"},
+					},
+					Tags: &PipelineTriggerFilter{
+						Includes: []string{"v*"},
+					},
+					PullRequestEvents: []string{"OPEN", "UPDATED"},
+				},
+			},
+			expectedShouldPrompt: false,
+			expectedErr:          nil,
+		},
+		"error out if the trigger can't be decoded": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.BitbucketProviderName,
+				Properties: map[string]interface{}{
+					"branch":     "test",
+					"repository": "some/repository/URL",
+					"trigger": map[string]interface{}{
+						"paths": "frontend/**",
+					},
+				},
+			},
+			expectedShouldPrompt: false,
+			expectedErr:          errors.New("unmarshal \"trigger\": yaml: unmarshal errors:\n  line 1: cannot unmarshal !!str `fronten...` into manifest.PipelineTriggerFilter"),
+		},
 		"transforms GitHub (v2) source without existing connection": {
 			mfSource: &manifest.Source{
 				ProviderName: manifest.GithubProviderName,
@@ -132,6 +187,81 @@ func TestPipelineSourceFromManifest(t *testing.T) {
 			expectedShouldPrompt: false,
 			expectedErr:          nil,
 		},
+		"transforms GitLab source without existing connection": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitLabProviderName,
+				Properties: map[string]interface{}{
+					"branch":     "test",
+					"repository": "some/repository/URL",
+				},
+			},
+			expectedDeploySource: &GitLabSource{
+				ProviderName:  manifest.GitLabProviderName,
+				Branch:        "test",
+				RepositoryURL: "some/repository/URL",
+			},
+			expectedShouldPrompt: true,
+		},
+		"transforms GitLab source with existing connection": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitLabProviderName,
+				Properties: map[string]interface{}{
+					"branch":         "test",
+					"repository":     "some/repository/URL",
+					"connection_arn": "yarnARN",
+				},
+			},
+			expectedDeploySource: &GitLabSource{
+				ProviderName:  manifest.GitLabProviderName,
+				Branch:        "test",
+				RepositoryURL: "some/repository/URL",
+				ConnectionARN: "yarnARN",
+			},
+			expectedShouldPrompt: false,
+		},
+		"transforms GitHub Enterprise Server source without existing connection": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitHubEnterpriseServerProviderName,
+				Properties: map[string]interface{}{
+					"branch":     "test",
+					"repository": "some/repository/URL",
+					"host_arn":   "hostARN",
+				},
+			},
+			expectedDeploySource: &SelfHostedSource{
+				ProviderName:  manifest.GitHubEnterpriseServerProviderName,
+				Branch:        "test",
+				RepositoryURL: "some/repository/URL",
+				HostARN:       "hostARN",
+			},
+			expectedShouldPrompt: true,
+		},
+		"transforms GitLab self-managed source with existing connection": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitLabSelfManagedProviderName,
+				Properties: map[string]interface{}{
+					"branch":         "test",
+					"repository":     "some/repository/URL",
+					"connection_arn": "yarnARN",
+				},
+			},
+			expectedDeploySource: &SelfHostedSource{
+				ProviderName:  manifest.GitLabSelfManagedProviderName,
+				Branch:        "test",
+				RepositoryURL: "some/repository/URL",
+				ConnectionARN: "yarnARN",
+			},
+			expectedShouldPrompt: false,
+		},
+		"error out if host is not configured for a self-hosted source without existing connection": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitLabSelfManagedProviderName,
+				Properties: map[string]interface{}{
+					"repository": "some/repository/URL",
+				},
+			},
+			expectedErr: errors.New("missing `host_arn` in properties"),
+		},
 		"transforms CodeCommit source": {
 			mfSource: &manifest.Source{
 				ProviderName: manifest.CodeCommitProviderName,
@@ -353,6 +483,65 @@ func TestParseOwnerAndRepo(t *testing.T) {
 	}
 }
 
+func TestConnectionSource_Repository(t *testing.T) {
+	testCases := map[string]struct {
+		src interface {
+			Repository() (string, error)
+			ConnectionName() (string, error)
+		}
+
+		wantedRepo           string
+		wantedConnectionName string
+		wantedErr            error
+	}{
+		"GitLab repository": {
+			src: &GitLabSource{
+				RepositoryURL: "https://gitlab.com/badgoose/chaOS",
+			},
+			wantedRepo:           "badgoose/chaOS",
+			wantedConnectionName: "copilot-badgo-chaOS",
+		},
+		"GitLab repository in a subgroup": {
+			src: &GitLabSource{
+				RepositoryURL: "https://gitlab.com/badgoose/platform/chaOS.git",
+			},
+			wantedRepo:           "badgoose/platform/chaOS",
+			wantedConnectionName: "copilot-badgo-chaOS",
+		},
+		"self-hosted repository": {
+			src: &SelfHostedSource{
+				RepositoryURL: "https://git.example.com/badgoose/chaOS",
+			},
+			wantedRepo:           "badgoose/chaOS",
+			wantedConnectionName: "copilot-badgo-chaOS",
+		},
+		"error if the URL of a GitLab repository is invalid": {
+			src: &GitLabSource{
+				RepositoryURL: "https://github.com/badgoose/chaOS",
+			},
+			wantedErr: errors.New("unable to parse the repository from the URL https://github.com/badgoose/chaOS"),
+		},
+		"error if the URL of a self-hosted repository is missing": {
+			src:       &SelfHostedSource{},
+			wantedErr: errors.New("unable to locate the repository"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			repo, err := tc.src.Repository()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRepo, repo)
+			connectionName, err := tc.src.ConnectionName()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedConnectionName, connectionName)
+		})
+	}
+}
+
 func TestPipelineStage_Init(t *testing.T) {
 	var stg PipelineStage
 	stg.Init(&config.Environment{
@@ -685,6 +874,58 @@ func (ma mockAction) RunOrder() int {
 	return ma.order
 }
 
+func TestPipelineStage_Bake(t *testing.T) {
+	duration := 30 * time.Minute
+	testCases := map[string]struct {
+		inBake   *manifest.PipelineBake
+		inAlarms []string
+
+		wanted *BakeCondition
+	}{
+		"should return nil if the stage doesn't bake": {
+			inAlarms: []string{"phonetool-test-api-CopilotRollbackCPUAlarm"},
+		},
+		"should return nil if there are no alarms to watch": {
+			inBake: &manifest.PipelineBake{Duration: &duration},
+		},
+		"should return a rule for each alarm": {
+			inBake:   &manifest.PipelineBake{Duration: &duration},
+			inAlarms: []string{"phonetool-test-api-CopilotRollbackCPUAlarm", "shared-5xx"},
+			wanted: &BakeCondition{
+				WaitTimeInMinutes: 30,
+				Rules: []BakeRule{
+					{
+						Name:      "BakeAlarm1",
+						AlarmName: "phonetool-test-api-CopilotRollbackCPUAlarm",
+					},
+					{
+						Name:      "BakeAlarm2",
+						AlarmName: "shared-5xx",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var stg PipelineStage
+			stg.Init(&config.Environment{Name: "test"}, &manifest.PipelineStage{
+				Name: "test",
+				Bake: tc.inBake,
+			}, nil)
+			stg.SetBakeAlarms(tc.inAlarms)
+
+			// WHEN
+			bake := stg.Bake()
+
+			// THEN
+			require.Equal(t, tc.wanted, bake)
+		})
+	}
+}
+
 func TestAction_RunOrder(t *testing.T) {
 	testCases := map[string]struct {
 		previous []orderedRunner
@@ -734,6 +975,15 @@ func TestDeployAction_Name(t *testing.T) {
 	require.Equal(t, "CreateOrUpdate-frontend-test", action.Name())
 }
 
+func TestDeployAction_WorkloadName(t *testing.T) {
+	action := DeployAction{
+		name:    "frontend",
+		envName: "test",
+	}
+
+	require.Equal(t, "frontend", action.WorkloadName())
+}
+
 func TestDeployAction_StackName(t *testing.T) {
 	testCases := map[string]struct {
 		in     DeployAction
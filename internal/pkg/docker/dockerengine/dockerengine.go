@@ -17,8 +17,10 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/fatih/color"
@@ -54,6 +56,13 @@ const (
 	unhealthy     = "unhealthy" // Unhealthy indicates that the container has a problem
 )
 
+// Prefixes of a health check command.
+const (
+	healthCheckCmd      = "CMD"
+	healthCheckCmdShell = "CMD-SHELL"
+	healthCheckCmdNone  = "NONE"
+)
+
 // State of a docker container.
 const (
 	containerStatusRunning = "running"
@@ -101,9 +110,54 @@ type RunOptions struct {
 	ContainerPorts       map[string]string // Optional. Contains host and container ports.
 	Command              []string          // Optional. The command to run in the container.
 	ContainerNetwork     string            // Optional. Network mode for the container.
+	Network              string            // Optional. User-defined network to connect the container to. Ignored if ContainerNetwork is set.
+	NetworkAliases       []string          // Optional. Aliases for the container on Network.
 	LogOptions           RunLogOptions     // Optional. Configure logging for output from the container
 	AddLinuxCapabilities []string          // Optional. Adds linux capabilities to the container.
 	Init                 bool              // Optional. Adds an init process as an entrypoint.
+	HealthCheck          *HealthCheck      // Optional. Overrides the health check of the image.
+	Volumes              map[string]string // Optional. Contains host paths and the container paths to bind mount them to.
+	WorkingDir           string            // Optional. The working directory inside the container.
+	Remove               bool              // Optional. Removes the container once it exits.
+}
+
+// HealthCheck holds the configuration of a container health check in the same format as ECS.
+type HealthCheck struct {
+	Command     []string // Starts with "CMD" or "CMD-SHELL" followed by the command, or is ["NONE"].
+	Interval    time.Duration
+	Timeout     time.Duration
+	StartPeriod time.Duration
+	Retries     int
+}
+
+// runArguments returns the docker run arguments that configure the health check.
+func (h *HealthCheck) runArguments() []string {
+	if h == nil || len(h.Command) == 0 {
+		return nil
+	}
+	var cmd string
+	switch h.Command[0] {
+	case healthCheckCmdNone:
+		return []string{"--no-healthcheck"}
+	case healthCheckCmd, healthCheckCmdShell:
+		cmd = strings.Join(h.Command[1:], " ")
+	default:
+		cmd = strings.Join(h.Command, " ")
+	}
+	args := []string{"--health-cmd", cmd}
+	if h.Interval > 0 {
+		args = append(args, "--health-interval", h.Interval.String())
+	}
+	if h.Timeout > 0 {
+		args = append(args, "--health-timeout", h.Timeout.String())
+	}
+	if h.StartPeriod > 0 {
+		args = append(args, "--health-start-period", h.StartPeriod.String())
+	}
+	if h.Retries > 0 {
+		args = append(args, "--health-retries", strconv.Itoa(h.Retries))
+	}
+	return args
 }
 
 // RunLogOptions holds the logging configuration for Run().
@@ -276,6 +330,11 @@ func (in *RunOptions) generateRunArguments() []string {
 
 	if in.ContainerNetwork != "" {
 		args = append(args, "--network", fmt.Sprintf("container:%s", in.ContainerNetwork))
+	} else if in.Network != "" {
+		args = append(args, "--network", in.Network)
+		for _, alias := range in.NetworkAliases {
+			args = append(args, "--network-alias", alias)
+		}
 	}
 
 	for key, value := range in.Secrets {
@@ -294,6 +353,20 @@ func (in *RunOptions) generateRunArguments() []string {
 		args = append(args, "--init")
 	}
 
+	for hostPath, containerPath := range in.Volumes {
+		args = append(args, "--volume", fmt.Sprintf("%s:%s", hostPath, containerPath))
+	}
+
+	if in.WorkingDir != "" {
+		args = append(args, "--workdir", in.WorkingDir)
+	}
+
+	if in.Remove {
+		args = append(args, "--rm")
+	}
+
+	args = append(args, in.HealthCheck.runArguments()...)
+
 	args = append(args, in.ImageURI)
 
 	if in.Command != nil && len(in.Command) > 0 {
@@ -407,7 +480,7 @@ func (c DockerCmdClient) IsContainerHealthy(ctx context.Context, containerName s
 	case starting:
 		return false, nil
 	case unhealthy:
-		return false, fmt.Errorf("container %q is %q", containerName, unhealthy)
+		return false, &ErrContainerUnhealthy{name: containerName}
 	case noHealthcheck:
 		return false, fmt.Errorf("healthcheck is not configured for container %q", containerName)
 	default:
@@ -474,6 +547,28 @@ func (c DockerCmdClient) Rm(ctx context.Context, containerID string) error {
 	return nil
 }
 
+// CreateNetwork calls `docker network create` to create a bridge network.
+// It is a no-op if a network with the same name already exists.
+func (c DockerCmdClient) CreateNetwork(ctx context.Context, name string) error {
+	if err := c.runner.RunWithContext(ctx, "docker", []string{"network", "inspect", name}, exec.Stdout(io.Discard), exec.Stderr(io.Discard)); err == nil {
+		return nil
+	}
+	buf := &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, "docker", []string{"network", "create", name}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
+		return fmt.Errorf("%s: %w", strings.TrimSpace(buf.String()), err)
+	}
+	return nil
+}
+
+// RemoveNetwork calls `docker network rm` to remove a network.
+func (c DockerCmdClient) RemoveNetwork(ctx context.Context, name string) error {
+	buf := &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, "docker", []string{"network", "rm", name}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
+		return fmt.Errorf("%s: %w", strings.TrimSpace(buf.String()), err)
+	}
+	return nil
+}
+
 // CheckDockerEngineRunning will run `docker info` command to check if the docker engine is running.
 func (c DockerCmdClient) CheckDockerEngineRunning() error {
 	if _, err := osexec.LookPath("docker"); err != nil {
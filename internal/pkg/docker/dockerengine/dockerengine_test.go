@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 
@@ -669,6 +670,12 @@ func TestDockerCommand_Run(t *testing.T) {
 		ports            map[string]string
 		command          []string
 		containerNetwork string
+		network          string
+		networkAliases   []string
+		healthCheck      *HealthCheck
+		volumes          map[string]string
+		workingDir       string
+		remove           bool
 		logPrefix        string
 		setupMocks       func(controller *gomock.Controller)
 
@@ -726,6 +733,23 @@ func TestDockerCommand_Run(t *testing.T) {
 					"sleep", "infinity"}), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"success with run options for pause container on a user-defined network": {
+			containerName:  mockPauseContainer,
+			command:        mockCommand,
+			uri:            mockImageURI,
+			network:        "mockNetwork",
+			networkAliases: []string{"api", "api.test.app.local"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockPauseContainer,
+					"--network", "mockNetwork",
+					"--network-alias", "api",
+					"--network-alias", "api.test.app.local",
+					mockImageURI,
+					"sleep", "infinity"}, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
 		"success with run options for service containers": {
 			containerName:    mockContainerName,
 			containerNetwork: mockPauseContainer,
@@ -745,6 +769,62 @@ func TestDockerCommand_Run(t *testing.T) {
 					mockImageURI}), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"success with a health check": {
+			containerName:    mockContainerName,
+			containerNetwork: mockPauseContainer,
+			uri:              mockImageURI,
+			healthCheck: &HealthCheck{
+				Command:  []string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"},
+				Interval: 10 * time.Second,
+				Timeout:  5 * time.Second,
+				Retries:  2,
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockContainerName,
+					"--network", "container:pauseContainer",
+					"--health-cmd", "curl -f http://localhost/ || exit 1",
+					"--health-interval", "10s",
+					"--health-timeout", "5s",
+					"--health-retries", "2",
+					mockImageURI}, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"success with the health check disabled": {
+			containerName: mockContainerName,
+			uri:           mockImageURI,
+			healthCheck: &HealthCheck{
+				Command: []string{"NONE"},
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockContainerName,
+					"--no-healthcheck",
+					mockImageURI}, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"success with bind mounts and a working directory": {
+			uri: mockImageURI,
+			volumes: map[string]string{
+				"/home/user/app":       "/codebuild/output/src",
+				"/home/user/artifacts": "/codebuild/output/artifacts",
+			},
+			workingDir: "/codebuild/output/src",
+			remove:     true,
+			command:    []string{"sh", "-c", "make test"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", gomock.InAnyOrder([]string{"run",
+					"--volume", "/home/user/app:/codebuild/output/src",
+					"--volume", "/home/user/artifacts:/codebuild/output/artifacts",
+					"--workdir", "/codebuild/output/src",
+					"--rm",
+					mockImageURI,
+					"sh", "-c", "make test"}), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
 		"logs are successfully copied to expected target": {
 			containerName:    mockContainerName,
 			containerNetwork: mockPauseContainer,
@@ -801,8 +881,14 @@ func TestDockerCommand_Run(t *testing.T) {
 				EnvVars:          tc.envVars,
 				ContainerName:    tc.containerName,
 				ContainerNetwork: tc.containerNetwork,
+				Network:          tc.network,
+				NetworkAliases:   tc.networkAliases,
+				HealthCheck:      tc.healthCheck,
 				Command:          tc.command,
 				ContainerPorts:   tc.ports,
+				Volumes:          tc.volumes,
+				WorkingDir:       tc.workingDir,
+				Remove:           tc.remove,
 				LogOptions: RunLogOptions{
 					LinePrefix: tc.logPrefix,
 					Output:     out,
@@ -1178,3 +1264,52 @@ func TestDockerCommand_ContainerExitCode(t *testing.T) {
 		})
 	}
 }
+
+func TestDockerCommand_CreateNetwork(t *testing.T) {
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller) *MockCmd
+
+		wantErr string
+	}{
+		"no-op if the network already exists": {
+			setupMocks: func(ctrl *gomock.Controller) *MockCmd {
+				mockCmd := NewMockCmd(ctrl)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"network", "inspect", "mockNetwork"}, gomock.Any(), gomock.Any()).Return(nil)
+				return mockCmd
+			},
+		},
+		"return error if the network cannot be created": {
+			setupMocks: func(ctrl *gomock.Controller) *MockCmd {
+				mockCmd := NewMockCmd(ctrl)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"network", "inspect", "mockNetwork"}, gomock.Any(), gomock.Any()).Return(errors.New("no such network"))
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"network", "create", "mockNetwork"}, gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				return mockCmd
+			},
+			wantErr: ": some error",
+		},
+		"happy path": {
+			setupMocks: func(ctrl *gomock.Controller) *MockCmd {
+				mockCmd := NewMockCmd(ctrl)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"network", "inspect", "mockNetwork"}, gomock.Any(), gomock.Any()).Return(errors.New("no such network"))
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"network", "create", "mockNetwork"}, gomock.Any(), gomock.Any()).Return(nil)
+				return mockCmd
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			s := DockerCmdClient{
+				runner: tc.setupMocks(ctrl),
+			}
+
+			err := s.CreateNetwork(context.Background(), "mockNetwork")
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -54,3 +54,13 @@ func (e *ErrContainerExited) ExitCode() int {
 func (e *ErrContainerExited) Error() string {
 	return fmt.Sprintf("container %q exited with code %d", e.name, e.exitcode)
 }
+
+// ErrContainerUnhealthy represents an error when the health check of a Docker container is failing.
+type ErrContainerUnhealthy struct {
+	name string
+}
+
+// Error returns the error message.
+func (e *ErrContainerUnhealthy) Error() string {
+	return fmt.Sprintf("container %q is %q", e.name, unhealthy)
+}
@@ -75,6 +75,10 @@ const (
 	proxyPortStart = uint16(50000)
 )
 
+const (
+	healthPollInterval = time.Second
+)
+
 const (
 	ctrStateHealthy  = "healthy"
 	ctrStateComplete = "complete"
@@ -165,6 +169,16 @@ type runTaskAction struct {
 	hosts     []Host
 	ssmTarget string
 	network   *net.IPNet
+
+	// optional vars for a shared network
+	dockerNetwork  string
+	networkAliases []string
+
+	// toCompletion is true if the task is expected to exit.
+	toCompletion bool
+
+	// optional restart policy for the task's containers
+	restart *RestartPolicy
 }
 
 // RunTaskOption adds optional data to RunTask.
@@ -185,6 +199,69 @@ func RunTaskWithProxy(ssmTarget string, network net.IPNet, hosts ...Host) RunTas
 	}
 }
 
+// RunTaskWithNetwork returns a RunTaskOption that connects the task to the
+// user-defined docker network. Other containers on the network can reach the task
+// through any of the aliases.
+func RunTaskWithNetwork(network string, aliases ...string) RunTaskOption {
+	return func(r *runTaskAction) {
+		r.dockerNetwork = network
+		r.networkAliases = aliases
+	}
+}
+
+// RunTaskNetwork returns the user-defined docker network, and the aliases of the task on it,
+// that opts connect a task to. The network is empty if none of opts is a RunTaskWithNetwork.
+func RunTaskNetwork(opts ...RunTaskOption) (network string, aliases []string) {
+	var r runTaskAction
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return r.dockerNetwork, r.networkAliases
+}
+
+// RunTaskToCompletion returns a RunTaskOption for tasks that are expected to exit, like jobs.
+// When an essential container of the task exits, its exit code is reported as an *ErrTaskExited
+// instead of an error about the container stopping unexpectedly.
+func RunTaskToCompletion() RunTaskOption {
+	return func(r *runTaskAction) {
+		r.toCompletion = true
+	}
+}
+
+// RestartPolicy configures how the containers of a task are restarted, like ECS replaces the failed tasks of a service.
+// Essential containers are restarted whenever they exit, and non-essential containers when they exit with a non-zero code.
+// Containers with a health check are also restarted once they become unhealthy.
+type RestartPolicy struct {
+	InitialBackoff time.Duration // Wait before the first restart of a container.
+	MaxBackoff     time.Duration // The wait doubles with every restart, up to MaxBackoff.
+}
+
+// backoff returns the wait before restarting a container that was last restarted after prev.
+func (p *RestartPolicy) backoff(prev time.Duration) time.Duration {
+	if prev == 0 {
+		return p.InitialBackoff
+	}
+	return min(2*prev, p.MaxBackoff)
+}
+
+// RunTaskWithRestartPolicy returns a RunTaskOption that restarts the containers of the task
+// when they exit or become unhealthy instead of stopping the task.
+func RunTaskWithRestartPolicy(policy RestartPolicy) RunTaskOption {
+	return func(r *runTaskAction) {
+		r.restart = &policy
+	}
+}
+
+// ErrTaskExited is reported when an essential container of a task run with RunTaskToCompletion exits.
+type ErrTaskExited struct {
+	Container string
+	ExitCode  int
+}
+
+func (e *ErrTaskExited) Error() string {
+	return fmt.Sprintf("essential container %q exited with code %d", e.Container, e.ExitCode)
+}
+
 func (a *runTaskAction) Do(o *Orchestrator) error {
 	// we no longer care about errors from the old task
 	taskID := o.curTaskID.Add(1)
@@ -211,7 +288,9 @@ func (a *runTaskAction) Do(o *Orchestrator) error {
 
 		// start the pause container
 		opts := o.pauseRunOptions(a.task)
-		o.run(pauseCtrTaskID, opts, true, cancel)
+		opts.Network = a.dockerNetwork
+		opts.NetworkAliases = a.networkAliases
+		o.run(pauseCtrTaskID, opts, containerRun{isEssential: true}, cancel)
 		if err := o.waitForContainerToStart(ctx, opts.ContainerName); err != nil {
 			return fmt.Errorf("wait for pause container to start: %w", err)
 		}
@@ -242,7 +321,11 @@ func (a *runTaskAction) Do(o *Orchestrator) error {
 				return fmt.Errorf("wait for container %s dependencies: %w", containerName, err)
 			}
 		}
-		o.run(taskID, o.containerRunOptions(containerName, a.task.Containers[containerName]), a.task.Containers[containerName].IsEssential, cancel)
+		o.run(taskID, o.containerRunOptions(containerName, a.task.Containers[containerName]), containerRun{
+			isEssential: a.task.Containers[containerName].IsEssential,
+			reportExit:  a.toCompletion,
+			restart:     a.restart,
+		}, cancel)
 		var errContainerExited *dockerengine.ErrContainerExited
 		if err := o.waitForContainerToStart(ctx, o.containerID(containerName)); err != nil && !errors.As(err, &errContainerExited) {
 			return fmt.Errorf("wait for container %s to start: %w", containerName, err)
@@ -572,6 +655,7 @@ type ContainerDefinition struct {
 	Ports       map[string]string // host port -> container port
 	IsEssential bool
 	DependsOn   map[string]string
+	HealthCheck *dockerengine.HealthCheck
 }
 
 // pauseRunOptions returns RunOptions for the pause container for t.
@@ -606,30 +690,72 @@ func (o *Orchestrator) containerRunOptions(name string, ctr ContainerDefinition)
 		Secrets:          ctr.Secrets,
 		ContainerNetwork: o.containerID("pause"),
 		LogOptions:       o.logOptions(name, ctr),
+		HealthCheck:      ctr.HealthCheck,
 	}
 }
 
+// containerRun configures how the Orchestrator handles a container exiting.
+type containerRun struct {
+	isEssential bool
+	reportExit  bool           // Report the exit of an essential container as an *ErrTaskExited.
+	restart     *RestartPolicy // Restart the container when it exits or becomes unhealthy, if set.
+}
+
 // run calls `docker run` using opts. Errors are only returned
 // to the main Orchestrator routine if the taskID the container was run with
-// matches the current taskID the Orchestrator is running.
-func (o *Orchestrator) run(taskID int32, opts dockerengine.RunOptions, isEssential bool, cancel context.CancelFunc) {
+// matches the current taskID the Orchestrator is running. If cr.reportExit is true,
+// the exit of an essential container is reported as an *ErrTaskExited. If cr.restart is set,
+// the container is restarted instead of stopping the task.
+func (o *Orchestrator) run(taskID int32, opts dockerengine.RunOptions, cr containerRun, cancel context.CancelFunc) {
 	o.wg.Add(1)
 	go func() {
 		defer o.wg.Done()
-		err := o.docker.Run(context.Background(), &opts)
-
-		// if the orchestrator has already stopped,
-		// we don't want to report the error
-		curTaskID := o.curTaskID.Load()
-		if curTaskID == orchestratorStoppedTaskID {
-			return
-		}
+		linePrefix := opts.LogOptions.LinePrefix
+		var backoff time.Duration
+		for restarts := 1; ; restarts++ {
+			unhealthy, err := o.runContainer(opts, cr.restart != nil)
+
+			// if the orchestrator has already stopped,
+			// we don't want to report the error
+			curTaskID := o.curTaskID.Load()
+			if curTaskID == orchestratorStoppedTaskID {
+				return
+			}
 
-		// the error is from the pause container
-		// or from the currently running task
-		if taskID == pauseCtrTaskID || taskID == curTaskID {
+			// the error is from the pause container
+			// or from the currently running task
+			if taskID != pauseCtrTaskID && taskID != curTaskID {
+				return
+			}
 			var errContainerExited *dockerengine.ErrContainerExited
-			if !isEssential && (errors.As(err, &errContainerExited) || err == nil) {
+			exited := errors.As(err, &errContainerExited) || err == nil
+			var exitCode int
+			if errContainerExited != nil {
+				exitCode = errContainerExited.ExitCode()
+			}
+			if exited && cr.reportExit && cr.isEssential {
+				cancel()
+				o.runErrs <- &ErrTaskExited{
+					Container: opts.ContainerName,
+					ExitCode:  exitCode,
+				}
+				return
+			}
+			if exited && cr.restart != nil && (cr.isEssential || exitCode != 0 || unhealthy) {
+				backoff = cr.restart.backoff(backoff)
+				fmt.Printf("container %q exited with code %d, restarting in %s\n", opts.ContainerName, exitCode, backoff)
+				if !o.waitToRestart(taskID, backoff) {
+					return
+				}
+				if err := o.docker.Rm(context.Background(), opts.ContainerName); err != nil {
+					cancel()
+					o.runErrs <- fmt.Errorf("remove %q to restart it: %w", opts.ContainerName, err)
+					return
+				}
+				opts.LogOptions.LinePrefix = restartLinePrefix(linePrefix, restarts, exitCode)
+				continue
+			}
+			if !cr.isEssential && exited {
 				fmt.Printf("non-essential container %q stopped\n", opts.ContainerName)
 				return
 			}
@@ -639,6 +765,68 @@ func (o *Orchestrator) run(taskID int32, opts dockerengine.RunOptions, isEssenti
 			// cancel context to indicate all the other go routines spawned by `graph.UpwardTarversal`.
 			cancel()
 			o.runErrs <- fmt.Errorf("run %q: %w", opts.ContainerName, err)
+			return
 		}
 	}()
 }
+
+// runContainer calls `docker run` using opts and returns once the container exits. If monitorHealth is true
+// and the container has a health check, the container is stopped once it becomes unhealthy.
+func (o *Orchestrator) runContainer(opts dockerengine.RunOptions, monitorHealth bool) (unhealthy bool, err error) {
+	if !monitorHealth || opts.HealthCheck == nil {
+		return false, o.docker.Run(context.Background(), &opts)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stoppedUnhealthy := make(chan bool, 1)
+	go func() {
+		stoppedUnhealthy <- o.stopWhenUnhealthy(ctx, opts.ContainerName)
+	}()
+	err = o.docker.Run(context.Background(), &opts)
+	cancel()
+	return <-stoppedUnhealthy, err
+}
+
+// stopWhenUnhealthy stops the container once it is unhealthy, and returns true if it did so before ctx is canceled.
+func (o *Orchestrator) stopWhenUnhealthy(ctx context.Context, name string) bool {
+	ticker := time.NewTicker(healthPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return false
+		}
+		// errors other than the container being unhealthy are expected while it's starting or stopping.
+		_, err := o.docker.IsContainerHealthy(ctx, name)
+		var errUnhealthy *dockerengine.ErrContainerUnhealthy
+		if !errors.As(err, &errUnhealthy) {
+			continue
+		}
+		fmt.Printf("container %q is unhealthy, stopping it\n", name)
+		if err := o.docker.Stop(context.Background(), name); err != nil {
+			log.Errorf("stop unhealthy container %q: %s\n", name, err)
+		}
+		return true
+	}
+}
+
+// waitToRestart waits for backoff before restarting a container of the task taskID, and returns
+// false if the Orchestrator is stopped or the task is replaced in the meantime.
+func (o *Orchestrator) waitToRestart(taskID int32, backoff time.Duration) bool {
+	select {
+	case <-time.After(backoff):
+	case <-o.stopped:
+		return false
+	}
+	return o.curTaskID.Load() == taskID
+}
+
+// restartLinePrefix adds the restart count and last exit code of a container to its log line prefix,
+// within the brackets of prefixes like "[name] ".
+func restartLinePrefix(prefix string, restarts, exitCode int) string {
+	status := fmt.Sprintf("restarts: %d, last exit code: %d", restarts, exitCode)
+	if trimmed, ok := strings.CutSuffix(prefix, "] "); ok {
+		return fmt.Sprintf("%s | %s] ", trimmed, status)
+	}
+	return fmt.Sprintf("%s[%s] ", prefix, status)
+}
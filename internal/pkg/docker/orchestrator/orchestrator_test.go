@@ -12,7 +12,9 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine/dockerenginetest"
@@ -611,6 +613,185 @@ func TestOrchestrator(t *testing.T) {
 			stopAfterNErrs: 1,
 			errs:           []string{`run "prefix-foo": container stopped unexpectedly`},
 		},
+		"task run to completion reports the exit of an essential container": {
+			logOptions: noLogs,
+			test: func(t *testing.T) (test, *dockerenginetest.Double) {
+				stopPause := make(chan struct{})
+				de := &dockerenginetest.Double{
+					IsContainerRunningFn: func(ctx context.Context, name string) (bool, error) {
+						return true, nil
+					},
+					RunFn: func(ctx context.Context, opts *dockerengine.RunOptions) error {
+						if opts.ContainerName == "prefix-foo" {
+							return nil
+						} else {
+							// block pause container until Stop(pause)
+							<-stopPause
+						}
+						return nil
+					},
+					StopFn: func(ctx context.Context, s string) error {
+						if s == "prefix-pause" {
+							stopPause <- struct{}{}
+						}
+						return nil
+					},
+				}
+				return func(t *testing.T, o *Orchestrator) {
+					o.RunTask(Task{
+						Containers: map[string]ContainerDefinition{
+							"foo": {
+								IsEssential: true,
+							},
+						},
+					}, RunTaskToCompletion())
+				}, de
+			},
+			stopAfterNErrs: 1,
+			errs:           []string{`essential container "prefix-foo" exited with code 0`},
+		},
+		"restart policy restarts an essential container that exits": {
+			logOptions: func(name string, ctr ContainerDefinition) dockerengine.RunLogOptions {
+				return dockerengine.RunLogOptions{
+					Output:     io.Discard,
+					LinePrefix: fmt.Sprintf("[%s] ", name),
+				}
+			},
+			test: func(t *testing.T) (test, *dockerenginetest.Double) {
+				stopPause, stopFoo := make(chan struct{}), make(chan struct{})
+				restarted := make(chan struct{})
+				var runs int
+				de := &dockerenginetest.Double{
+					IsContainerRunningFn: func(ctx context.Context, name string) (bool, error) {
+						return true, nil
+					},
+					RunFn: func(ctx context.Context, opts *dockerengine.RunOptions) error {
+						if opts.ContainerName == "prefix-pause" {
+							<-stopPause
+							return nil
+						}
+						runs++
+						if runs < 3 {
+							return nil
+						}
+						require.Equal(t, "[foo | restarts: 2, last exit code: 0] ", opts.LogOptions.LinePrefix)
+						close(restarted)
+						<-stopFoo
+						return nil
+					},
+					StopFn: func(ctx context.Context, s string) error {
+						switch s {
+						case "prefix-pause":
+							stopPause <- struct{}{}
+						case "prefix-foo":
+							stopFoo <- struct{}{}
+						}
+						return nil
+					},
+				}
+				return func(t *testing.T, o *Orchestrator) {
+					o.RunTask(Task{
+						Containers: map[string]ContainerDefinition{
+							"foo": {
+								IsEssential: true,
+							},
+						},
+					}, RunTaskWithRestartPolicy(RestartPolicy{
+						InitialBackoff: time.Millisecond,
+						MaxBackoff:     2 * time.Millisecond,
+					}))
+					<-restarted
+				}, de
+			},
+		},
+		"restart policy restarts an unhealthy container": {
+			logOptions: func(name string, ctr ContainerDefinition) dockerengine.RunLogOptions {
+				return dockerengine.RunLogOptions{
+					Output:     io.Discard,
+					LinePrefix: fmt.Sprintf("[%s] ", name),
+				}
+			},
+			test: func(t *testing.T) (test, *dockerenginetest.Double) {
+				stopPause, stopFoo := make(chan struct{}), make(chan struct{})
+				restarted := make(chan struct{})
+				var runs atomic.Int32
+				de := &dockerenginetest.Double{
+					IsContainerRunningFn: func(ctx context.Context, name string) (bool, error) {
+						return true, nil
+					},
+					IsContainerHealthyFn: func(ctx context.Context, name string) (bool, error) {
+						if runs.Load() == 1 {
+							return false, &dockerengine.ErrContainerUnhealthy{}
+						}
+						return true, nil
+					},
+					RunFn: func(ctx context.Context, opts *dockerengine.RunOptions) error {
+						if opts.ContainerName == "prefix-pause" {
+							<-stopPause
+							return nil
+						}
+						require.NotNil(t, opts.HealthCheck)
+						if runs.Add(1) == 2 {
+							require.Equal(t, "[foo | restarts: 1, last exit code: 0] ", opts.LogOptions.LinePrefix)
+							close(restarted)
+						}
+						<-stopFoo
+						return nil
+					},
+					StopFn: func(ctx context.Context, s string) error {
+						switch s {
+						case "prefix-pause":
+							stopPause <- struct{}{}
+						case "prefix-foo":
+							stopFoo <- struct{}{}
+						}
+						return nil
+					},
+				}
+				return func(t *testing.T, o *Orchestrator) {
+					o.RunTask(Task{
+						Containers: map[string]ContainerDefinition{
+							"foo": {
+								HealthCheck: &dockerengine.HealthCheck{
+									Command: []string{"CMD-SHELL", "exit 1"},
+								},
+							},
+						},
+					}, RunTaskWithRestartPolicy(RestartPolicy{
+						InitialBackoff: time.Millisecond,
+						MaxBackoff:     time.Millisecond,
+					}))
+					<-restarted
+				}, de
+			},
+		},
+		"pause container joins the shared network with aliases": {
+			logOptions:      noLogs,
+			runUntilStopped: true,
+			test: func(t *testing.T) (test, *dockerenginetest.Double) {
+				de := &dockerenginetest.Double{
+					IsContainerRunningFn: func(ctx context.Context, name string) (bool, error) {
+						return true, nil
+					},
+					RunFn: func(ctx context.Context, opts *dockerengine.RunOptions) error {
+						if opts.ContainerName == "prefix-pause" {
+							require.Equal(t, "shared", opts.Network)
+							require.Equal(t, []string{"api", "api.test.app.local"}, opts.NetworkAliases)
+						} else {
+							require.Equal(t, "prefix-pause", opts.ContainerNetwork)
+						}
+						return nil
+					},
+				}
+				return func(t *testing.T, o *Orchestrator) {
+					o.RunTask(Task{
+						Containers: map[string]ContainerDefinition{
+							"foo": {},
+						},
+					}, RunTaskWithNetwork("shared", "api", "api.test.app.local"))
+				}, de
+			},
+		},
 		"proxy setup, connection returns error": {
 			logOptions:      noLogs,
 			runUntilStopped: true,
@@ -869,3 +1050,29 @@ func TestIPv4Increment(t *testing.T) {
 		})
 	}
 }
+
+func TestRunTaskNetwork(t *testing.T) {
+	tests := map[string]struct {
+		opts []RunTaskOption
+
+		wantNetwork string
+		wantAliases []string
+	}{
+		"no network": {
+			opts: []RunTaskOption{RunTaskToCompletion()},
+		},
+		"network with aliases": {
+			opts:        []RunTaskOption{RunTaskToCompletion(), RunTaskWithNetwork("app-test-local", "api", "api.test.app.local")},
+			wantNetwork: "app-test-local",
+			wantAliases: []string{"api", "api.test.app.local"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			network, aliases := RunTaskNetwork(tc.opts...)
+			require.Equal(t, tc.wantNetwork, network)
+			require.Equal(t, tc.wantAliases, aliases)
+		})
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package localqueue
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Data types of message attributes.
+const (
+	AttributeTypeString      = "String"
+	AttributeTypeNumber      = "Number"
+	AttributeTypeStringArray = "String.Array"
+)
+
+const filterPolicyOrKey = "$or"
+
+// MessageAttribute is an attribute of a message published to a topic.
+type MessageAttribute struct {
+	Type  string
+	Value string
+}
+
+// MatchesFilterPolicy returns true if a message with the attributes attrs passes the filter policy of a topic subscription.
+// Only filter policies scoped to message attributes are supported.
+func MatchesFilterPolicy(policy map[string]interface{}, attrs map[string]MessageAttribute) (bool, error) {
+	for key, rules := range policy {
+		var matches bool
+		var err error
+		if key == filterPolicyOrKey {
+			matches, err = matchesAnyFilterPolicy(rules, attrs)
+		} else {
+			matches, err = matchesAttributeRules(key, rules, attrs)
+		}
+		if err != nil {
+			return false, err
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func matchesAnyFilterPolicy(rules interface{}, attrs map[string]MessageAttribute) (bool, error) {
+	policies, ok := rules.([]interface{})
+	if !ok {
+		return false, fmt.Errorf(`"%s" must be a list of filter policies`, filterPolicyOrKey)
+	}
+	for _, p := range policies {
+		policy, ok := p.(map[string]interface{})
+		if !ok {
+			return false, fmt.Errorf(`"%s" must be a list of filter policies`, filterPolicyOrKey)
+		}
+		matches, err := MatchesFilterPolicy(policy, attrs)
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func matchesAttributeRules(key string, rules interface{}, attrs map[string]MessageAttribute) (bool, error) {
+	if _, ok := rules.(map[string]interface{}); ok {
+		return false, fmt.Errorf("attribute %q: nested filter policies are not supported", key)
+	}
+	list, ok := rules.([]interface{})
+	if !ok {
+		list = []interface{}{rules}
+	}
+	attr, exists := attrs[key]
+	values, err := attributeValues(attr)
+	if err != nil {
+		return false, fmt.Errorf("attribute %q: %w", key, err)
+	}
+	for _, rule := range list {
+		matches, err := matchesRule(rule, exists, values)
+		if err != nil {
+			return false, fmt.Errorf("attribute %q: %w", key, err)
+		}
+		if matches {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// attributeValues returns the values of attr to match rules against.
+// Strings are returned as string and numbers as float64.
+func attributeValues(attr MessageAttribute) ([]interface{}, error) {
+	switch attr.Type {
+	case "":
+		return nil, nil
+	case AttributeTypeString:
+		return []interface{}{attr.Value}, nil
+	case AttributeTypeNumber:
+		num, err := strconv.ParseFloat(attr.Value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q as a number: %w", attr.Value, err)
+		}
+		return []interface{}{num}, nil
+	case AttributeTypeStringArray:
+		var values []interface{}
+		if err := json.Unmarshal([]byte(attr.Value), &values); err != nil {
+			return nil, fmt.Errorf("parse %q as an array: %w", attr.Value, err)
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("unsupported data type %q", attr.Type)
+}
+
+func matchesRule(rule interface{}, exists bool, values []interface{}) (bool, error) {
+	operators, ok := rule.(map[string]interface{})
+	if !ok {
+		return containsValue(values, rule), nil
+	}
+	if len(operators) != 1 {
+		return false, fmt.Errorf("rule %v must have exactly one operator", rule)
+	}
+	for op, operand := range operators {
+		switch op {
+		case "exists":
+			want, ok := operand.(bool)
+			if !ok {
+				return false, fmt.Errorf(`"exists" must be a boolean`)
+			}
+			return exists == want, nil
+		case "prefix", "suffix", "equals-ignore-case":
+			s, ok := operand.(string)
+			if !ok {
+				return false, fmt.Errorf("%q must be a string", op)
+			}
+			return anyValue(values, func(v interface{}) bool {
+				str, ok := v.(string)
+				if !ok {
+					return false
+				}
+				switch op {
+				case "prefix":
+					return strings.HasPrefix(str, s)
+				case "suffix":
+					return strings.HasSuffix(str, s)
+				}
+				return strings.EqualFold(str, s)
+			}), nil
+		case "anything-but":
+			return matchesAnythingBut(operand, values)
+		case "numeric":
+			return matchesNumeric(operand, values)
+		default:
+			return false, fmt.Errorf("unsupported operator %q", op)
+		}
+	}
+	return false, nil
+}
+
+func matchesAnythingBut(operand interface{}, values []interface{}) (bool, error) {
+	if len(values) == 0 {
+		return false, nil
+	}
+	excluded := func(v interface{}) bool {
+		return containsValue([]interface{}{v}, operand)
+	}
+	switch operand := operand.(type) {
+	case []interface{}:
+		excluded = func(v interface{}) bool {
+			for _, o := range operand {
+				if containsValue([]interface{}{v}, o) {
+					return true
+				}
+			}
+			return false
+		}
+	case map[string]interface{}:
+		prefix, ok := operand["prefix"].(string)
+		if !ok || len(operand) != 1 {
+			return false, fmt.Errorf(`"anything-but" only supports the "prefix" operator`)
+		}
+		excluded = func(v interface{}) bool {
+			str, ok := v.(string)
+			return ok && strings.HasPrefix(str, prefix)
+		}
+	}
+	return !anyValue(values, excluded), nil
+}
+
+func matchesNumeric(operand interface{}, values []interface{}) (bool, error) {
+	conditions, ok := operand.([]interface{})
+	if !ok || len(conditions) == 0 || len(conditions)%2 != 0 {
+		return false, fmt.Errorf(`"numeric" must be a list of operator and number pairs`)
+	}
+	type condition struct {
+		op  string
+		num float64
+	}
+	var conds []condition
+	for i := 0; i < len(conditions); i += 2 {
+		op, ok := conditions[i].(string)
+		if !ok {
+			return false, fmt.Errorf(`"numeric" operator %v must be a string`, conditions[i])
+		}
+		num, ok := toFloat(conditions[i+1])
+		if !ok {
+			return false, fmt.Errorf(`"numeric" operand %v must be a number`, conditions[i+1])
+		}
+		switch op {
+		case "=", "<", "<=", ">", ">=":
+		default:
+			return false, fmt.Errorf(`unsupported "numeric" operator %q`, op)
+		}
+		conds = append(conds, condition{op: op, num: num})
+	}
+	return anyValue(values, func(v interface{}) bool {
+		num, ok := toFloat(v)
+		if !ok {
+			return false
+		}
+		for _, c := range conds {
+			var holds bool
+			switch c.op {
+			case "=":
+				holds = num == c.num
+			case "<":
+				holds = num < c.num
+			case "<=":
+				holds = num <= c.num
+			case ">":
+				holds = num > c.num
+			case ">=":
+				holds = num >= c.num
+			}
+			if !holds {
+				return false
+			}
+		}
+		return true
+	}), nil
+}
+
+// containsValue returns true if any of values is equal to the exact match rule.
+func containsValue(values []interface{}, rule interface{}) bool {
+	if ruleNum, ok := toFloat(rule); ok {
+		return anyValue(values, func(v interface{}) bool {
+			num, ok := toFloat(v)
+			return ok && num == ruleNum
+		})
+	}
+	switch rule.(type) {
+	case string, bool:
+		return anyValue(values, func(v interface{}) bool {
+			return v == rule
+		})
+	}
+	return false
+}
+
+func anyValue(values []interface{}, fn func(v interface{}) bool) bool {
+	for _, v := range values {
+		if fn(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
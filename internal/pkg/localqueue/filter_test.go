@@ -0,0 +1,150 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package localqueue
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMatchesFilterPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		inPolicy string
+		inAttrs  map[string]MessageAttribute
+
+		wanted      bool
+		wantedError error
+	}{
+		"empty policy matches every message": {
+			inPolicy: `{}`,
+			wanted:   true,
+		},
+		"exact string match": {
+			inPolicy: `store: ["example_corp", "other_corp"]`,
+			inAttrs: map[string]MessageAttribute{
+				"store": {Type: AttributeTypeString, Value: "example_corp"},
+			},
+			wanted: true,
+		},
+		"string mismatch": {
+			inPolicy: `store: ["example_corp"]`,
+			inAttrs: map[string]MessageAttribute{
+				"store": {Type: AttributeTypeString, Value: "another_corp"},
+			},
+		},
+		"missing attribute does not match": {
+			inPolicy: `store: ["example_corp"]`,
+		},
+		"all attributes must match": {
+			inPolicy: `
+store: ["example_corp"]
+event: ["order_placed"]`,
+			inAttrs: map[string]MessageAttribute{
+				"store": {Type: AttributeTypeString, Value: "example_corp"},
+				"event": {Type: AttributeTypeString, Value: "order_cancelled"},
+			},
+		},
+		"string array matches any element": {
+			inPolicy: `customer_interests: ["rugby"]`,
+			inAttrs: map[string]MessageAttribute{
+				"customer_interests": {Type: AttributeTypeStringArray, Value: `["soccer", "rugby"]`},
+			},
+			wanted: true,
+		},
+		"prefix": {
+			inPolicy: `event: [{prefix: order-}]`,
+			inAttrs: map[string]MessageAttribute{
+				"event": {Type: AttributeTypeString, Value: "order-placed"},
+			},
+			wanted: true,
+		},
+		"anything-but excludes listed values": {
+			inPolicy: `event: [{anything-but: ["order_cancelled", "order_rejected"]}]`,
+			inAttrs: map[string]MessageAttribute{
+				"event": {Type: AttributeTypeString, Value: "order_cancelled"},
+			},
+		},
+		"anything-but matches other values": {
+			inPolicy: `event: [{anything-but: {prefix: order-}}]`,
+			inAttrs: map[string]MessageAttribute{
+				"event": {Type: AttributeTypeString, Value: "refund-issued"},
+			},
+			wanted: true,
+		},
+		"numeric range": {
+			inPolicy: `price_usd: [{numeric: [">=", 100, "<", 200]}]`,
+			inAttrs: map[string]MessageAttribute{
+				"price_usd": {Type: AttributeTypeNumber, Value: "150.5"},
+			},
+			wanted: true,
+		},
+		"numeric range mismatch": {
+			inPolicy: `price_usd: [{numeric: [">=", 100, "<", 200]}]`,
+			inAttrs: map[string]MessageAttribute{
+				"price_usd": {Type: AttributeTypeNumber, Value: "200"},
+			},
+		},
+		"exact number does not match a string attribute": {
+			inPolicy: `quantity: [5]`,
+			inAttrs: map[string]MessageAttribute{
+				"quantity": {Type: AttributeTypeString, Value: "5"},
+			},
+		},
+		"exists false matches a missing attribute": {
+			inPolicy: `coupon: [{exists: false}]`,
+			wanted:   true,
+		},
+		"$or matches any of the policies": {
+			inPolicy: `
+$or:
+  - store: ["example_corp"]
+  - event: ["order_placed"]`,
+			inAttrs: map[string]MessageAttribute{
+				"event": {Type: AttributeTypeString, Value: "order_placed"},
+			},
+			wanted: true,
+		},
+		"error on nested policies": {
+			inPolicy: `
+order:
+  status: ["placed"]`,
+			wantedError: errors.New(`attribute "order": nested filter policies are not supported`),
+		},
+		"error on unsupported operator": {
+			inPolicy: `event: [{cidr: 10.0.0.0/24}]`,
+			inAttrs: map[string]MessageAttribute{
+				"event": {Type: AttributeTypeString, Value: "order_placed"},
+			},
+			wantedError: errors.New(`attribute "event": unsupported operator "cidr"`),
+		},
+		"error on malformed number attribute": {
+			inPolicy: `price_usd: [{numeric: [">", 0]}]`,
+			inAttrs: map[string]MessageAttribute{
+				"price_usd": {Type: AttributeTypeNumber, Value: "cheap"},
+			},
+			wantedError: errors.New(`attribute "price_usd": parse "cheap" as a number: strconv.ParseFloat: parsing "cheap": invalid syntax`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var policy map[string]interface{}
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inPolicy), &policy))
+
+			// WHEN
+			got, err := MatchesFilterPolicy(policy, tc.inAttrs)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
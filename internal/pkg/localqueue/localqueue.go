@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package localqueue provides local stand-ins for the SNS topics and SQS queues of Worker Services,
+// so that they can be run and fed messages without AWS resources.
+package localqueue
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/google/uuid"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+const (
+	// EmulatorImage is the image of the SQS compatible queue emulator.
+	EmulatorImage = "softwaremill/elasticmq-native:1.5.7"
+	// EmulatorHost is the host name containers use to reach the queue emulator.
+	EmulatorHost = "copilot-local-sqs"
+	// EmulatorPort is the port the queue emulator listens on, both in its container and on the host.
+	EmulatorPort = "9324"
+	// Region is the region local queues and topics pretend to be in.
+	Region = "us-east-1"
+
+	accountID  = "000000000000"
+	fifoSuffix = ".fifo"
+)
+
+// Environment variables that point a Worker Service to its queues.
+const (
+	envVarQueueURI       = "COPILOT_QUEUE_URI"
+	envVarTopicQueueURIs = "COPILOT_TOPIC_QUEUE_URIS"
+	envVarSQSEndpoint    = "AWS_ENDPOINT_URL_SQS"
+)
+
+// Subscription is a Worker Service's subscription to a topic.
+type Subscription struct {
+	Service      string                 // Name of the service that publishes to the topic.
+	Topic        string                 // Name of the topic.
+	FilterPolicy map[string]interface{} // Optional. Messages must match the policy to be delivered.
+	Queue        string                 // Name of the local queue that receives messages from the topic.
+
+	envVarKey string // Key of the queue in the COPILOT_TOPIC_QUEUE_URIS environment variable, if the queue is topic-specific.
+}
+
+// Worker holds the local queues of a Worker Service.
+type Worker struct {
+	Name          string
+	Queue         string // Name of the default events queue.
+	Subscriptions []Subscription
+}
+
+// NewWorker returns the local queues of the Worker Service named name.
+func NewWorker(name string, mft *manifest.WorkerService) Worker {
+	w := Worker{
+		Name:  name,
+		Queue: queueName(mft.Subscribe.Queue.FIFO.IsEnabled(), name, "events"),
+	}
+	for _, sub := range mft.Subscriptions() {
+		svc, topic := aws.StringValue(sub.Service), aws.StringValue(sub.Name)
+		s := Subscription{
+			Service:      svc,
+			Topic:        strings.TrimSuffix(topic, fifoSuffix),
+			FilterPolicy: sub.FilterPolicy,
+			Queue:        w.Queue,
+		}
+		if aws.BoolValue(sub.Queue.Enabled) || !sub.Queue.Advanced.IsEmpty() {
+			s.Queue = queueName(sub.Queue.Advanced.FIFO.IsEnabled(), name, svc, s.Topic)
+			s.envVarKey = fmt.Sprintf("%s%sEventsQueue", template.StripNonAlphaNumFunc(svc),
+				cases.Title(language.English).String(template.StripNonAlphaNumFunc(topic)))
+		}
+		w.Subscriptions = append(w.Subscriptions, s)
+	}
+	return w
+}
+
+// Queues returns the names of all the queues of the worker.
+func (w Worker) Queues() []string {
+	queues := []string{w.Queue}
+	for _, sub := range w.Subscriptions {
+		if sub.Queue != w.Queue {
+			queues = append(queues, sub.Queue)
+		}
+	}
+	return queues
+}
+
+// EnvVars returns the environment variables that point the worker's main container to its queues on the emulator.
+func (w Worker) EnvVars() (map[string]string, error) {
+	vars := map[string]string{
+		envVarQueueURI:    QueueURL(EmulatorHost, w.Queue),
+		envVarSQSEndpoint: fmt.Sprintf("http://%s:%s", EmulatorHost, EmulatorPort),
+	}
+	topicQueueURIs := make(map[string]string)
+	for _, sub := range w.Subscriptions {
+		if sub.envVarKey != "" {
+			topicQueueURIs[sub.envVarKey] = QueueURL(EmulatorHost, sub.Queue)
+		}
+	}
+	if len(topicQueueURIs) == 0 {
+		return vars, nil
+	}
+	out, err := json.Marshal(topicQueueURIs)
+	if err != nil {
+		return nil, fmt.Errorf("marshal topic queue URIs: %w", err)
+	}
+	vars[envVarTopicQueueURIs] = string(out)
+	return vars, nil
+}
+
+// QueueURL returns the URL of the queue named name on the emulator reachable at host.
+func QueueURL(host, name string) string {
+	return fmt.Sprintf("http://%s:%s/%s/%s", host, EmulatorPort, accountID, name)
+}
+
+// TopicARN returns the ARN standing in for a topic of a service.
+func TopicARN(app, env, svc, topic string) string {
+	return fmt.Sprintf("arn:aws:sns:%s:%s:%s-%s-%s-%s", Region, accountID, app, env, svc, topic)
+}
+
+type notification struct {
+	Type              string
+	MessageID         string `json:"MessageId"`
+	TopicArn          string
+	Message           string
+	Timestamp         string
+	MessageAttributes map[string]MessageAttribute `json:",omitempty"`
+}
+
+// Notification returns the body of the message a topic subscription delivers to a queue,
+// in the same format as SNS when raw message delivery is disabled.
+func Notification(topicARN, message string, attrs map[string]MessageAttribute) (string, error) {
+	out, err := json.Marshal(notification{
+		Type:              "Notification",
+		MessageID:         uuid.NewString(),
+		TopicArn:          topicARN,
+		Message:           message,
+		Timestamp:         time.Now().UTC().Format("2006-01-02T15:04:05.000Z"),
+		MessageAttributes: attrs,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal notification: %w", err)
+	}
+	return string(out), nil
+}
+
+func queueName(fifo bool, parts ...string) string {
+	name := strings.Join(parts, "-")
+	if fifo {
+		name += fifoSuffix
+	}
+	return name
+}
@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package localqueue
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorker(t *testing.T) {
+	testCases := map[string]struct {
+		inMft string
+
+		wantedQueues  []string
+		wantedSubs    []Subscription
+		wantedEnvVars map[string]string
+	}{
+		"subscriptions deliver to the default queue": {
+			inMft: `name: worker
+type: Worker Service
+image:
+  build: Dockerfile
+subscribe:
+  topics:
+    - name: orders
+      service: api
+      filter_policy:
+        store: ["example_corp"]`,
+			wantedQueues: []string{"worker-events"},
+			wantedSubs: []Subscription{
+				{
+					Service: "api",
+					Topic:   "orders",
+					FilterPolicy: map[string]interface{}{
+						"store": []interface{}{"example_corp"},
+					},
+					Queue: "worker-events",
+				},
+			},
+			wantedEnvVars: map[string]string{
+				"COPILOT_QUEUE_URI":    "http://copilot-local-sqs:9324/000000000000/worker-events",
+				"AWS_ENDPOINT_URL_SQS": "http://copilot-local-sqs:9324",
+			},
+		},
+		"topic-specific and FIFO queues": {
+			inMft: `name: worker
+type: Worker Service
+image:
+  build: Dockerfile
+subscribe:
+  queue:
+    fifo: true
+  topics:
+    - name: orders
+      service: api
+    - name: refunds
+      service: api
+      queue: true`,
+			wantedQueues: []string{"worker-events.fifo", "worker-api-refunds"},
+			wantedSubs: []Subscription{
+				{
+					Service: "api",
+					Topic:   "orders",
+					Queue:   "worker-events.fifo",
+				},
+				{
+					Service:   "api",
+					Topic:     "refunds",
+					Queue:     "worker-api-refunds",
+					envVarKey: "apiRefundsEventsQueue",
+				},
+			},
+			wantedEnvVars: map[string]string{
+				"COPILOT_QUEUE_URI":        "http://copilot-local-sqs:9324/000000000000/worker-events.fifo",
+				"COPILOT_TOPIC_QUEUE_URIS": `{"apiRefundsEventsQueue":"http://copilot-local-sqs:9324/000000000000/worker-api-refunds"}`,
+				"AWS_ENDPOINT_URL_SQS":     "http://copilot-local-sqs:9324",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft, err := manifest.UnmarshalWorkload([]byte(tc.inMft))
+			require.NoError(t, err)
+
+			// WHEN
+			w := NewWorker("worker", mft.Manifest().(*manifest.WorkerService))
+			envVars, err := w.EnvVars()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedQueues, w.Queues())
+			require.Equal(t, tc.wantedSubs, w.Subscriptions)
+			require.Equal(t, tc.wantedEnvVars, envVars)
+		})
+	}
+}
+
+func TestNotification(t *testing.T) {
+	// WHEN
+	out, err := Notification("arn:aws:sns:us-east-1:000000000000:app-test-api-orders", "hello", map[string]MessageAttribute{
+		"store": {Type: AttributeTypeString, Value: "example_corp"},
+	})
+
+	// THEN
+	require.NoError(t, err)
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(out), &got))
+	require.Equal(t, "Notification", got["Type"])
+	require.Equal(t, "arn:aws:sns:us-east-1:000000000000:app-test-api-orders", got["TopicArn"])
+	require.Equal(t, "hello", got["Message"])
+	require.NotEmpty(t, got["MessageId"])
+	require.Equal(t, map[string]interface{}{
+		"store": map[string]interface{}{
+			"Type":  "String",
+			"Value": "example_corp",
+		},
+	}, got["MessageAttributes"])
+}
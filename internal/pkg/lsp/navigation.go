@@ -0,0 +1,263 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	envNameVar     = "${COPILOT_ENVIRONMENT_NAME"
+	docsURLFmt     = "https://aws.github.io/copilot-cli/docs/manifest/%s/#%s"
+	envOverrideKey = "environments"
+)
+
+// docsPages holds the pages of the manifest documentation by manifest type.
+var docsPages = map[string]string{
+	manifestinfo.LoadBalancedWebServiceType:  "lb-web-service",
+	manifestinfo.RequestDrivenWebServiceType: "rd-web-service",
+	manifestinfo.BackendServiceType:          "backend-service",
+	manifestinfo.WorkerServiceType:           "worker-service",
+	manifestinfo.StaticSiteType:              "static-site",
+	manifestinfo.ScheduledJobType:            "scheduled-job",
+	manifest.Environmentmanifestinfo:         "environment",
+	manifest.PipelineManifestType:            "pipeline",
+}
+
+// field is the field of a manifest under the cursor.
+type field struct {
+	path  []string   // Path to the field, with map keys and list indexes, such as ["sidecars", "nginx", "port"].
+	key   *yaml.Node // Key of the field, nil for list items.
+	value *yaml.Node
+	onKey bool // True if the cursor is on the key of the field rather than on its value.
+}
+
+// hoverAt returns the documentation of the field at pos, or nil if there is no field at pos.
+func hoverAt(content []byte, pos position) *hover {
+	root, ok := parseManifest(content)
+	if !ok {
+		return nil
+	}
+	f := fieldAt(root, pos, nil)
+	if f == nil {
+		return nil
+	}
+	mftType := manifestType(root)
+	doc, ok := manifest.DescribeField(mftType, withoutEnvOverride(f.path))
+	if !ok {
+		return nil
+	}
+	value := fmt.Sprintf("**`%s`** %s", strings.Join(doc.Path, "."), doc.Type)
+	if page, ok := docsPages[mftType]; ok {
+		anchor := strings.ReplaceAll(strings.Join(doc.Path, "-"), "_", "-")
+		value += fmt.Sprintf("\n\n[Documentation](%s)", fmt.Sprintf(docsURLFmt, page, anchor))
+	}
+	node := f.value
+	if f.onKey {
+		node = f.key
+	}
+	r := nodeRange(node)
+	return &hover{
+		Contents: markupContent{
+			Kind:  markupKindMarkdown,
+			Value: value,
+		},
+		Range: &r,
+	}
+}
+
+// definitionAt returns the locations that the reference at pos is defined at.
+// References are either the COPILOT_ENVIRONMENT_NAME variable, defined by the manifests of the environments,
+// or the names of containers in "depends_on" and "target_container" fields, defined by the sidecars and the workload.
+func (s *Server) definitionAt(uri string, content []byte, pos position) []location {
+	locations := []location{}
+	root, ok := parseManifest(content)
+	if !ok {
+		return locations
+	}
+	f := fieldAt(root, pos, nil)
+	if isOnEnvNameVar(content, pos) {
+		envs, err := s.ws.ListEnvironments()
+		if err != nil {
+			return locations
+		}
+		if f != nil && len(f.path) > 2 && f.path[0] == envOverrideKey && slices.Contains(envs, f.path[1]) {
+			// The variable is the name of the environment of the override.
+			envs = []string{f.path[1]}
+		}
+		for _, env := range envs {
+			locations = append(locations, location{URI: pathToURI(s.ws.EnvManifestFileAbsPath(env))})
+		}
+		return locations
+	}
+	if f == nil {
+		return locations
+	}
+	var container string
+	switch {
+	case f.onKey && len(f.path) > 1 && f.path[len(f.path)-2] == "depends_on":
+		container = f.key.Value
+	case !f.onKey && f.path[len(f.path)-1] == "target_container":
+		container = f.value.Value
+	default:
+		return locations
+	}
+	if def := containerDefinition(root, f.path, container); def != nil {
+		locations = append(locations, location{URI: uri, Range: nodeRange(def)})
+	}
+	return locations
+}
+
+// containerDefinition returns the node that defines the container named name: either a sidecar or the workload itself.
+// Sidecars are looked up in the environment override of the path first.
+func containerDefinition(root *yaml.Node, path []string, name string) *yaml.Node {
+	if len(path) > 2 && path[0] == envOverrideKey {
+		if override := mappingValue(mappingValue(root, envOverrideKey), path[1]); override != nil {
+			if sidecar := mappingKey(mappingValue(override, "sidecars"), name); sidecar != nil {
+				return sidecar
+			}
+		}
+	}
+	if sidecar := mappingKey(mappingValue(root, "sidecars"), name); sidecar != nil {
+		return sidecar
+	}
+	if wkld := mappingValue(root, "name"); wkld != nil && wkld.Value == name {
+		return wkld
+	}
+	return nil
+}
+
+// isOnEnvNameVar returns true if pos is on a reference to the COPILOT_ENVIRONMENT_NAME variable.
+func isOnEnvNameVar(content []byte, pos position) bool {
+	lines := strings.Split(string(content), "\n")
+	if pos.Line >= len(lines) {
+		return false
+	}
+	line := []rune(lines[pos.Line])
+	varRunes := []rune(envNameVar)
+	for start := 0; start+len(varRunes) <= len(line); start++ {
+		if string(line[start:start+len(varRunes)]) != envNameVar {
+			continue
+		}
+		end := start + len(varRunes)
+		for end < len(line) && line[end-1] != '}' {
+			end++
+		}
+		if pos.Character >= start && pos.Character < end {
+			return true
+		}
+	}
+	return false
+}
+
+// parseManifest returns the root mapping of the manifest.
+func parseManifest(content []byte) (*yaml.Node, bool) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, false
+	}
+	return doc.Content[0], true
+}
+
+// manifestType returns the type of the manifest. Pipeline manifests don't have a type but have stages.
+func manifestType(root *yaml.Node) string {
+	if typ := mappingValue(root, "type"); typ != nil {
+		return typ.Value
+	}
+	if mappingValue(root, "stages") != nil {
+		return manifest.PipelineManifestType
+	}
+	return ""
+}
+
+// withoutEnvOverride returns the path of a field in an environment override as if it wasn't overridden.
+func withoutEnvOverride(path []string) []string {
+	if len(path) > 2 && path[0] == envOverrideKey {
+		return path[2:]
+	}
+	return path
+}
+
+// fieldAt returns the field at pos under node, or nil if there is none.
+func fieldAt(node *yaml.Node, pos position, path []string) *field {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			fieldPath := append(slices.Clone(path), key.Value)
+			if contains(key, pos) {
+				return &field{path: fieldPath, key: key, value: value, onKey: true}
+			}
+			if value.Kind == yaml.ScalarNode && contains(value, pos) {
+				return &field{path: fieldPath, key: key, value: value}
+			}
+			if f := fieldAt(value, pos, fieldPath); f != nil {
+				return f
+			}
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			itemPath := append(slices.Clone(path), strconv.Itoa(i))
+			if item.Kind == yaml.ScalarNode && contains(item, pos) {
+				return &field{path: itemPath, value: item}
+			}
+			if f := fieldAt(item, pos, itemPath); f != nil {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// contains returns true if pos is on the scalar node.
+func contains(node *yaml.Node, pos position) bool {
+	r := nodeRange(node)
+	return pos.Line == r.Start.Line && pos.Character >= r.Start.Character && pos.Character < r.End.Character
+}
+
+// nodeRange returns the range of a scalar node on its line.
+func nodeRange(node *yaml.Node) textRange {
+	length := len([]rune(node.Value))
+	if node.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0 {
+		length += 2
+	}
+	start := position{Line: node.Line - 1, Character: node.Column - 1}
+	return textRange{
+		Start: start,
+		End:   position{Line: start.Line, Character: start.Character + length},
+	}
+}
+
+// mappingKey returns the key node of the field named key in a mapping, or nil if it doesn't exist.
+func mappingKey(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i]
+		}
+	}
+	return nil
+}
+
+// mappingValue returns the value node of the field named key in a mapping, or nil if it doesn't exist.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+import (
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWorkspace struct {
+	envs    []string
+	envsErr error
+}
+
+func (ws *fakeWorkspace) ListEnvironments() ([]string, error) {
+	return ws.envs, ws.envsErr
+}
+
+func (ws *fakeWorkspace) EnvManifestFileAbsPath(name string) string {
+	return path.Join("/ws/copilot/environments", name, "manifest.yml")
+}
+
+const testSvcManifest = `name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 80
+  depends_on:
+    nginx: start
+http:
+  path: /
+  target_container: nginx
+variables:
+  LOG_LEVEL: ${COPILOT_ENVIRONMENT_NAME}
+sidecars:
+  nginx:
+    port: 8080
+environments:
+  test:
+    variables:
+      STAGE: ${COPILOT_ENVIRONMENT_NAME}
+    sidecars:
+      nginx:
+        port: 8081
+    http:
+      target_container: frontend
+`
+
+func TestHoverAt(t *testing.T) {
+	testCases := map[string]struct {
+		inContent  string
+		inPosition position
+
+		wantedHover *hover
+	}{
+		"no field at the position": {
+			inContent:  testSvcManifest,
+			inPosition: position{Line: 2, Character: 20},
+		},
+		"invalid manifest": {
+			inContent:  "name: [frontend",
+			inPosition: position{Line: 0, Character: 1},
+		},
+		"key of a field": {
+			inContent:  testSvcManifest,
+			inPosition: position{Line: 8, Character: 3},
+			wantedHover: &hover{
+				Contents: markupContent{
+					Kind:  markupKindMarkdown,
+					Value: "**`http.path`** String\n\n[Documentation](https://aws.github.io/copilot-cli/docs/manifest/lb-web-service/#http-path)",
+				},
+				Range: &textRange{
+					Start: position{Line: 8, Character: 2},
+					End:   position{Line: 8, Character: 6},
+				},
+			},
+		},
+		"value of a field in an environment override": {
+			inContent:  testSvcManifest,
+			inPosition: position{Line: 21, Character: 15},
+			wantedHover: &hover{
+				Contents: markupContent{
+					Kind:  markupKindMarkdown,
+					Value: "**`sidecars.port`** String\n\n[Documentation](https://aws.github.io/copilot-cli/docs/manifest/lb-web-service/#sidecars-port)",
+				},
+				Range: &textRange{
+					Start: position{Line: 21, Character: 14},
+					End:   position{Line: 21, Character: 18},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			h := hoverAt([]byte(tc.inContent), tc.inPosition)
+
+			// THEN
+			require.Equal(t, tc.wantedHover, h)
+		})
+	}
+}
+
+func TestServer_definitionAt(t *testing.T) {
+	const uri = "file:///ws/copilot/frontend/manifest.yml"
+	testCases := map[string]struct {
+		inPosition  position
+		inWorkspace *fakeWorkspace
+
+		wantedLocations []location
+	}{
+		"no reference at the position": {
+			inPosition:      position{Line: 0, Character: 2},
+			wantedLocations: []location{},
+		},
+		"environment name variable is defined by all the environments": {
+			inPosition:  position{Line: 11, Character: 16},
+			inWorkspace: &fakeWorkspace{envs: []string{"prod", "test"}},
+			wantedLocations: []location{
+				{URI: "file:///ws/copilot/environments/prod/manifest.yml"},
+				{URI: "file:///ws/copilot/environments/test/manifest.yml"},
+			},
+		},
+		"environment name variable in an override is defined by its environment": {
+			inPosition:  position{Line: 18, Character: 20},
+			inWorkspace: &fakeWorkspace{envs: []string{"prod", "test"}},
+			wantedLocations: []location{
+				{URI: "file:///ws/copilot/environments/test/manifest.yml"},
+			},
+		},
+		"no definition if environments can't be listed": {
+			inPosition:      position{Line: 11, Character: 16},
+			inWorkspace:     &fakeWorkspace{envsErr: errors.New("some error")},
+			wantedLocations: []location{},
+		},
+		"container in depends_on is defined by a sidecar": {
+			inPosition: position{Line: 6, Character: 5},
+			wantedLocations: []location{
+				{
+					URI: uri,
+					Range: textRange{
+						Start: position{Line: 13, Character: 2},
+						End:   position{Line: 13, Character: 7},
+					},
+				},
+			},
+		},
+		"target container is defined by a sidecar": {
+			inPosition: position{Line: 9, Character: 21},
+			wantedLocations: []location{
+				{
+					URI: uri,
+					Range: textRange{
+						Start: position{Line: 13, Character: 2},
+						End:   position{Line: 13, Character: 7},
+					},
+				},
+			},
+		},
+		"target container is defined by the workload": {
+			inPosition: position{Line: 23, Character: 26},
+			wantedLocations: []location{
+				{
+					URI: uri,
+					Range: textRange{
+						Start: position{Line: 0, Character: 6},
+						End:   position{Line: 0, Character: 14},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			s := NewServer(nil, tc.inWorkspace, "")
+
+			// WHEN
+			locations := s.definitionAt(uri, []byte(testSvcManifest), tc.inPosition)
+
+			// THEN
+			require.Equal(t, tc.wantedLocations, locations)
+		})
+	}
+}
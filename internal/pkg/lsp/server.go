@@ -0,0 +1,279 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lsp provides a language server for Copilot manifests.
+// It speaks the Language Server Protocol over JSON-RPC so that editors can report manifest errors as you type,
+// show the documentation of fields on hover, and go to the definition of references.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
+const diagnosticSource = "copilot"
+
+// Problem is an error in a manifest.
+type Problem struct {
+	Position manifest.Position // Position of the error, zero if unknown.
+	Message  string
+}
+
+// Linter reports the problems in manifests.
+type Linter interface {
+	// Lint returns the problems in the content of the file at path.
+	// It returns nil if the file isn't a manifest.
+	Lint(path string, content []byte) []Problem
+}
+
+// Workspace holds the manifests that references are defined in.
+type Workspace interface {
+	ListEnvironments() ([]string, error)
+	EnvManifestFileAbsPath(name string) string
+}
+
+// Server is a language server for Copilot manifests.
+type Server struct {
+	linter  Linter
+	ws      Workspace
+	version string
+
+	docs     map[string][]byte // Content of the open documents by URI.
+	w        io.Writer
+	shutdown bool
+}
+
+// NewServer returns a language server that reports the problems found by linter.
+func NewServer(linter Linter, ws Workspace, version string) *Server {
+	return &Server{
+		linter:  linter,
+		ws:      ws,
+		version: version,
+		docs:    make(map[string][]byte),
+	}
+}
+
+// Serve reads requests from r and writes responses to w until the client asks the server to exit or r is closed.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	s.w = w
+	reader := bufio.NewReader(r)
+	for {
+		body, err := readMessage(reader)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var msg message
+		if err := json.Unmarshal(body, &msg); err != nil {
+			if err := s.reply(nil, nil, &responseError{Code: codeParseError, Message: err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+		if msg.Method == methodExit {
+			if !s.shutdown {
+				return errors.New("exit before shutdown")
+			}
+			return nil
+		}
+		if err := s.handle(&msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(msg *message) error {
+	var result any
+	var respErr *responseError
+	switch msg.Method {
+	case methodInitialize:
+		result = initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync:   textDocumentSyncFull,
+				HoverProvider:      true,
+				DefinitionProvider: true,
+			},
+			ServerInfo: serverInfo{
+				Name:    diagnosticSource,
+				Version: s.version,
+			},
+		}
+	case methodShutdown:
+		s.shutdown = true
+	case methodDidOpen:
+		var params didOpenParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		s.docs[params.TextDocument.URI] = []byte(params.TextDocument.Text)
+		return s.publishDiagnostics(params.TextDocument.URI)
+	case methodDidChange:
+		var params didChangeParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil || len(params.ContentChanges) == 0 {
+			return nil
+		}
+		// The server asks for full syncs, so the last change holds the whole document.
+		s.docs[params.TextDocument.URI] = []byte(params.ContentChanges[len(params.ContentChanges)-1].Text)
+		return s.publishDiagnostics(params.TextDocument.URI)
+	case methodDidClose:
+		var params didCloseParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return s.notify(methodPublishDiagnostics, publishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []diagnostic{},
+		})
+	case methodHover, methodDefinition:
+		var params textDocumentPositionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			respErr = &responseError{Code: codeInvalidParams, Message: err.Error()}
+			break
+		}
+		content, ok := s.docs[params.TextDocument.URI]
+		if !ok {
+			break
+		}
+		if msg.Method == methodHover {
+			if h := hoverAt(content, params.Position); h != nil {
+				result = h
+			}
+			break
+		}
+		result = s.definitionAt(params.TextDocument.URI, content, params.Position)
+	default:
+		if msg.ID == nil {
+			// Notifications that aren't handled, such as "initialized", are ignored.
+			return nil
+		}
+		respErr = &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method %q is not supported", msg.Method)}
+	}
+	if msg.ID == nil {
+		return nil
+	}
+	return s.reply(msg.ID, result, respErr)
+}
+
+func (s *Server) publishDiagnostics(uri string) error {
+	content := s.docs[uri]
+	diagnostics := []diagnostic{}
+	if path, ok := uriToPath(uri); ok {
+		lines := strings.Split(string(content), "\n")
+		for _, problem := range s.linter.Lint(path, content) {
+			diagnostics = append(diagnostics, diagnostic{
+				Range:    problemRange(lines, problem.Position),
+				Severity: severityError,
+				Source:   diagnosticSource,
+				Message:  problem.Message,
+			})
+		}
+	}
+	return s.notify(methodPublishDiagnostics, publishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diagnostics,
+	})
+}
+
+// problemRange returns the range from the position of a problem to the end of its line.
+// Problems without a position are reported on the first line.
+func problemRange(lines []string, pos manifest.Position) textRange {
+	start := position{}
+	if pos.Line > 0 {
+		start = position{Line: pos.Line - 1, Character: pos.Column - 1}
+	}
+	end := start
+	if start.Line < len(lines) {
+		end.Character = len([]rune(strings.TrimRight(lines[start.Line], "\r")))
+	}
+	if end.Character < start.Character {
+		end.Character = start.Character
+	}
+	return textRange{Start: start, End: end}
+}
+
+func (s *Server) reply(id *json.RawMessage, result any, respErr *responseError) error {
+	msg := &message{
+		JSONRPC: "2.0",
+		ID:      id,
+		Error:   respErr,
+	}
+	if respErr == nil {
+		msg.Result = result
+		if result == nil {
+			msg.Result = json.RawMessage("null")
+		}
+	}
+	if id == nil {
+		null := json.RawMessage("null")
+		msg.ID = &null
+	}
+	return writeMessage(s.w, msg)
+}
+
+func (s *Server) notify(method string, params any) error {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("marshal %s params: %w", method, err)
+	}
+	return writeMessage(s.w, &message{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  raw,
+	})
+}
+
+// readMessage reads the body of a message framed by a Content-Length header.
+func readMessage(r *bufio.Reader) ([]byte, error) {
+	header, err := textproto.NewReader(r).ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil {
+		return nil, fmt.Errorf("parse Content-Length header %q: %w", header.Get("Content-Length"), err)
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, fmt.Errorf("read message body: %w", err)
+	}
+	return body, nil
+}
+
+// writeMessage writes a message framed by a Content-Length header.
+func writeMessage(w io.Writer, msg *message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("marshal message: %w", err)
+	}
+	if _, err := fmt.Fprintf(w, "Content-Length: %d\r\n\r\n%s", len(body), body); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+	return nil
+}
+
+// uriToPath returns the path of a "file://" URI.
+func uriToPath(uri string) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return "", false
+	}
+	return filepath.FromSlash(u.Path), true
+}
+
+// pathToURI returns the "file://" URI of an absolute path.
+func pathToURI(path string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLinter struct {
+	problems map[string][]Problem // Problems by path.
+}
+
+func (l *fakeLinter) Lint(path string, _ []byte) []Problem {
+	return l.problems[path]
+}
+
+func TestServer_Serve(t *testing.T) {
+	testCases := map[string]struct {
+		inRequests []string
+
+		wantedResponses []string
+		wantedError     error
+	}{
+		"initialize and shut down": {
+			inRequests: []string{
+				`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`,
+				`{"jsonrpc":"2.0","method":"initialized","params":{}}`,
+				`{"jsonrpc":"2.0","id":2,"method":"shutdown"}`,
+				`{"jsonrpc":"2.0","method":"exit"}`,
+			},
+			wantedResponses: []string{
+				`{"jsonrpc":"2.0","id":1,"result":{"capabilities":{"textDocumentSync":1,"hoverProvider":true,"definitionProvider":true},"serverInfo":{"name":"copilot","version":"v1.34.0"}}}`,
+				`{"jsonrpc":"2.0","id":2,"result":null}`,
+			},
+		},
+		"error if the client exits before shutting down the server": {
+			inRequests: []string{
+				`{"jsonrpc":"2.0","method":"exit"}`,
+			},
+			wantedError: fmt.Errorf("exit before shutdown"),
+		},
+		"unsupported methods and invalid messages": {
+			inRequests: []string{
+				`{"jsonrpc":"2.0","id":1,"method":"textDocument/completion","params":{}}`,
+				`{"jsonrpc":"2.0","method":"$/cancelRequest","params":{"id":1}}`,
+				`{"jsonrpc":`,
+			},
+			wantedResponses: []string{
+				`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method \"textDocument/completion\" is not supported"}}`,
+				`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"unexpected end of JSON input"}}`,
+			},
+		},
+		"publish the problems of documents as they change": {
+			inRequests: []string{
+				`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///ws/copilot/api/manifest.yml","text":"name: api\ntype: Backend Service\ncount: -1\n"}}}`,
+				`{"jsonrpc":"2.0","method":"textDocument/didChange","params":{"textDocument":{"uri":"file:///ws/copilot/api/manifest.yml"},"contentChanges":[{"text":"name: api\n"}]}}`,
+				`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"untitled:Untitled-1","text":"name: api\n"}}}`,
+				`{"jsonrpc":"2.0","method":"textDocument/didClose","params":{"textDocument":{"uri":"file:///ws/copilot/api/manifest.yml"}}}`,
+			},
+			wantedResponses: []string{
+				`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///ws/copilot/api/manifest.yml","diagnostics":[{"range":{"start":{"line":2,"character":0},"end":{"line":2,"character":9}},"severity":1,"source":"copilot","message":"invalid count"},{"range":{"start":{"line":0,"character":0},"end":{"line":0,"character":9}},"severity":1,"source":"copilot","message":"some error"}]}}`,
+				`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///ws/copilot/api/manifest.yml","diagnostics":[{"range":{"start":{"line":2,"character":0},"end":{"line":2,"character":0}},"severity":1,"source":"copilot","message":"invalid count"},{"range":{"start":{"line":0,"character":0},"end":{"line":0,"character":9}},"severity":1,"source":"copilot","message":"some error"}]}}`,
+				`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"untitled:Untitled-1","diagnostics":[]}}`,
+				`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///ws/copilot/api/manifest.yml","diagnostics":[]}}`,
+			},
+		},
+		"hover and definition of open documents": {
+			inRequests: []string{
+				`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///ws/copilot/frontend/manifest.yml","text":"name: frontend\ntype: Backend Service\ncpu: 256\n"}}}`,
+				`{"jsonrpc":"2.0","id":1,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///ws/copilot/frontend/manifest.yml"},"position":{"line":2,"character":1}}}`,
+				`{"jsonrpc":"2.0","id":2,"method":"textDocument/definition","params":{"textDocument":{"uri":"file:///ws/copilot/frontend/manifest.yml"},"position":{"line":2,"character":1}}}`,
+				`{"jsonrpc":"2.0","id":3,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///ws/copilot/api/manifest.yml"},"position":{"line":2,"character":1}}}`,
+			},
+			wantedResponses: []string{
+				`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///ws/copilot/frontend/manifest.yml","diagnostics":[]}}`,
+				"{\"jsonrpc\":\"2.0\",\"id\":1,\"result\":{\"contents\":{\"kind\":\"markdown\",\"value\":\"**`cpu`** Integer\\n\\n[Documentation](https://aws.github.io/copilot-cli/docs/manifest/backend-service/#cpu)\"},\"range\":{\"start\":{\"line\":2,\"character\":0},\"end\":{\"line\":2,\"character\":3}}}}",
+				`{"jsonrpc":"2.0","id":2,"result":[]}`,
+				`{"jsonrpc":"2.0","id":3,"result":null}`,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			linter := &fakeLinter{
+				problems: map[string][]Problem{
+					"/ws/copilot/api/manifest.yml": {
+						{Position: manifest.Position{Line: 3, Column: 1}, Message: "invalid count"},
+						{Message: "some error"},
+					},
+				},
+			}
+			s := NewServer(linter, &fakeWorkspace{}, "v1.34.0")
+			in := &strings.Builder{}
+			for _, req := range tc.inRequests {
+				fmt.Fprintf(in, "Content-Length: %d\r\n\r\n%s", len(req), req)
+			}
+			out := &strings.Builder{}
+
+			// WHEN
+			err := s.Serve(strings.NewReader(in.String()), out)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			var responses []string
+			r := bufio.NewReader(strings.NewReader(out.String()))
+			for {
+				body, err := readMessage(r)
+				if err != nil {
+					break
+				}
+				require.True(t, json.Valid(body))
+				responses = append(responses, string(body))
+			}
+			require.Equal(t, tc.wantedResponses, responses)
+		})
+	}
+}
@@ -150,6 +150,12 @@ func (s *BackendService) ContainerDependencies() map[string]ContainerDependency
 	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.Logging, s.Sidecars)
 }
 
+// ContainerConfigs returns a map of ContainerConfig objects for the BackendService
+// including its main container and additional sidecars.
+func (s *BackendService) ContainerConfigs() map[string]ContainerConfig {
+	return containerConfigs(aws.StringValue(s.Name), s.ImageConfig.Image, s.ImageConfig.Port, s.ImageConfig.HealthCheck, s.TaskConfig, s.Sidecars)
+}
+
 func (s *BackendService) subnets() *SubnetListOrArgs {
 	return &s.Network.VPC.Placement.Subnets
 }
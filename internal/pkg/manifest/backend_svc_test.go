@@ -1287,3 +1287,99 @@ func TestBackendService_Dockerfile(t *testing.T) {
 		})
 	}
 }
+
+func TestBackendService_ContainerConfigs(t *testing.T) {
+	testCases := map[string]struct {
+		in     *BackendService
+		wanted map[string]ContainerConfig
+	}{
+		"return container configs of the main container and sidecars": {
+			in: &BackendService{
+				Workload: Workload{
+					Name: aws.String("api"),
+					Type: aws.String(manifestinfo.BackendServiceType),
+				},
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: Image{
+								ImageLocationOrBuild: ImageLocationOrBuild{
+									Build: BuildArgsOrString{
+										BuildString: aws.String("./Dockerfile"),
+									},
+								},
+							},
+							Port: aws.Uint16(8080),
+						},
+						HealthCheck: ContainerHealthCheck{
+							Command: []string{"CMD-SHELL", "curl localhost:8080"},
+						},
+					},
+					TaskConfig: TaskConfig{
+						Variables: map[string]Variable{
+							"LOG_LEVEL": {
+								StringOrFromCFN{
+									Plain: aws.String("debug"),
+								},
+							},
+						},
+						EnvFile: aws.String("api.env"),
+						Secrets: map[string]Secret{
+							"DB_PASSWORD": {
+								from: StringOrFromCFN{
+									Plain: aws.String("/db/password"),
+								},
+							},
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"nginx": {
+							Port: aws.String("80/tcp"),
+							Image: Union[*string, ImageLocationOrBuild]{
+								Basic: aws.String("public.ecr.aws/nginx/nginx:latest"),
+							},
+							EnvFile: aws.String("nginx.env"),
+						},
+					},
+				},
+			},
+			wanted: map[string]ContainerConfig{
+				"api": {
+					Port: "8080",
+					Variables: map[string]Variable{
+						"LOG_LEVEL": {
+							StringOrFromCFN{
+								Plain: aws.String("debug"),
+							},
+						},
+					},
+					EnvFile: "api.env",
+					Secrets: map[string]Secret{
+						"DB_PASSWORD": {
+							from: StringOrFromCFN{
+								Plain: aws.String("/db/password"),
+							},
+						},
+					},
+					HealthCheck: ContainerHealthCheck{
+						Command: []string{"CMD-SHELL", "curl localhost:8080"},
+					},
+				},
+				"nginx": {
+					Image:   "public.ecr.aws/nginx/nginx:latest",
+					Port:    "80",
+					EnvFile: "nginx.env",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got := ContainerConfigs(tc.in)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -19,26 +19,74 @@ const (
 	reservedEnvVarKeyForEnvName = "COPILOT_ENVIRONMENT_NAME"
 )
 
+const (
+	// ReferencePrefixSSM is the prefix of references to SSM parameters, such as "${ssm:/path/to/parameter}".
+	ReferencePrefixSSM = "ssm"
+	// ReferencePrefixCFNExport is the prefix of references to CloudFormation exports, such as "${cfn-export:ExportName}".
+	ReferencePrefixCFNExport = "cfn-export"
+)
+
+const (
+	interpolatorOpDefault  = ":-"
+	interpolatorOpRequired = ":?"
+)
+
 var (
 	// Taken from docker/compose.
 	// Environment variable names consist solely of uppercase letters, digits, and underscore,
 	// and do not begin with a digit. （https://pubs.opengroup.org/onlinepubs/007904875/basedefs/xbd_chap08.html）
-	interpolatorEnvVarRegExp = regexp.MustCompile(`(\\?)\${([_a-zA-Z][_a-zA-Z0-9]*)}`)
+	// The name can be followed by ":-default" or ":?message".
+	interpolatorEnvVarRegExp = regexp.MustCompile(`(\\?)\${([_a-zA-Z][_a-zA-Z0-9]*)(?:(:-|:\?)([^}]*))?}`)
+	// References start with the lowercase prefix of their resolver, such as "${ssm:/path/to/parameter}".
+	interpolatorReferenceRegExp = regexp.MustCompile(`(\\?)\${([a-z][a-z0-9-]*):([^-?}][^}]*)}`)
 )
 
+// Resolver looks up the value of a reference in a manifest, such as the value of an SSM parameter.
+type Resolver interface {
+	Resolve(name string) (string, error)
+}
+
+// ResolverFunc is an adapter to use a function as a Resolver.
+type ResolverFunc func(name string) (string, error)
+
+// Resolve calls f(name).
+func (f ResolverFunc) Resolve(name string) (string, error) {
+	return f(name)
+}
+
+// InterpolatorOption configures an Interpolator.
+type InterpolatorOption func(*Interpolator)
+
+// WithResolver substitutes the references with the given prefix, such as "${prefix:name}", with the value of name
+// returned by the resolver. References with a prefix that has no resolver are left as they are.
+func WithResolver(prefix string, r Resolver) InterpolatorOption {
+	return func(i *Interpolator) {
+		i.resolvers[prefix] = r
+	}
+}
+
 // Interpolator substitutes variables in a manifest.
 type Interpolator struct {
 	predefinedEnvVars map[string]string
+	resolvers         map[string]Resolver
+
+	resolved map[string]string // Cached values of the references.
 }
 
 // NewInterpolator initiates a new Interpolator.
-func NewInterpolator(appName, envName string) *Interpolator {
-	return &Interpolator{
+func NewInterpolator(appName, envName string, opts ...InterpolatorOption) *Interpolator {
+	i := &Interpolator{
 		predefinedEnvVars: map[string]string{
 			reservedEnvVarKeyForAppName: appName,
 			reservedEnvVarKeyForEnvName: envName,
 		},
+		resolvers: make(map[string]Resolver),
+		resolved:  make(map[string]string),
 	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
 }
 
 // Interpolate substitutes environment variables in a string.
@@ -98,39 +146,94 @@ func (i *Interpolator) applyInterpolation(node *yaml.Node) error {
 	return nil
 }
 
+// interpolatePart substitutes the environment variables in s, and then the references,
+// so that references can be built from environment variables such as "${ssm:/${COPILOT_ENVIRONMENT_NAME}/url}".
 func (i *Interpolator) interpolatePart(s string) (string, error) {
-	matches := interpolatorEnvVarRegExp.FindAllStringSubmatch(s, -1)
+	substituted, err := replaceAllMatches(s, interpolatorEnvVarRegExp, func(group func(int) string) (string, error) {
+		return i.substitute(group(2), group(3), group(4))
+	})
+	if err != nil {
+		return "", err
+	}
+	return replaceAllMatches(substituted, interpolatorReferenceRegExp, func(group func(int) string) (string, error) {
+		return i.resolve(group(0), group(2), group(3))
+	})
+}
+
+// replaceAllMatches replaces the matches of re in s with the result of replace, which gets the submatches of the match.
+// Matches whose first submatch is a backslash are escaped and aren't replaced.
+func replaceAllMatches(s string, re *regexp.Regexp, replace func(group func(int) string) (string, error)) (string, error) {
+	matches := re.FindAllStringSubmatchIndex(s, -1)
 	if len(matches) == 0 {
 		return s, nil
 	}
-	replaced := s
+	var replaced strings.Builder
+	prev := 0
 	for _, match := range matches {
-		// https://pkg.go.dev/regexp#Regexp.FindAllStringSubmatch
-		key := match[2]
-
-		if match[1] == "\\" {
-			// variable is escaped (e.g. \${foo}) -> no substitution is desired, let's just remove the leading backslash
-			replaced = strings.ReplaceAll(replaced, fmt.Sprintf("\\${%s}", key), fmt.Sprintf("${%s}", key))
-			continue
-		}
-
-		currSegment := fmt.Sprintf("${%s}", key)
-		predefinedVal, isPredefined := i.predefinedEnvVars[key]
-		osVal, isEnvVarSet := os.LookupEnv(key)
-		if isPredefined && isEnvVarSet && predefinedVal != osVal {
-			return "", fmt.Errorf(`predefined environment variable "%s" cannot be overridden by OS environment variable with the same name`, key)
+		// https://pkg.go.dev/regexp#Regexp.FindAllStringSubmatchIndex
+		group := func(n int) string {
+			if match[2*n] < 0 {
+				return ""
+			}
+			return s[match[2*n]:match[2*n+1]]
 		}
-		if isPredefined {
-			replaced = strings.ReplaceAll(replaced, currSegment, predefinedVal)
+		replaced.WriteString(s[prev:match[0]])
+		prev = match[1]
+		if group(1) == "\\" {
+			// variable is escaped (e.g. \${foo}) -> no substitution is desired, let's just remove the leading backslash
+			replaced.WriteString(group(0)[1:])
 			continue
 		}
-		if isEnvVarSet {
-			replaced = strings.ReplaceAll(replaced, currSegment, osVal)
-			continue
+		val, err := replace(group)
+		if err != nil {
+			return "", err
 		}
-		return "", fmt.Errorf(`environment variable "%s" is not defined`, key)
+		replaced.WriteString(val)
+	}
+	replaced.WriteString(s[prev:])
+	return replaced.String(), nil
+}
+
+// substitute returns the value of the environment variable key.
+// The operator ":-" substitutes the word if the variable is unset or empty, and ":?" returns an error with the word instead.
+func (i *Interpolator) substitute(key, op, word string) (string, error) {
+	predefinedVal, isPredefined := i.predefinedEnvVars[key]
+	osVal, isEnvVarSet := os.LookupEnv(key)
+	if isPredefined && isEnvVarSet && predefinedVal != osVal {
+		return "", fmt.Errorf(`predefined environment variable "%s" cannot be overridden by OS environment variable with the same name`, key)
+	}
+	if isPredefined {
+		return predefinedVal, nil
+	}
+	switch {
+	case op == interpolatorOpDefault && osVal == "":
+		return word, nil
+	case op == interpolatorOpRequired && osVal == "" && word != "":
+		return "", fmt.Errorf(`environment variable "%s" is required: %s`, key, word)
+	case op == interpolatorOpRequired && osVal == "":
+		return "", fmt.Errorf(`environment variable "%s" is required`, key)
+	case isEnvVarSet:
+		return osVal, nil
+	}
+	return "", fmt.Errorf(`environment variable "%s" is not defined`, key)
+}
+
+// resolve returns the value of the reference to name with the resolver of the prefix,
+// or the segment itself if there is no resolver for the prefix.
+func (i *Interpolator) resolve(segment, prefix, name string) (string, error) {
+	r, ok := i.resolvers[prefix]
+	if !ok {
+		return segment, nil
+	}
+	if val, ok := i.resolved[segment]; ok {
+		return val, nil
+	}
+	val, err := r.Resolve(name)
+	if err != nil {
+		return "", fmt.Errorf("resolve %s: %w", segment, err)
 	}
-	return replaced, nil
+	i.resolved[segment] = val
+	return val, nil
 }
 
 func unmarshalYAML(temp []byte) (*yaml.Node, error) {
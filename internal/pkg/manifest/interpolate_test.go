@@ -4,6 +4,7 @@
 package manifest
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"testing"
@@ -134,6 +135,36 @@ network:
 			},
 			wanted: "AB${c}D\n",
 		},
+		"should substitute the default value if the env var is unset or empty": {
+			inputStr: "${LOG_LEVEL:-info}/${REGION:-us-west-2}/${TAG:-latest}/${EMPTY:-}",
+			inputEnvVar: map[string]string{
+				"REGION": "",
+				"TAG":    "v1",
+			},
+			wanted: "info/us-west-2/v1/\n",
+		},
+		"should return error with the message if a required env var is unset": {
+			inputStr:  "${IMAGE_TAG:?set IMAGE_TAG to the tag of the release}",
+			wantedErr: fmt.Errorf(`environment variable "IMAGE_TAG" is required: set IMAGE_TAG to the tag of the release`),
+		},
+		"should return error if a required env var is empty": {
+			inputStr: "${IMAGE_TAG:?}",
+			inputEnvVar: map[string]string{
+				"IMAGE_TAG": "",
+			},
+			wantedErr: fmt.Errorf(`environment variable "IMAGE_TAG" is required`),
+		},
+		"should substitute a required env var that is set": {
+			inputStr: "${IMAGE_TAG:?set IMAGE_TAG}",
+			inputEnvVar: map[string]string{
+				"IMAGE_TAG": "v1",
+			},
+			wanted: "v1\n",
+		},
+		"should not substitute references without a resolver": {
+			inputStr: "arn:aws:s3:::bucket/${aws:username}/${ssm:/copilot/db}",
+			wanted:   "arn:aws:s3:::bucket/${aws:username}/${ssm:/copilot/db}\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -161,3 +192,57 @@ network:
 		})
 	}
 }
+
+func TestInterpolator_Interpolate_WithResolver(t *testing.T) {
+	testCases := map[string]struct {
+		inputStr string
+
+		wanted    string
+		wantedErr error
+	}{
+		"should substitute the references with their resolved values": {
+			inputStr: `variables:
+  DB_HOST: ${ssm:/copilot/${COPILOT_ENVIRONMENT_NAME}/db-host}
+  TABLE: ${cfn-export:shared-TableName}
+  SAME_TABLE: ${cfn-export:shared-TableName}
+  ESCAPED: \${ssm:/copilot/db-host}
+`,
+			wanted: `variables:
+  DB_HOST: ${ssm:/copilot/test/db-host}
+  TABLE: orders
+  SAME_TABLE: orders
+  ESCAPED: ${ssm:/copilot/db-host}
+`,
+		},
+		"should return error if a reference cannot be resolved": {
+			inputStr:  "${cfn-export:missing}",
+			wantedErr: errors.New(`resolve ${cfn-export:missing}: export "missing" does not exist`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var calls int
+			itpl := NewInterpolator("myApp", "test",
+				WithResolver(ReferencePrefixCFNExport, ResolverFunc(func(name string) (string, error) {
+					calls++
+					if name != "shared-TableName" {
+						return "", fmt.Errorf("export %q does not exist", name)
+					}
+					return "orders", nil
+				})))
+
+			// WHEN
+			actual, err := itpl.Interpolate(tc.inputStr)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, actual)
+			require.Equal(t, 1, calls, "references should be resolved once")
+		})
+	}
+}
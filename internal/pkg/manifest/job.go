@@ -170,6 +170,12 @@ func (s *ScheduledJob) ContainerDependencies() map[string]ContainerDependency {
 	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.Logging, s.Sidecars)
 }
 
+// ContainerConfigs returns a map of ContainerConfig objects for the ScheduledJob
+// including its main container and additional sidecars.
+func (s *ScheduledJob) ContainerConfigs() map[string]ContainerConfig {
+	return containerConfigs(aws.StringValue(s.Name), s.ImageConfig.Image, nil, s.ImageConfig.HealthCheck, s.TaskConfig, s.Sidecars)
+}
+
 // newDefaultScheduledJob returns an empty ScheduledJob with only the default values set.
 func newDefaultScheduledJob() *ScheduledJob {
 	return &ScheduledJob{
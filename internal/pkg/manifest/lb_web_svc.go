@@ -225,6 +225,12 @@ func (s *LoadBalancedWebService) ContainerDependencies() map[string]ContainerDep
 	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.Logging, s.Sidecars)
 }
 
+// ContainerConfigs returns a map of ContainerConfig objects for the LoadBalancedWebService
+// including its main container and additional sidecars.
+func (s *LoadBalancedWebService) ContainerConfigs() map[string]ContainerConfig {
+	return containerConfigs(aws.StringValue(s.Name), s.ImageConfig.Image, s.ImageConfig.Port, s.ImageConfig.HealthCheck, s.TaskConfig, s.Sidecars)
+}
+
 func (s *LoadBalancedWebService) subnets() *SubnetListOrArgs {
 	return &s.Network.VPC.Placement.Subnets
 }
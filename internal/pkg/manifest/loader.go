@@ -4,6 +4,8 @@
 package manifest
 
 import (
+	"errors"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 )
@@ -65,6 +67,29 @@ func (s *DynamicWorkloadManifest) Load(sess *session.Session) error {
 	return loadAll(loaders)
 }
 
+// LoadOffline populates all fields in the manifest without AWS access.
+// Subnets selected by tags are resolved to the given subnet IDs instead of being looked up.
+func (s *DynamicWorkloadManifest) LoadOffline(subnetIDs []string) error {
+	loaders := []loader{
+		&dynamicSubnets{
+			cfg:    s.mft.subnets(),
+			client: staticSubnetIDs(subnetIDs),
+		},
+	}
+	return loadAll(loaders)
+}
+
+// staticSubnetIDs returns the same subnet IDs for any filter.
+type staticSubnetIDs []string
+
+// SubnetIDs returns the subnet IDs regardless of the filters.
+func (ids staticSubnetIDs) SubnetIDs(_ ...ec2.Filter) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, errors.New("no subnet IDs provided to resolve subnets selected by tags")
+	}
+	return ids, nil
+}
+
 func loadAll(loaders []loader) error {
 	for _, loader := range loaders {
 		if err := loader.load(); err != nil {
@@ -90,3 +90,44 @@ func TestDynamicWorkloadManifest_Load(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicWorkloadManifest_LoadOffline(t *testing.T) {
+	testCases := map[string]struct {
+		inMft       workloadManifest
+		inSubnetIDs []string
+
+		wantedSubnetIDs []string
+		wantedError     error
+	}{
+		"error if subnets are selected by tags without subnet IDs": {
+			inMft: newMockMftWithTags(),
+
+			wantedError: errors.New("get subnet IDs: no subnet IDs provided to resolve subnets selected by tags"),
+		},
+		"success with subnet IDs for tags": {
+			inMft:       newMockMftWithTags(),
+			inSubnetIDs: []string{"id1", "id2"},
+
+			wantedSubnetIDs: []string{"id1", "id2"},
+		},
+		"success with no subnets": {
+			inMft: newDefaultBackendService(),
+
+			wantedSubnetIDs: []string{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dyn := &DynamicWorkloadManifest{
+				mft: tc.inMft,
+			}
+			err := dyn.LoadOffline(tc.inSubnetIDs)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.ElementsMatch(t, tc.wantedSubnetIDs, dyn.mft.subnets().IDs)
+			}
+		})
+	}
+}
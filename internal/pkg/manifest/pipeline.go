@@ -4,8 +4,10 @@
 package manifest
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/fatih/structs"
@@ -18,6 +20,10 @@ const (
 	GithubV1ProviderName   = "GitHubV1"
 	CodeCommitProviderName = "CodeCommit"
 	BitbucketProviderName  = "Bitbucket"
+	GitLabProviderName     = "GitLab"
+
+	GitHubEnterpriseServerProviderName = "GitHubEnterpriseServer"
+	GitLabSelfManagedProviderName      = "GitLabSelfManaged"
 )
 
 const pipelineManifestPath = "cicd/pipeline.yml"
@@ -27,6 +33,7 @@ var PipelineProviders = []string{
 	GithubProviderName,
 	CodeCommitProviderName,
 	BitbucketProviderName,
+	GitLabProviderName,
 }
 
 // Provider defines a source of the artifacts
@@ -93,6 +100,48 @@ func (p *bitbucketProvider) Properties() map[string]interface{} {
 	return structs.Map(p.properties)
 }
 
+type gitlabProvider struct {
+	properties *GitLabProperties
+}
+
+func (p *gitlabProvider) Name() string {
+	return GitLabProviderName
+}
+func (p *gitlabProvider) String() string {
+	return GitLabProviderName
+}
+func (p *gitlabProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+type githubEnterpriseServerProvider struct {
+	properties *GitHubEnterpriseServerProperties
+}
+
+func (p *githubEnterpriseServerProvider) Name() string {
+	return GitHubEnterpriseServerProviderName
+}
+func (p *githubEnterpriseServerProvider) String() string {
+	return "GitHub Enterprise Server"
+}
+func (p *githubEnterpriseServerProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+type gitlabSelfManagedProvider struct {
+	properties *GitLabSelfManagedProperties
+}
+
+func (p *gitlabSelfManagedProvider) Name() string {
+	return GitLabSelfManagedProviderName
+}
+func (p *gitlabSelfManagedProvider) String() string {
+	return "GitLab self-managed"
+}
+func (p *gitlabSelfManagedProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
 // GitHubV1Properties contain information for configuring a Githubv1
 // source provider.
 type GitHubV1Properties struct {
@@ -117,6 +166,29 @@ type BitbucketProperties struct {
 	Branch        string `structs:"branch" yaml:"branch"`
 }
 
+// GitLabProperties contains information for configuring a GitLab
+// source provider.
+type GitLabProperties struct {
+	RepositoryURL string `structs:"repository" yaml:"repository"`
+	Branch        string `structs:"branch" yaml:"branch"`
+}
+
+// GitHubEnterpriseServerProperties contains information for configuring a GitHub Enterprise Server
+// source provider. The repository is reached through a CodeStar Connections host.
+type GitHubEnterpriseServerProperties struct {
+	RepositoryURL string `structs:"repository" yaml:"repository"`
+	Branch        string `structs:"branch" yaml:"branch"`
+	HostARN       string `structs:"host_arn" yaml:"host_arn"`
+}
+
+// GitLabSelfManagedProperties contains information for configuring a GitLab self-managed
+// source provider. The repository is reached through a CodeStar Connections host.
+type GitLabSelfManagedProperties struct {
+	RepositoryURL string `structs:"repository" yaml:"repository"`
+	Branch        string `structs:"branch" yaml:"branch"`
+	HostARN       string `structs:"host_arn" yaml:"host_arn"`
+}
+
 // CodeCommitProperties contains information for configuring a CodeCommit
 // source provider.
 type CodeCommitProperties struct {
@@ -144,6 +216,18 @@ func NewProvider(configs interface{}) (Provider, error) {
 		return &bitbucketProvider{
 			properties: props,
 		}, nil
+	case *GitLabProperties:
+		return &gitlabProvider{
+			properties: props,
+		}, nil
+	case *GitHubEnterpriseServerProperties:
+		return &githubEnterpriseServerProvider{
+			properties: props,
+		}, nil
+	case *GitLabSelfManagedProperties:
+		return &gitlabSelfManagedProvider{
+			properties: props,
+		}, nil
 	default:
 		return nil, &ErrUnknownProvider{unknownProviderProperties: props}
 	}
@@ -177,6 +261,64 @@ type Source struct {
 	Properties   map[string]interface{} `yaml:"properties"`
 }
 
+// Valid pull request events that can trigger a pipeline.
+const (
+	PullRequestEventOpen    = "open"
+	PullRequestEventUpdated = "updated"
+	PullRequestEventClosed  = "closed"
+)
+
+// PullRequestEvents is the list of all pull request events that can trigger a pipeline.
+var PullRequestEvents = []string{
+	PullRequestEventOpen,
+	PullRequestEventUpdated,
+	PullRequestEventClosed,
+}
+
+// PipelineTrigger holds the filters on the changes to the source repository that start the pipeline.
+type PipelineTrigger struct {
+	Paths        PipelineTriggerFilter `yaml:"paths"`
+	Tags         PipelineTriggerFilter `yaml:"tags"`
+	PullRequests *PullRequestTrigger   `yaml:"pull_requests"`
+}
+
+// PipelineTriggerFilter holds the glob patterns to include and exclude.
+type PipelineTriggerFilter struct {
+	Include []string `yaml:"include"`
+	Exclude []string `yaml:"exclude"`
+}
+
+// IsEmpty returns true if no patterns are configured.
+func (f PipelineTriggerFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// PullRequestTrigger holds the pull request events that start the pipeline.
+type PullRequestTrigger struct {
+	Events []string `yaml:"events"`
+}
+
+// Trigger returns the trigger configured under the "trigger" property of the source.
+// It returns nil if the pipeline is started on every push to the branch.
+func (s Source) Trigger() (*PipelineTrigger, error) {
+	v, ok := s.Properties["trigger"]
+	if !ok || v == nil {
+		return nil, nil
+	}
+	// Properties are a generic map, so round-trip the value to decode it into a typed struct.
+	raw, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf(`marshal "trigger": %w`, err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+	var trigger PipelineTrigger
+	if err := dec.Decode(&trigger); err != nil {
+		return nil, fmt.Errorf(`unmarshal "trigger": %w`, err)
+	}
+	return &trigger, nil
+}
+
 // Build defines the build project to build and test image.
 type Build struct {
 	Image            string `yaml:"image"`
@@ -194,6 +336,13 @@ type PipelineStage struct {
 	Deployments      Deployments        `yaml:"deployments,omitempty"`
 	PreDeployments   PrePostDeployments `yaml:"pre_deployments,omitempty"`
 	PostDeployments  PrePostDeployments `yaml:"post_deployments,omitempty"`
+	Bake             *PipelineBake      `yaml:"bake,omitempty"`
+}
+
+// PipelineBake is the time a stage waits after its deployments, while the rollback alarms
+// of its services stay OK, before the pipeline moves on to the next stage.
+type PipelineBake struct {
+	Duration *time.Duration `yaml:"duration"`
 }
 
 // Deployments represent a directed graph of cloudformation deployments.
@@ -276,6 +425,8 @@ func (s Source) IsCodeStarConnection() bool {
 		return true
 	case BitbucketProviderName:
 		return true
+	case GitLabProviderName, GitHubEnterpriseServerProviderName, GitLabSelfManagedProviderName:
+		return true
 	default:
 		return false
 	}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
@@ -39,6 +40,30 @@ func TestNewProvider(t *testing.T) {
 				Branch:        defaultCCBranch,
 			},
 		},
+		"successfully create GitLab provider": {
+			providerConfig: &GitLabProperties{
+				RepositoryURL: "https://gitlab.com/aws/group/amazon-ecs-cli-v2",
+				Branch:        defaultGHBranch,
+			},
+		},
+		"successfully create GitHub Enterprise Server provider": {
+			providerConfig: &GitHubEnterpriseServerProperties{
+				RepositoryURL: "https://github.example.com/aws/amazon-ecs-cli-v2",
+				Branch:        defaultGHBranch,
+				HostARN:       "arn:aws:codestar-connections:us-west-2:123456789012:host/ghes-1234",
+			},
+		},
+		"successfully create GitLab self-managed provider": {
+			providerConfig: &GitLabSelfManagedProperties{
+				RepositoryURL: "https://gitlab.example.com/aws/amazon-ecs-cli-v2",
+				Branch:        defaultGHBranch,
+				HostARN:       "arn:aws:codestar-connections:us-west-2:123456789012:host/gitlab-1234",
+			},
+		},
+		"error on unknown properties": {
+			providerConfig: struct{}{},
+			expectedErr:    &ErrUnknownProvider{unknownProviderProperties: struct{}{}},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -303,6 +328,43 @@ stages:
 				},
 			},
 		},
+		"valid pipeline.yml with bake": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: chicken
+      bake:
+        duration: 30m
+`,
+			expectedManifest: &Pipeline{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "chicken",
+						Bake: &PipelineBake{
+							Duration: durationp(30 * time.Minute),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -317,3 +379,88 @@ stages:
 		})
 	}
 }
+
+func TestSource_Trigger(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		expectedTrigger *PipelineTrigger
+		expectedErr     string
+	}{
+		"returns nil if there is no trigger": {
+			inContent: `
+name: pipepiper
+version: 1
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+`,
+		},
+		"returns the trigger filters": {
+			inContent: `
+name: pipepiper
+version: 1
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+    trigger:
+      paths:
+        include: ["frontend/**"]
+        exclude: ["**/*.md"]
+      tags:
+        include: ["v*"]
+      pull_requests:
+        events: [open, closed]
+`,
+			expectedTrigger: &PipelineTrigger{
+				Paths: PipelineTriggerFilter{
+					Include: []string{"frontend/**"},
+					Exclude: []string{"**/*.md"},
+				},
+				Tags: PipelineTriggerFilter{
+					Include: []string{"v*"},
+				},
+				PullRequests: &PullRequestTrigger{
+					Events: []string{"open", "closed"},
+				},
+			},
+		},
+		"returns an error on unknown fields": {
+			inContent: `
+name: pipepiper
+version: 1
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    trigger:
+      path:
+        include: ["frontend/**"]
+`,
+			expectedErr: `unmarshal "trigger": yaml: unmarshal errors:`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft, err := UnmarshalPipeline([]byte(tc.inContent))
+			require.NoError(t, err)
+
+			// WHEN
+			trigger, err := mft.Source.Trigger()
+
+			// THEN
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTrigger, trigger)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// Validation errors name the fields they are about, such as `validate "http": validate "additional_rules[0]": ...`.
+	validateErrFieldRegExp = regexp.MustCompile(`validate (?:[a-zA-Z ]+ )?"([^"]+)"`)
+	// YAML errors have the line they are about, such as "yaml: line 3: mapping values are not allowed in this context".
+	yamlErrLineRegExp = regexp.MustCompile(`line (\d+):`)
+	// Validation errors quote the other fields they are about, such as `"http" must be specified if "count.requests" is specified`.
+	quotedFieldRegExp = regexp.MustCompile(`"([a-z_]+(?:\[[^\]]+\])*(?:\.[a-z_]+(?:\[[^\]]+\])*)*)"`)
+)
+
+// Position is a position in a manifest file. Lines and columns start at 1.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// ErrorPosition returns the position in the manifest of the field that err is about, if err was returned
+// while unmarshaling or validating the manifest. The field is looked up in the overrides of the environment first.
+// If the field isn't in the manifest, the position of its closest parent is returned instead.
+// It returns false if the position of the error can't be found.
+func ErrorPosition(mft []byte, err error, envName string) (Position, bool) {
+	if match := yamlErrLineRegExp.FindStringSubmatch(err.Error()); match != nil {
+		line, _ := strconv.Atoi(match[1])
+		return Position{Line: line, Column: 1}, true
+	}
+	var doc yaml.Node
+	if yaml.Unmarshal(mft, &doc) != nil || len(doc.Content) == 0 {
+		return Position{}, false
+	}
+	var node *yaml.Node
+	var depth int
+	for _, path := range errorFieldPaths(err) {
+		if n, d := lookupField(doc.Content[0], path); d > depth {
+			node, depth = n, d
+		}
+		if envName == "" {
+			continue
+		}
+		envPath := append([]string{"environments", envName}, path...)
+		if n, d := lookupField(doc.Content[0], envPath); d > 2 && d-2 >= depth {
+			node, depth = n, d-2
+		}
+	}
+	if depth == 0 {
+		return Position{}, false
+	}
+	return Position{Line: node.Line, Column: node.Column}, true
+}
+
+// errWithManifestLines wraps an error returned while unmarshaling a document that was derived from a manifest,
+// such as the interpolated manifest, with the lines of its YAML errors mapped back to the manifest.
+type errWithManifestLines struct {
+	err error
+	msg string
+}
+
+// Error returns the message of the wrapped error with the lines of the manifest.
+func (e *errWithManifestLines) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the wrapped error.
+func (e *errWithManifestLines) Unwrap() error {
+	return e.err
+}
+
+// WithManifestLines returns err with the lines of its YAML errors, which refer to the decoded document,
+// replaced by the lines of the same nodes in the manifest. Lines are matched by the path of their node,
+// since the decoded document can drop the blank lines of the manifest. Lines that can't be matched are left as they are.
+func WithManifestLines(mft, decoded []byte, err error) error {
+	if err == nil || !yamlErrLineRegExp.MatchString(err.Error()) {
+		return err
+	}
+	var mftDoc, decodedDoc yaml.Node
+	if yaml.Unmarshal(mft, &mftDoc) != nil || len(mftDoc.Content) == 0 {
+		return err
+	}
+	if yaml.Unmarshal(decoded, &decodedDoc) != nil || len(decodedDoc.Content) == 0 {
+		return err
+	}
+	msg := yamlErrLineRegExp.ReplaceAllStringFunc(err.Error(), func(match string) string {
+		line, _ := strconv.Atoi(yamlErrLineRegExp.FindStringSubmatch(match)[1])
+		path, ok := pathToLine(decodedDoc.Content[0], line)
+		if !ok {
+			return match
+		}
+		node, depth := lookupField(mftDoc.Content[0], path)
+		if depth != len(path) {
+			return match
+		}
+		return fmt.Sprintf("line %d:", node.Line)
+	})
+	return &errWithManifestLines{err: err, msg: msg}
+}
+
+// pathToLine returns the path to the first node at the line under the root node, such as ["sidecars", "nginx", "port"].
+func pathToLine(root *yaml.Node, line int) ([]string, bool) {
+	if root.Line == line {
+		return nil, true
+	}
+	switch root.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(root.Content); i += 2 {
+			key, value := root.Content[i], root.Content[i+1]
+			if key.Line == line {
+				return []string{key.Value}, true
+			}
+			if path, ok := pathToLine(value, line); ok {
+				return append([]string{key.Value}, path...), true
+			}
+		}
+	case yaml.SequenceNode:
+		for i, elem := range root.Content {
+			if path, ok := pathToLine(elem, line); ok {
+				return append([]string{strconv.Itoa(i)}, path...), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// errorFieldPaths returns the candidate paths to the field that a validation error is about, such as ["http", "additional_rules", "0"].
+// The first path is made of the fields that are validated. The other ones append the fields named by the error message to it,
+// such as ["count", "requests"] for `"http" must be specified if "count.requests" is specified`.
+func errorFieldPaths(err error) [][]string {
+	msg := err.Error()
+	var path []string
+	for _, match := range validateErrFieldRegExp.FindAllStringSubmatch(msg, -1) {
+		// Some fields are described rather than named, such as "http config" for "http".
+		name, _, _ := strings.Cut(match[1], " ")
+		path = append(path, splitFieldPath(name)...)
+	}
+	paths := [][]string{path}
+	if matches := validateErrFieldRegExp.FindAllStringIndex(msg, -1); len(matches) > 0 {
+		msg = msg[matches[len(matches)-1][1]:]
+	}
+	for _, match := range quotedFieldRegExp.FindAllStringSubmatch(msg, -1) {
+		paths = append(paths, append(slices.Clone(path), splitFieldPath(match[1])...))
+	}
+	return paths
+}
+
+// splitFieldPath splits a field path such as "sidecars[nginx].port" into ["sidecars", "nginx", "port"].
+func splitFieldPath(fieldPath string) []string {
+	var path []string
+	for _, field := range strings.Split(fieldPath, ".") {
+		// Keys and indexes are in brackets, such as "sidecars[nginx]" or "taskdef_overrides[0]".
+		name, rest, _ := strings.Cut(field, "[")
+		path = append(path, name)
+		for rest != "" {
+			var key string
+			key, rest, _ = strings.Cut(rest, "]")
+			path = append(path, key)
+			rest = strings.TrimPrefix(rest, "[")
+		}
+	}
+	return path
+}
+
+// lookupField returns the deepest node of the path under the root node, and the number of path elements that were found.
+// Mapping values are returned as the node of their key.
+func lookupField(root *yaml.Node, path []string) (*yaml.Node, int) {
+	curr, found := root, root
+	for depth, elem := range path {
+		switch curr.Kind {
+		case yaml.MappingNode:
+			var next *yaml.Node
+			for i := 0; i+1 < len(curr.Content); i += 2 {
+				if curr.Content[i].Value == elem {
+					found, next = curr.Content[i], curr.Content[i+1]
+					break
+				}
+			}
+			if next == nil {
+				return found, depth
+			}
+			curr = next
+		case yaml.SequenceNode:
+			idx, err := strconv.Atoi(elem)
+			if err != nil || idx < 0 || idx >= len(curr.Content) {
+				return found, depth
+			}
+			curr = curr.Content[idx]
+			found = curr
+		default:
+			return found, depth
+		}
+	}
+	return found, len(path)
+}
@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorPosition(t *testing.T) {
+	const mft = `name: frontend
+type: Load Balanced Web Service
+http:
+  path: /
+  additional_rules:
+    - path: /admin
+    - path: /api
+      healthcheck: /health
+sidecars:
+  nginx:
+    port: 80
+environments:
+  test:
+    http:
+      additional_rules:
+        - path: /admin
+        - path: /v2/api
+`
+	testCases := map[string]struct {
+		inManifest string
+		inErr      error
+		inEnv      string
+
+		wantedPosition Position
+		wantedFound    bool
+	}{
+		"line of a YAML error": {
+			inManifest:     mft,
+			inErr:          errors.New("unmarshal to workload manifest: yaml: line 4: mapping values are not allowed in this context"),
+			wantedPosition: Position{Line: 4, Column: 1},
+			wantedFound:    true,
+		},
+		"field in a list": {
+			inManifest:     mft,
+			inErr:          errors.New(`validate "http": validate "additional_rules[1]": validate "healthcheck": "path" must start with "/"`),
+			wantedPosition: Position{Line: 8, Column: 7},
+			wantedFound:    true,
+		},
+		"field in a map": {
+			inManifest:     mft,
+			inErr:          errors.New(`validate "sidecars[nginx]": validate "port": invalid port`),
+			wantedPosition: Position{Line: 11, Column: 5},
+			wantedFound:    true,
+		},
+		"field in the overrides of the environment": {
+			inManifest:     mft,
+			inErr:          errors.New(`validate load balancer target for "http.additional_rules[1]": invalid target`),
+			inEnv:          "test",
+			wantedPosition: Position{Line: 17, Column: 11},
+			wantedFound:    true,
+		},
+		"field that isn't overridden by the environment": {
+			inManifest:     mft,
+			inErr:          errors.New(`validate "sidecars[nginx]": invalid sidecar`),
+			inEnv:          "test",
+			wantedPosition: Position{Line: 10, Column: 3},
+			wantedFound:    true,
+		},
+		"closest parent of a field that isn't in the manifest": {
+			inManifest:     mft,
+			inErr:          errors.New(`validate "http": validate "alias": "alias" must be specified if "redirect_to_https" is set`),
+			wantedPosition: Position{Line: 3, Column: 1},
+			wantedFound:    true,
+		},
+		"field named by the error message": {
+			inManifest:     mft,
+			inErr:          errors.New(`validate "http": "path" must be specified if "additional_rules[1].healthcheck" is set`),
+			wantedPosition: Position{Line: 8, Column: 7},
+			wantedFound:    true,
+		},
+		"field described by the error": {
+			inManifest:     mft,
+			inErr:          errors.New(`validate "http config": validate "additional_rules[0]": invalid rule`),
+			wantedPosition: Position{Line: 6, Column: 7},
+			wantedFound:    true,
+		},
+		"error that isn't about a field": {
+			inManifest: mft,
+			inErr:      errors.New("validate Windows: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			pos, found := ErrorPosition([]byte(tc.inManifest), tc.inErr, tc.inEnv)
+
+			// THEN
+			require.Equal(t, tc.wantedFound, found)
+			require.Equal(t, tc.wantedPosition, pos)
+		})
+	}
+}
+
+func TestWithManifestLines(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+		inDecoded  string
+		inErr      error
+
+		wantedError error
+	}{
+		"no error": {},
+		"error without lines": {
+			inManifest:  "name: frontend\n",
+			inDecoded:   "name: frontend\n",
+			inErr:       errors.New("some error"),
+			wantedError: errors.New("some error"),
+		},
+		"lines of the fields in the manifest with blank lines and comments": {
+			inManifest: `# The frontend service.
+name: frontend
+type: Load Balanced Web Service
+
+image:
+  # Built by the pipeline.
+  location: nginx
+
+http:
+  path: /
+
+  additional_rules:
+    # The admin console.
+    - path: /admin
+
+      target_port: abc
+
+# Resources of the task.
+cpu: abc
+memory: 512
+`,
+			inDecoded: `# The frontend service.
+name: frontend
+type: Load Balanced Web Service
+image:
+  # Built by the pipeline.
+  location: nginx
+http:
+  path: /
+  additional_rules:
+    # The admin console.
+    - path: /admin
+      target_port: abc
+# Resources of the task.
+cpu: abc
+memory: 512
+`,
+			inErr:       errors.New("unmarshal manifest for Load Balanced Web Service: yaml: unmarshal errors:\n  line 12: cannot unmarshal !!str `abc` into uint16\n  line 14: cannot unmarshal !!str `abc` into int"),
+			wantedError: errors.New("unmarshal manifest for Load Balanced Web Service: yaml: unmarshal errors:\n  line 16: cannot unmarshal !!str `abc` into uint16\n  line 19: cannot unmarshal !!str `abc` into int"),
+		},
+		"line that isn't in the decoded document": {
+			inManifest:  "name: frontend\n\ncpu: abc\n",
+			inDecoded:   "name: frontend\ncpu: abc\n",
+			inErr:       errors.New("yaml: unmarshal errors:\n  line 5: cannot unmarshal !!str `abc` into int"),
+			wantedError: errors.New("yaml: unmarshal errors:\n  line 5: cannot unmarshal !!str `abc` into int"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			err := WithManifestLines([]byte(tc.inManifest), []byte(tc.inDecoded), tc.inErr)
+
+			// THEN
+			if tc.wantedError == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.wantedError.Error())
+			require.ErrorIs(t, err, tc.inErr)
+		})
+	}
+}
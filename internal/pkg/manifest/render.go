@@ -0,0 +1,274 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const environmentsKey = "environments"
+
+var yamlMarshalerType = reflect.TypeOf((*yaml.Marshaler)(nil)).Elem()
+
+// RenderEnv returns the workload manifest as it is deployed to the environment: with the defaults of its type,
+// the overrides of the environment applied by ApplyEnv, and without the "environments" field.
+// Fields that aren't set are omitted, so that the result can be compared across environments.
+func RenderEnv(in []byte, envName string) ([]byte, error) {
+	mft, err := UnmarshalWorkload(in)
+	if err != nil {
+		return nil, err
+	}
+	envMft, err := mft.ApplyEnv(envName)
+	if err != nil {
+		return nil, err
+	}
+	root, err := renderNode(reflect.ValueOf(envMft.Manifest()))
+	if err != nil {
+		return nil, fmt.Errorf("render manifest for environment %s: %w", envName, err)
+	}
+	if root == nil {
+		return nil, nil
+	}
+	removeMappingKey(root, environmentsKey) // Set if the environment has no overrides.
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(root); err != nil {
+		return nil, fmt.Errorf("marshal manifest for environment %s: %w", envName, err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("marshal manifest for environment %s: %w", envName, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// renderNode returns the YAML node of v as it is written in a manifest, or nil if v isn't set.
+// Types that can be unmarshaled from alternative forms, like a bool or a mapping, are rendered in the form that is set.
+func renderNode(v reflect.Value) (*yaml.Node, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return renderNode(v.Elem())
+	}
+	if v.CanInterface() && v.Type().Implements(yamlMarshalerType) {
+		out, err := v.Interface().(yaml.Marshaler).MarshalYAML()
+		if err != nil {
+			return nil, err
+		}
+		return renderNode(reflect.ValueOf(out))
+	}
+	switch v.Type() {
+	case durationType:
+		return scalarNode(time.Duration(v.Int()).String())
+	case yamlNodeType:
+		node := v.Interface().(yaml.Node)
+		if node.IsZero() {
+			return nil, nil
+		}
+		return &node, nil
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if isUnionStruct(v.Type()) {
+			return renderUnion(v)
+		}
+		return renderStruct(v)
+	case reflect.Map:
+		return renderMap(v)
+	case reflect.Slice, reflect.Array:
+		return renderSlice(v)
+	case reflect.String:
+		if v.String() == "" {
+			return nil, nil
+		}
+		return scalarNode(v.String())
+	case reflect.Bool:
+		if !v.Bool() {
+			return nil, nil
+		}
+		return scalarNode(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() == 0 {
+			return nil, nil
+		}
+		return scalarNode(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() == 0 {
+			return nil, nil
+		}
+		return scalarNode(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		if v.Float() == 0 {
+			return nil, nil
+		}
+		return scalarNode(v.Float())
+	}
+	return nil, fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// renderPointee returns the node of a value that is set through a pointer, in which case its zero value is rendered,
+// like "false" for a *bool.
+func renderPointee(v reflect.Value) (*yaml.Node, error) {
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return renderNode(v)
+	}
+	elem := v.Elem()
+	switch elem.Kind() {
+	case reflect.String:
+		return scalarNode(elem.String())
+	case reflect.Bool:
+		return scalarNode(elem.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if elem.Type() == durationType {
+			return scalarNode(time.Duration(elem.Int()).String())
+		}
+		return scalarNode(elem.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return scalarNode(elem.Uint())
+	case reflect.Float32, reflect.Float64:
+		return scalarNode(elem.Float())
+	}
+	return renderNode(elem)
+}
+
+// renderUnion returns the node of the first form of the union struct that is set, such as the bool of HTTPOrBool.
+func renderUnion(v reflect.Value) (*yaml.Node, error) {
+	for _, field := range unionFields(v.Type()) {
+		node, err := renderPointee(v.FieldByIndex(field.Index))
+		if err != nil {
+			return nil, err
+		}
+		if node != nil {
+			return node, nil
+		}
+	}
+	return nil, nil
+}
+
+func renderStruct(v reflect.Value) (*yaml.Node, error) {
+	mapping := &yaml.Node{Kind: yaml.MappingNode}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+		node, err := renderPointee(v.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field.Name, err)
+		}
+		if node == nil {
+			continue
+		}
+		inline := strings.Contains(opts, "inline") || (field.Anonymous && name == "")
+		if inline && node.Kind == yaml.MappingNode {
+			mapping.Content = append(mapping.Content, node.Content...)
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		key, err := scalarNode(name)
+		if err != nil {
+			return nil, err
+		}
+		mapping.Content = append(mapping.Content, key, node)
+	}
+	if len(mapping.Content) == 0 {
+		return nil, nil
+	}
+	return mapping, nil
+}
+
+func renderMap(v reflect.Value) (*yaml.Node, error) {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	mapping := &yaml.Node{Kind: yaml.MappingNode}
+	for _, k := range keys {
+		node, err := renderPointee(v.MapIndex(k))
+		if err != nil {
+			return nil, err
+		}
+		if node == nil {
+			continue
+		}
+		key, err := scalarNode(k.Interface())
+		if err != nil {
+			return nil, err
+		}
+		mapping.Content = append(mapping.Content, key, node)
+	}
+	if len(mapping.Content) == 0 {
+		return nil, nil
+	}
+	return mapping, nil
+}
+
+func renderSlice(v reflect.Value) (*yaml.Node, error) {
+	if v.Len() == 0 {
+		return nil, nil
+	}
+	seq := &yaml.Node{Kind: yaml.SequenceNode}
+	for i := 0; i < v.Len(); i++ {
+		node, err := renderPointee(v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		if node == nil {
+			node = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+		}
+		seq.Content = append(seq.Content, node)
+	}
+	return seq, nil
+}
+
+func scalarNode(v interface{}) (*yaml.Node, error) {
+	var node yaml.Node
+	if err := node.Encode(v); err != nil {
+		return nil, err
+	}
+	return &node, nil
+}
+
+// removeMappingKey removes the key and its value from the mapping.
+func removeMappingKey(node *yaml.Node, key string) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content = append(node.Content[:i], node.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+// mappingValue returns the value of the key in the mapping, or nil if there is none.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
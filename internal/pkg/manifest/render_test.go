@@ -0,0 +1,195 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderEnv(t *testing.T) {
+	testCases := map[string]struct {
+		in      string
+		envName string
+
+		wanted      string
+		wantedError error
+	}{
+		"apply the overrides of the environment": {
+			in: `name: api
+type: Load Balanced Web Service
+image:
+  build: Dockerfile
+  port: 80
+http:
+  path: '/'
+count: 1
+variables:
+  LOG_LEVEL: debug
+  REGION: us-west-2
+secrets:
+  DB_PASSWORD: /db/password
+environments:
+  test:
+    count: 2
+  prod:
+    count:
+      range: 1-10
+      cpu_percentage: 70
+    variables:
+      LOG_LEVEL: info
+    secrets:
+      API_KEY:
+        secretsmanager: api-key
+`,
+			envName: "prod",
+			wanted: `name: api
+type: Load Balanced Web Service
+image:
+  build: Dockerfile
+  port: 80
+http:
+  path: /
+cpu: 256
+memory: 512
+count:
+  range: 1-10
+  cpu_percentage: 70
+exec: false
+variables:
+  LOG_LEVEL: info
+  REGION: us-west-2
+secrets:
+  API_KEY:
+    secretsmanager: api-key
+  DB_PASSWORD: /db/password
+network:
+  vpc:
+    placement: public
+`,
+		},
+		"render the basic form of a field overridden by the environment": {
+			in: `name: api
+type: Load Balanced Web Service
+image:
+  build: Dockerfile
+  port: 80
+http:
+  path: '/'
+  alias: example.com
+count:
+  range: 1-10
+environments:
+  test:
+    http: false
+    nlb:
+      port: 80/tcp
+    count: 1
+`,
+			envName: "test",
+			wanted: `name: api
+type: Load Balanced Web Service
+image:
+  build: Dockerfile
+  port: 80
+http: false
+cpu: 256
+memory: 512
+count: 1
+exec: false
+network:
+  vpc:
+    placement: public
+nlb:
+  port: 80/tcp
+`,
+		},
+		"merge the sidecars and replace the slices of the environment": {
+			in: `name: api
+type: Backend Service
+image:
+  location: api:latest
+command: [run, --verbose]
+sidecars:
+  nginx:
+    image: nginx:1.25
+    variables:
+      LOG_LEVEL: debug
+environments:
+  test:
+    command: run
+    sidecars:
+      nginx:
+        image: nginx:latest
+      xray:
+        image: xray:latest
+`,
+			envName: "test",
+			wanted: `name: api
+type: Backend Service
+image:
+  location: api:latest
+command: run
+cpu: 256
+memory: 512
+count: 1
+exec: false
+sidecars:
+  nginx:
+    image: nginx:latest
+    variables:
+      LOG_LEVEL: debug
+  xray:
+    image: xray:latest
+network:
+  vpc:
+    placement: public
+`,
+		},
+		"drop the overrides of other environments": {
+			in: `name: api
+type: Backend Service
+image:
+  location: api:latest
+environments:
+  prod:
+    count: 3
+`,
+			envName: "test",
+			wanted: `name: api
+type: Backend Service
+image:
+  location: api:latest
+cpu: 256
+memory: 512
+count: 1
+exec: false
+network:
+  vpc:
+    placement: public
+`,
+		},
+		"error if the manifest is not a workload manifest": {
+			in:          `- name: api`,
+			envName:     "test",
+			wantedError: errors.New("unmarshal to workload manifest: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!seq into manifest.Workload"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := RenderEnv([]byte(tc.in), tc.envName)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"gopkg.in/yaml.v3"
+)
+
+// PipelineManifestType is the type of pipeline manifests in JSON schemas. Pipeline manifests don't have a "type" field.
+const PipelineManifestType = "Pipeline"
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+var (
+	yamlUnmarshalerType = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	yamlNodeType        = reflect.TypeOf(yaml.Node{})
+	durationType        = reflect.TypeOf(time.Duration(0))
+
+	// Matches the package paths in the names of generic types, such as "github.com/aws/copilot-cli/internal/pkg/manifest.".
+	schemaDefPkgPathRegExp = regexp.MustCompile(`[\w./-]*[./]`)
+)
+
+// SchemaTypes returns the manifest types that have a JSON schema.
+func SchemaTypes() []string {
+	return append(manifestinfo.WorkloadTypes(), Environmentmanifestinfo, PipelineManifestType)
+}
+
+// JSONSchema returns the JSON schema of the manifests of the given type, such as "Load Balanced Web Service",
+// "Environment" or "Pipeline", so that editors can complete and validate manifests.
+//
+// The schema is generated from the manifest structs the way they are decoded from YAML:
+// types with a custom unmarshaler and no YAML fields, such as Union or StringSliceOrString,
+// accept any of the forms held by their fields.
+func JSONSchema(mftType string) ([]byte, error) {
+	root, err := newJSONSchema(mftType)
+	if err != nil {
+		return nil, err
+	}
+	root.Schema = jsonSchemaDraft
+	root.Title = fmt.Sprintf("Copilot %s manifest", mftType)
+	out, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal JSON schema of %s manifest: %w", mftType, err)
+	}
+	return out, nil
+}
+
+// FieldDoc describes a field of a manifest.
+type FieldDoc struct {
+	Path []string // Names of the fields from the root of the manifest, without map keys and list indexes, such as ["variables", "from_cfn"].
+	Type string   // Type of the field as written in the documentation, such as "String or Map".
+}
+
+// DescribeField returns the description of the field at path in manifests of the given type.
+// The path holds map keys and list indexes as well, such as ["sidecars", "nginx", "port"].
+// It returns false if the field doesn't exist.
+func DescribeField(mftType string, path []string) (FieldDoc, bool) {
+	root, err := newJSONSchema(mftType)
+	if err != nil {
+		return FieldDoc{}, false
+	}
+	field, names, ok := root.lookup(root.Defs, path)
+	if !ok {
+		return FieldDoc{}, false
+	}
+	return FieldDoc{
+		Path: names,
+		Type: field.describe(root.Defs),
+	}, true
+}
+
+func newJSONSchema(mftType string) (*jsonSchema, error) {
+	var mft any
+	switch mftType {
+	case manifestinfo.LoadBalancedWebServiceType:
+		mft = LoadBalancedWebService{}
+	case manifestinfo.RequestDrivenWebServiceType:
+		mft = RequestDrivenWebService{}
+	case manifestinfo.BackendServiceType:
+		mft = BackendService{}
+	case manifestinfo.WorkerServiceType:
+		mft = WorkerService{}
+	case manifestinfo.StaticSiteType:
+		mft = StaticSite{}
+	case manifestinfo.ScheduledJobType:
+		mft = ScheduledJob{}
+	case Environmentmanifestinfo:
+		mft = Environment{}
+	case PipelineManifestType:
+		mft = Pipeline{}
+	default:
+		return nil, &ErrInvalidWorkloadType{Type: mftType}
+	}
+	g := &schemaGenerator{
+		defs:  make(map[string]*jsonSchema),
+		names: make(map[reflect.Type]string),
+	}
+	root := g.object(reflect.TypeOf(mft))
+	if typ, ok := root.Properties["type"]; ok && mftType != PipelineManifestType {
+		typ.Type, typ.Const = "string", mftType
+	}
+	root.Defs = g.defs
+	return root, nil
+}
+
+// jsonSchema is the subset of JSON schema keywords used to describe manifests.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 any                    `json:"type,omitempty"` // Either a string or a list of strings.
+	Const                string                 `json:"const,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	AdditionalProperties any                    `json:"additionalProperties,omitempty"` // Either false or a *jsonSchema.
+	Items                *jsonSchema            `json:"items,omitempty"`
+	AnyOf                []*jsonSchema          `json:"anyOf,omitempty"`
+	Defs                 map[string]*jsonSchema `json:"$defs,omitempty"`
+}
+
+// lookup returns the schema of the field at path, and the names of the fields on the way.
+func (s *jsonSchema) lookup(defs map[string]*jsonSchema, path []string) (*jsonSchema, []string, bool) {
+	s = s.resolve(defs)
+	if len(path) == 0 {
+		return s, nil, true
+	}
+	for _, form := range s.AnyOf {
+		if field, names, ok := form.lookup(defs, path); ok {
+			return field, names, true
+		}
+	}
+	if prop, ok := s.Properties[path[0]]; ok {
+		field, names, ok := prop.lookup(defs, path[1:])
+		return field, append([]string{path[0]}, names...), ok
+	}
+	if elem, ok := s.AdditionalProperties.(*jsonSchema); ok {
+		return elem.lookup(defs, path[1:])
+	}
+	if s.Items != nil {
+		return s.Items.lookup(defs, path[1:])
+	}
+	return nil, nil, false
+}
+
+// describe returns the type of the schema as written in the documentation, such as "Array of Strings".
+func (s *jsonSchema) describe(defs map[string]*jsonSchema) string {
+	s = s.resolve(defs)
+	if len(s.AnyOf) > 0 {
+		var forms []string
+		for _, form := range s.AnyOf {
+			if desc := form.describe(defs); !slices.Contains(forms, desc) {
+				forms = append(forms, desc)
+			}
+		}
+		return strings.Join(forms, " or ")
+	}
+	typ := s.Type
+	if types, ok := typ.([]string); ok {
+		// Strings accept any scalar.
+		typ = types[0]
+	}
+	switch typ {
+	case "string":
+		return "String"
+	case "integer":
+		return "Integer"
+	case "number":
+		return "Float"
+	case "boolean":
+		return "Boolean"
+	case "object":
+		return "Map"
+	case "array":
+		forms := strings.Split(s.Items.describe(defs), " or ")
+		for i := range forms {
+			forms[i] += "s"
+		}
+		return "Array of " + strings.Join(forms, " or ")
+	}
+	return "Any"
+}
+
+// resolve returns the definition that s refers to, or s if it's not a reference.
+func (s *jsonSchema) resolve(defs map[string]*jsonSchema) *jsonSchema {
+	for s.Ref != "" {
+		s = defs[strings.TrimPrefix(s.Ref, "#/$defs/")]
+	}
+	return s
+}
+
+type schemaGenerator struct {
+	defs  map[string]*jsonSchema  // Schemas of the struct types, by definition name.
+	names map[reflect.Type]string // Definition names of the struct types.
+}
+
+// schema returns the schema of values of type t.
+func (g *schemaGenerator) schema(t reflect.Type) *jsonSchema {
+	if t == durationType {
+		return &jsonSchema{Type: "string"}
+	}
+	if t == yamlNodeType {
+		return &jsonSchema{}
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.schema(t.Elem())
+	case reflect.String:
+		// Any scalar can be decoded into a string.
+		return &jsonSchema{Type: []string{"string", "number", "boolean"}}
+	case reflect.Bool:
+		return &jsonSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &jsonSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &jsonSchema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &jsonSchema{Type: "array", Items: g.schema(t.Elem())}
+	case reflect.Map:
+		return &jsonSchema{Type: "object", AdditionalProperties: g.schema(t.Elem())}
+	case reflect.Struct:
+		return g.ref(t)
+	}
+	// Interfaces accept any value.
+	return &jsonSchema{}
+}
+
+// ref returns a reference to the definition of the struct type t, and adds the definition if it doesn't exist yet.
+func (g *schemaGenerator) ref(t reflect.Type) *jsonSchema {
+	name, ok := g.names[t]
+	if !ok {
+		name = g.defName(t)
+		g.names[t] = name
+		g.defs[name] = nil // Reserve the name in case t is recursive.
+		if isUnionStruct(t) {
+			g.defs[name] = g.union(t)
+		} else {
+			g.defs[name] = g.object(t)
+		}
+	}
+	return &jsonSchema{Ref: "#/$defs/" + name}
+}
+
+// defName returns a unique definition name for the struct type t, such as "Union_string_AdvancedAlias".
+func (g *schemaGenerator) defName(t reflect.Type) string {
+	name := schemaDefPkgPathRegExp.ReplaceAllString(t.Name(), "")
+	name = strings.NewReplacer("[]", "ArrayOf", "[", "_", ",", "_", "]", "").Replace(name)
+	unique := name
+	for i := 2; ; i++ {
+		if _, ok := g.defs[unique]; !ok {
+			return unique
+		}
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+}
+
+// object returns the schema of the struct type t decoded field by field.
+func (g *schemaGenerator) object(t reflect.Type) *jsonSchema {
+	s := &jsonSchema{
+		Type:                 "object",
+		Properties:           make(map[string]*jsonSchema),
+		AdditionalProperties: false,
+	}
+	g.addProperties(s, t)
+	return s
+}
+
+func (g *schemaGenerator) addProperties(s *jsonSchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		key, opts, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if key == "-" {
+			continue
+		}
+		if strings.Contains(opts, "inline") {
+			inlined := field.Type
+			if inlined.Kind() == reflect.Pointer {
+				inlined = inlined.Elem()
+			}
+			g.addProperties(s, inlined)
+			continue
+		}
+		if key == "" {
+			key = strings.ToLower(field.Name)
+		}
+		s.Properties[key] = g.schema(field.Type)
+	}
+}
+
+// union returns the schema of the struct type t that accepts any of the forms held by its fields.
+func (g *schemaGenerator) union(t reflect.Type) *jsonSchema {
+	var forms []*jsonSchema
+	for _, field := range unionFields(t) {
+		forms = append(forms, g.schema(field.Type))
+	}
+	if len(forms) == 1 {
+		return forms[0]
+	}
+	return &jsonSchema{AnyOf: forms}
+}
+
+// isUnionStruct returns true if the struct type t is decoded by a custom unmarshaler into one of its fields,
+// instead of field by field.
+func isUnionStruct(t reflect.Type) bool {
+	if !reflect.PointerTo(t).Implements(yamlUnmarshalerType) {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if _, ok := t.Field(i).Tag.Lookup("yaml"); ok {
+			return false
+		}
+	}
+	return len(unionFields(t)) > 0
+}
+
+// unionFields returns the fields that hold the forms of a union struct.
+// Unexported fields hold the forms only if the struct has no exported fields.
+func unionFields(t reflect.Type) []reflect.StructField {
+	var exported, unexported []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.IsExported() {
+			exported = append(exported, field)
+		} else {
+			unexported = append(unexported, field)
+		}
+	}
+	if len(exported) > 0 {
+		return exported
+	}
+	return unexported
+}
@@ -0,0 +1,375 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestJSONSchema(t *testing.T) {
+	t.Run("error if the manifest type is not supported", func(t *testing.T) {
+		_, err := JSONSchema("Lambda Function")
+
+		require.EqualError(t, err, "invalid manifest type: Lambda Function")
+	})
+	for _, mftType := range SchemaTypes() {
+		t.Run(fmt.Sprintf("generates a schema for %s manifests", mftType), func(t *testing.T) {
+			// WHEN
+			out, err := JSONSchema(mftType)
+
+			// THEN
+			require.NoError(t, err)
+			var schema map[string]any
+			require.NoError(t, json.Unmarshal(out, &schema))
+			require.Equal(t, "object", schema["type"])
+			require.NotEmpty(t, schema["$defs"])
+			for _, ref := range schemaRefs(schema) {
+				require.Contains(t, schema["$defs"], strings.TrimPrefix(ref, "#/$defs/"), "reference %s is not defined", ref)
+			}
+			if mftType == PipelineManifestType {
+				return
+			}
+			typ := schema["properties"].(map[string]any)["type"].(map[string]any)
+			require.Equal(t, mftType, typ["const"])
+		})
+	}
+}
+
+func TestJSONSchema_Manifests(t *testing.T) {
+	testCases := map[string]struct {
+		inType     string
+		inManifest string
+
+		wantedError error
+	}{
+		"union types accept their basic form": {
+			inType: manifestinfo.LoadBalancedWebServiceType,
+			inManifest: `
+name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 80
+http:
+  path: /
+  alias: example.com
+count: 1
+platform: linux/arm64
+entrypoint: "/bin/sh"
+variables:
+  PORT: 80
+secrets:
+  DB_PASSWORD: /copilot/db/password
+network:
+  vpc:
+    placement: private`,
+		},
+		"union types accept their advanced form": {
+			inType: manifestinfo.LoadBalancedWebServiceType,
+			inManifest: `
+name: frontend
+type: Load Balanced Web Service
+image:
+  build:
+    dockerfile: frontend/Dockerfile
+    args:
+      GO_VERSION: 1.21
+  port: 80
+http:
+  path: /
+  alias:
+    - name: example.com
+      hosted_zone: Z0873220N255IR3MTNR4
+count:
+  range: 1-10
+  cpu_percentage: 70
+  response_time:
+    value: 2s
+    cooldown:
+      in: 60s
+platform:
+  osfamily: linux
+  architecture: arm64
+entrypoint: ["/bin/sh", "-c"]
+variables:
+  TABLE:
+    from_cfn: shared-TableName
+secrets:
+  GITHUB_TOKEN:
+    secretsmanager: prod/github
+network:
+  vpc:
+    placement:
+      subnets: ["subnet-1"]
+environments:
+  test:
+    count: 2`,
+		},
+		"error on unknown fields": {
+			inType: manifestinfo.BackendServiceType,
+			inManifest: `
+name: api
+type: Backend Service
+cpuu: 256`,
+			wantedError: fmt.Errorf(`/cpuu: unknown field`),
+		},
+		"error if a union type has none of its forms": {
+			inType: manifestinfo.BackendServiceType,
+			inManifest: `
+name: api
+type: Backend Service
+count: many`,
+			wantedError: fmt.Errorf(`/count: does not match any of the forms`),
+		},
+		"error on a different manifest type": {
+			inType: manifestinfo.BackendServiceType,
+			inManifest: `
+name: api
+type: Load Balanced Web Service`,
+			wantedError: fmt.Errorf(`/type: must be "Backend Service"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			schema := jsonSchemaOf(t, tc.inType)
+			var mft any
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inManifest), &mft))
+
+			// WHEN
+			err := validateJSONSchema(schema, schema, mft, "")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestJSONSchema_Testdata(t *testing.T) {
+	testCases := map[string]string{
+		"backend-svc-customhealthcheck.yml":           manifestinfo.BackendServiceType,
+		"backend-svc-nohealthcheck-placement.yml":     manifestinfo.BackendServiceType,
+		"environment-adjust-vpc-private-subnets.yml":  Environmentmanifestinfo,
+		"environment-adjust-vpc.yml":                  Environmentmanifestinfo,
+		"environment-default.yml":                     Environmentmanifestinfo,
+		"environment-import-vpc.yml":                  Environmentmanifestinfo,
+		"lb-svc-placement-private.yml":                manifestinfo.LoadBalancedWebServiceType,
+		"lb-svc.yml":                                  manifestinfo.LoadBalancedWebServiceType,
+		"pipeline-basic.yml":                          PipelineManifestType,
+		"pipeline-environment.yml":                    PipelineManifestType,
+		"scheduled-job-fully-specified-placement.yml": manifestinfo.ScheduledJobType,
+		"scheduled-job-no-retries.yml":                manifestinfo.ScheduledJobType,
+		"scheduled-job-no-timeout-or-retries.yml":     manifestinfo.ScheduledJobType,
+		"scheduled-job-no-timeout.yml":                manifestinfo.ScheduledJobType,
+		"worker-svc-nosubscribe-placement.yml":        manifestinfo.WorkerServiceType,
+		"worker-svc-subscribe.yml":                    manifestinfo.WorkerServiceType,
+		"worker-svc-with-default-fifo-queue.yml":      manifestinfo.WorkerServiceType,
+	}
+	for file, mftType := range testCases {
+		t.Run(file, func(t *testing.T) {
+			// GIVEN
+			schema := jsonSchemaOf(t, mftType)
+			raw, err := os.ReadFile(filepath.Join("testdata", file))
+			require.NoError(t, err)
+			var mft any
+			require.NoError(t, yaml.Unmarshal(raw, &mft))
+
+			// THEN
+			require.NoError(t, validateJSONSchema(schema, schema, mft, ""))
+		})
+	}
+}
+
+func jsonSchemaOf(t *testing.T, mftType string) map[string]any {
+	out, err := JSONSchema(mftType)
+	require.NoError(t, err)
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(out, &schema))
+	return schema
+}
+
+// schemaRefs returns all the "$ref" values in the schema.
+func schemaRefs(schema any) []string {
+	var refs []string
+	switch s := schema.(type) {
+	case map[string]any:
+		for k, v := range s {
+			if ref, ok := v.(string); ok && k == "$ref" {
+				refs = append(refs, ref)
+				continue
+			}
+			refs = append(refs, schemaRefs(v)...)
+		}
+	case []any:
+		for _, v := range s {
+			refs = append(refs, schemaRefs(v)...)
+		}
+	}
+	return refs
+}
+
+// validateJSONSchema validates v against the subset of JSON schema keywords generated by JSONSchema.
+func validateJSONSchema(root, schema map[string]any, v any, path string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		def := root["$defs"].(map[string]any)[strings.TrimPrefix(ref, "#/$defs/")]
+		return validateJSONSchema(root, def.(map[string]any), v, path)
+	}
+	if forms, ok := schema["anyOf"].([]any); ok {
+		for _, form := range forms {
+			if validateJSONSchema(root, form.(map[string]any), v, path) == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: does not match any of the forms", path)
+	}
+	if c, ok := schema["const"]; ok && c != v {
+		return fmt.Errorf("%s: must be %q", path, c)
+	}
+	if typ, ok := schema["type"]; ok && !hasJSONType(typ, v) {
+		return fmt.Errorf("%s: %v is not of type %v", path, v, typ)
+	}
+	switch val := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			props, _ := schema["properties"].(map[string]any)
+			prop, ok := props[k]
+			if !ok {
+				prop, ok = schema["additionalProperties"].(map[string]any)
+			}
+			if !ok {
+				return fmt.Errorf("%s/%s: unknown field", path, k)
+			}
+			if err := validateJSONSchema(root, prop.(map[string]any), val[k], path+"/"+k); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, item := range val {
+			if err := validateJSONSchema(root, schema["items"].(map[string]any), item, fmt.Sprintf("%s/%d", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func hasJSONType(typ, v any) bool {
+	types, ok := typ.([]any)
+	if !ok {
+		types = []any{typ}
+	}
+	for _, t := range types {
+		switch v.(type) {
+		case map[string]any:
+			if t == "object" {
+				return true
+			}
+		case []any:
+			if t == "array" {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case int:
+			if t == "integer" || t == "number" {
+				return true
+			}
+		case float64:
+			if t == "number" {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestDescribeField(t *testing.T) {
+	testCases := map[string]struct {
+		inType string
+		inPath []string
+
+		wantedDoc   FieldDoc
+		wantedFound bool
+	}{
+		"unknown manifest type": {
+			inType: "Lambda Function",
+			inPath: []string{"name"},
+		},
+		"unknown field": {
+			inType: manifestinfo.BackendServiceType,
+			inPath: []string{"cpuu"},
+		},
+		"scalar field": {
+			inType: manifestinfo.BackendServiceType,
+			inPath: []string{"cpu"},
+			wantedDoc: FieldDoc{
+				Path: []string{"cpu"},
+				Type: "Integer",
+			},
+			wantedFound: true,
+		},
+		"field of a union": {
+			inType: manifestinfo.LoadBalancedWebServiceType,
+			inPath: []string{"count", "range"},
+			wantedDoc: FieldDoc{
+				Path: []string{"count", "range"},
+				Type: "String or Map",
+			},
+			wantedFound: true,
+		},
+		"field under a map key": {
+			inType: manifestinfo.LoadBalancedWebServiceType,
+			inPath: []string{"variables", "TABLE", "from_cfn"},
+			wantedDoc: FieldDoc{
+				Path: []string{"variables", "from_cfn"},
+				Type: "String",
+			},
+			wantedFound: true,
+		},
+		"field of a list item": {
+			inType: PipelineManifestType,
+			inPath: []string{"stages", "0", "test_commands"},
+			wantedDoc: FieldDoc{
+				Path: []string{"stages", "test_commands"},
+				Type: "Array of Strings",
+			},
+			wantedFound: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			doc, found := DescribeField(tc.inType, tc.inPath)
+
+			// THEN
+			require.Equal(t, tc.wantedFound, found)
+			require.Equal(t, tc.wantedDoc, doc)
+		})
+	}
+}
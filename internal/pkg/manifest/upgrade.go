@@ -0,0 +1,213 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"gopkg.in/yaml.v3"
+)
+
+// fieldUpgrade rewrites the deprecated fields under a top-level field of a manifest to their current form.
+// It returns a description of each change.
+type fieldUpgrade func(key string, value *yaml.Node) []string
+
+var (
+	workloadUpgrades = []fieldUpgrade{
+		upgradeTargetContainer,
+	}
+	environmentUpgrades = []fieldUpgrade{
+		upgradeSecurityGroupsIngress,
+	}
+)
+
+// Upgrade rewrites the deprecated fields of a manifest to their current form, such as "http.targetContainer"
+// to "http.target_container". It returns the upgraded manifest and a description of each change.
+//
+// The "image" field has no deprecated form: both the string and map forms of "image.build" are current,
+// so it is left as is.
+//
+// Comments and the order of the fields are preserved. Only the top-level fields that contain a deprecated field
+// are re-formatted, the rest of the manifest is returned as is.
+func Upgrade(in []byte) ([]byte, []string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return in, nil, nil
+	}
+	root := doc.Content[0]
+	var upgrades []fieldUpgrade
+	if typ := mappingValue(root, "type"); typ != nil {
+		switch {
+		case typ.Value == Environmentmanifestinfo:
+			upgrades = environmentUpgrades
+		case slices.Contains(manifestinfo.WorkloadTypes(), typ.Value):
+			upgrades = workloadUpgrades
+		}
+	}
+
+	lines := strings.SplitAfter(string(in), "\n")
+	var out strings.Builder
+	var changes []string
+	next := 0 // Index of the next line of the input to write.
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		// The position of the field must be read before it's upgraded.
+		start, end := key.Line-1, fieldEndLine(lines, root, i)
+		var fieldChanges []string
+		for _, upgrade := range upgrades {
+			fieldChanges = append(fieldChanges, upgrade(key.Value, value)...)
+		}
+		if len(fieldChanges) == 0 {
+			continue
+		}
+		changes = append(changes, fieldChanges...)
+		upgraded, err := encodeField(key, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		out.WriteString(strings.Join(lines[next:start], ""))
+		out.Write(upgraded)
+		next = end
+	}
+	if len(changes) == 0 {
+		return in, nil, nil
+	}
+	out.WriteString(strings.Join(lines[next:], ""))
+	return []byte(out.String()), changes, nil
+}
+
+// fieldEndLine returns the index of the line after the top-level field at index i of the root mapping,
+// without the blank lines and comments that precede the next field.
+func fieldEndLine(lines []string, root *yaml.Node, i int) int {
+	end := len(lines)
+	if i+2 < len(root.Content) {
+		end = root.Content[i+2].Line - 1
+	}
+	start := root.Content[i].Line
+	for end > start {
+		line := strings.TrimSpace(lines[end-1])
+		if line != "" && !strings.HasPrefix(lines[end-1], "#") {
+			break
+		}
+		end--
+	}
+	return end
+}
+
+// encodeField returns the YAML of a top-level field. The comments above the field are left out
+// since they are kept from the original manifest.
+func encodeField(key, value *yaml.Node) ([]byte, error) {
+	k := *key
+	k.HeadComment = ""
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&yaml.Node{
+		Kind:    yaml.MappingNode,
+		Content: []*yaml.Node{&k, value},
+	}); err != nil {
+		return nil, fmt.Errorf("marshal %q: %w", key.Value, err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("marshal %q: %w", key.Value, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// upgradeTargetContainer renames "http.targetContainer" to "http.target_container", including in environment overrides.
+func upgradeTargetContainer(key string, value *yaml.Node) []string {
+	switch key {
+	case "http":
+		return renameTargetContainer("http", value)
+	case "environments":
+		var changes []string
+		for i := 0; i+1 < len(value.Content); i += 2 {
+			env, override := value.Content[i].Value, value.Content[i+1]
+			changes = append(changes, renameTargetContainer(fmt.Sprintf("environments.%s.http", env), mappingValue(override, "http"))...)
+		}
+		return changes
+	}
+	return nil
+}
+
+func renameTargetContainer(path string, http *yaml.Node) []string {
+	old := mappingKey(http, "targetContainer")
+	if old == nil || mappingKey(http, "target_container") != nil {
+		// Manifests with both fields are invalid, so they're left for the user to fix.
+		return nil
+	}
+	old.Value = "target_container"
+	return []string{fmt.Sprintf(`renamed "%s.targetContainer" to "%s.target_container"`, path, path)}
+}
+
+// upgradeSecurityGroupsIngress moves "http.public.security_groups.ingress.restrict_to" to "http.public.ingress",
+// and "http.private.security_groups.ingress.from_vpc" to "http.private.ingress.vpc".
+func upgradeSecurityGroupsIngress(key string, value *yaml.Node) []string {
+	if key != "http" {
+		return nil
+	}
+	var changes []string
+	if public := mappingValue(value, "public"); public != nil {
+		ingress := mappingValue(mappingValue(public, "security_groups"), "ingress")
+		restrictTo := mappingValue(ingress, "restrict_to")
+		// Public load balancers can't have the "from_vpc" field, so the manifest is left for the user to fix.
+		if restrictTo != nil && restrictTo.Kind == yaml.MappingNode && mappingKey(ingress, "from_vpc") == nil && replaceSecurityGroups(public, restrictTo) {
+			changes = append(changes, `moved "http.public.security_groups.ingress.restrict_to" to "http.public.ingress"`)
+		}
+	}
+	if private := mappingValue(value, "private"); private != nil {
+		ingress := mappingValue(mappingValue(private, "security_groups"), "ingress")
+		fromVPCKey, fromVPC := mappingKey(ingress, "from_vpc"), mappingValue(ingress, "from_vpc")
+		// Private load balancers can't have the "restrict_to" field, so the manifest is left for the user to fix.
+		if fromVPC != nil && mappingKey(ingress, "restrict_to") == nil {
+			vpcKey := *fromVPCKey
+			vpcKey.Value = "vpc"
+			if replaceSecurityGroups(private, &yaml.Node{
+				Kind:    yaml.MappingNode,
+				Tag:     "!!map",
+				Content: []*yaml.Node{&vpcKey, fromVPC},
+			}) {
+				changes = append(changes, `moved "http.private.security_groups.ingress.from_vpc" to "http.private.ingress.vpc"`)
+			}
+		}
+	}
+	return changes
+}
+
+// replaceSecurityGroups replaces the "security_groups" field of a load balancer with an "ingress" field.
+// It returns false if the load balancer has both fields already.
+func replaceSecurityGroups(lb, ingress *yaml.Node) bool {
+	if mappingKey(lb, "ingress") != nil {
+		return false
+	}
+	for i := 0; i+1 < len(lb.Content); i += 2 {
+		if lb.Content[i].Value != "security_groups" {
+			continue
+		}
+		lb.Content[i].Value = "ingress"
+		lb.Content[i+1] = ingress
+		return true
+	}
+	return false
+}
+
+// mappingKey returns the key node of the field named key in a mapping, or nil if it doesn't exist.
+func mappingKey(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgrade(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+
+		wantedManifest string
+		wantedChanges  []string
+		wantedError    error
+	}{
+		"manifest without deprecated fields is left as is": {
+			inManifest: `name: api
+type: Load Balanced Web Service
+http:
+  path: '/'   # Keep the spacing.
+  target_container: nginx
+`,
+			wantedManifest: `name: api
+type: Load Balanced Web Service
+http:
+  path: '/'   # Keep the spacing.
+  target_container: nginx
+`,
+		},
+		"rename targetContainer of a workload and its environment overrides": {
+			inManifest: `# The manifest for the "api" service.
+name: api
+type: Load Balanced Web Service
+
+# Distribute traffic to your service.
+http:
+  path: '/'
+  # The container that receives the traffic.
+  targetContainer: nginx
+
+image:
+  build: Dockerfile
+  port: 80
+command: >
+  folded
+  text
+environments:
+  test:
+    http:
+      targetContainer: api
+`,
+			wantedManifest: `# The manifest for the "api" service.
+name: api
+type: Load Balanced Web Service
+
+# Distribute traffic to your service.
+http:
+  path: '/'
+  # The container that receives the traffic.
+  target_container: nginx
+
+image:
+  build: Dockerfile
+  port: 80
+command: >
+  folded
+  text
+environments:
+  test:
+    http:
+      target_container: api
+`,
+			wantedChanges: []string{
+				`renamed "http.targetContainer" to "http.target_container"`,
+				`renamed "environments.test.http.targetContainer" to "environments.test.http.target_container"`,
+			},
+		},
+		"workload with both targetContainer fields is left for the user to fix": {
+			inManifest: `name: api
+type: Backend Service
+http:
+  targetContainer: nginx
+  target_container: api
+`,
+			wantedManifest: `name: api
+type: Backend Service
+http:
+  targetContainer: nginx
+  target_container: api
+`,
+		},
+		"move the ingress of the load balancers of an environment": {
+			inManifest: `name: test
+type: Environment
+http:
+  public:
+    security_groups:
+      ingress:
+        restrict_to:
+          cdn: true
+    certificates: [arn]
+  private:
+    security_groups:
+      ingress:
+        from_vpc: true # Allow traffic from the VPC.
+
+observability:
+  container_insights: false
+`,
+			wantedManifest: `name: test
+type: Environment
+http:
+  public:
+    ingress:
+      cdn: true
+    certificates: [arn]
+  private:
+    ingress:
+      vpc: true # Allow traffic from the VPC.
+
+observability:
+  container_insights: false
+`,
+			wantedChanges: []string{
+				`moved "http.public.security_groups.ingress.restrict_to" to "http.public.ingress"`,
+				`moved "http.private.security_groups.ingress.from_vpc" to "http.private.ingress.vpc"`,
+			},
+		},
+		"environment with both ingress fields is left for the user to fix": {
+			inManifest: `name: test
+type: Environment
+http:
+  public:
+    security_groups:
+      ingress:
+        restrict_to:
+          cdn: true
+    ingress:
+      cdn: true
+`,
+			wantedManifest: `name: test
+type: Environment
+http:
+  public:
+    security_groups:
+      ingress:
+        restrict_to:
+          cdn: true
+    ingress:
+      cdn: true
+`,
+		},
+		"upgrades don't apply to other manifest types": {
+			inManifest: `name: api
+type: Environment
+http:
+  targetContainer: nginx
+`,
+			wantedManifest: `name: api
+type: Environment
+http:
+  targetContainer: nginx
+`,
+		},
+		"error if the manifest is not valid YAML": {
+			inManifest:  "name: api\n  type: Backend Service\n",
+			wantedError: errors.New("unmarshal manifest: yaml: line 2: mapping values are not allowed in this context"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			out, changes, err := Upgrade([]byte(tc.inManifest))
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedManifest, string(out))
+			require.Equal(t, tc.wantedChanges, changes)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -680,6 +681,11 @@ func (p Pipeline) Validate() error {
 	if len(p.Name) > 100 {
 		return fmt.Errorf(`pipeline name '%s' must be shorter than 100 characters`, p.Name)
 	}
+	if p.Source != nil {
+		if err := p.Source.validate(); err != nil {
+			return fmt.Errorf(`validate "source" for pipeline %q: %w`, p.Name, err)
+		}
+	}
 	for _, stg := range p.Stages {
 		if err := stg.validate(); err != nil {
 			return fmt.Errorf(`validate stage %q for pipeline %q: %w`, stg.Name, p.Name, err)
@@ -691,6 +697,43 @@ func (p Pipeline) Validate() error {
 	return nil
 }
 
+// validate returns nil if Source is configured correctly.
+func (s Source) validate() error {
+	trigger, err := s.Trigger()
+	if err != nil {
+		return err
+	}
+	if trigger == nil {
+		return nil
+	}
+	// Triggers are only available on the CodeStarSourceConnection action.
+	if !s.IsCodeStarConnection() || s.Properties["access_token_secret"] != nil {
+		return fmt.Errorf(`"trigger" is not supported for provider %q`, s.ProviderName)
+	}
+	if err := trigger.validate(); err != nil {
+		return fmt.Errorf(`validate "trigger": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if PipelineTrigger is configured correctly.
+func (t PipelineTrigger) validate() error {
+	if t.PullRequests == nil {
+		return nil
+	}
+	if len(t.PullRequests.Events) == 0 {
+		return fmt.Errorf(`validate "pull_requests": %w`, &errFieldMustBeSpecified{
+			missingField: "events",
+		})
+	}
+	for _, event := range t.PullRequests.Events {
+		if !slices.Contains(PullRequestEvents, event) {
+			return fmt.Errorf(`validate "pull_requests": event %q must be one of %s`, event, english.WordSeries(quoteStringSlice(PullRequestEvents), "or"))
+		}
+	}
+	return nil
+}
+
 // validate returns nil if stages are configured correctly.
 func (s PipelineStage) validate() error {
 	if len(s.TestCommands) != 0 && s.PostDeployments != nil {
@@ -720,6 +763,24 @@ func (s PipelineStage) validate() error {
 		}
 
 	}
+	if s.Bake != nil {
+		if err := s.Bake.validate(); err != nil {
+			return fmt.Errorf(`validate "bake": %w`, err)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if bake is configured correctly.
+func (b PipelineBake) validate() error {
+	if b.Duration == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "duration",
+		}
+	}
+	if *b.Duration < time.Minute || *b.Duration%time.Minute != 0 {
+		return fmt.Errorf(`"duration" must be a whole number of minutes, not %s`, *b.Duration)
+	}
 	return nil
 }
 
@@ -740,9 +801,34 @@ func (d Deployments) validate() error {
 			}
 		}
 	}
+	// Deployments are ranked into run orders at deploy time, so make sure that an order exists.
+	if _, err := graph.TopologicalOrder(d.graph()); err != nil {
+		return fmt.Errorf("find an ordering for deployments: %w", err)
+	}
 	return nil
 }
 
+// graph returns the directed graph of the deployments where an edge goes from a dependency to its dependent.
+func (d Deployments) graph() *graph.Graph[string] {
+	var names []string
+	for name := range d {
+		names = append(names, name)
+	}
+	digraph := graph.New(names...)
+	for name, conf := range d {
+		if conf == nil {
+			continue
+		}
+		for _, dependency := range conf.DependsOn {
+			digraph.Add(graph.Edge[string]{
+				From: dependency,
+				To:   name,
+			})
+		}
+	}
+	return digraph
+}
+
 // validate returns nil if Workload is configured correctly.
 func (w Workload) validate() error {
 	if w.Name == nil {
@@ -1483,6 +1483,32 @@ func TestPipelineManifest_validate(t *testing.T) {
 			},
 			wantedError: errors.New(`validate stage "test" for pipeline "release": "buildspec" must be specified`),
 		},
+		"should validate bake duration is specified": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Bake: &PipelineBake{},
+					},
+				},
+			},
+			wantedError: errors.New(`validate stage "test" for pipeline "release": validate "bake": "duration" must be specified`),
+		},
+		"should validate bake duration is in whole minutes": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Bake: &PipelineBake{
+							Duration: durationp(90 * time.Second),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate stage "test" for pipeline "release": validate "bake": "duration" must be a whole number of minutes, not 1m30s`),
+		},
 		"should validate pipeline deployments": {
 			Pipeline: Pipeline{
 				Name: "release",
@@ -1499,6 +1525,88 @@ func TestPipelineManifest_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployments" for pipeline stage test:`,
 		},
+		"error if trigger is set for a provider without CodeStar Connections": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Source: &Source{
+					ProviderName: CodeCommitProviderName,
+					Properties: map[string]interface{}{
+						"trigger": map[string]interface{}{
+							"paths": map[string]interface{}{
+								"include": []interface{}{"frontend/**"},
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "source" for pipeline "release": "trigger" is not supported for provider "CodeCommit"`),
+		},
+		"error if trigger has an unknown field": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Source: &Source{
+					ProviderName: GithubProviderName,
+					Properties: map[string]interface{}{
+						"trigger": map[string]interface{}{
+							"branches": []interface{}{"main"},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "source" for pipeline "release": unmarshal "trigger":`,
+		},
+		"error if pull request events are missing": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Source: &Source{
+					ProviderName: GithubProviderName,
+					Properties: map[string]interface{}{
+						"trigger": map[string]interface{}{
+							"pull_requests": map[string]interface{}{},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "source" for pipeline "release": validate "trigger": validate "pull_requests": "events" must be specified`),
+		},
+		"error if a pull request event is invalid": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Source: &Source{
+					ProviderName: GitLabProviderName,
+					Properties: map[string]interface{}{
+						"trigger": map[string]interface{}{
+							"pull_requests": map[string]interface{}{
+								"events": []interface{}{"open", "merged"},
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "source" for pipeline "release": validate "trigger": validate "pull_requests": event "merged" must be one of "open", "updated" or "closed"`),
+		},
+		"valid trigger": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Source: &Source{
+					ProviderName: BitbucketProviderName,
+					Properties: map[string]interface{}{
+						"trigger": map[string]interface{}{
+							"paths": map[string]interface{}{
+								"include": []interface{}{"frontend/**"},
+								"exclude": []interface{}{"**/*.md"},
+							},
+							"tags": map[string]interface{}{
+								"include": []interface{}{"v*"},
+							},
+							"pull_requests": map[string]interface{}{
+								"events": []interface{}{"open", "updated"},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1519,8 +1627,9 @@ func TestPipelineManifest_validate(t *testing.T) {
 
 func TestDeployments_validate(t *testing.T) {
 	testCases := map[string]struct {
-		in     Deployments
-		wanted error
+		in           Deployments
+		wanted       error
+		wantedPrefix string
 	}{
 		"should return nil on empty deployments": {},
 		"should return an error when a dependency does not exist": {
@@ -1539,15 +1648,48 @@ func TestDeployments_validate(t *testing.T) {
 				"backend": nil,
 			},
 		},
+		"should return an error when a deployment depends on itself": {
+			in: map[string]*Deployment{
+				"frontend": {
+					DependsOn: []string{"frontend"},
+				},
+			},
+			wanted: errors.New("find an ordering for deployments: graph contains a cycle: frontend"),
+		},
+		"should return an error when the dependencies form a cycle": {
+			in: map[string]*Deployment{
+				"db-migrator": {
+					DependsOn: []string{"api"},
+				},
+				"api": {
+					DependsOn: []string{"db-migrator"},
+				},
+				"web": nil,
+			},
+			wantedPrefix: "find an ordering for deployments: graph contains a cycle:",
+		},
+		"should return nil when independent deployments run in parallel": {
+			in: map[string]*Deployment{
+				"db-migrator": nil,
+				"api": {
+					DependsOn: []string{"db-migrator"},
+				},
+				"web":    nil,
+				"worker": nil,
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			actual := tc.in.validate()
 
-			if tc.wanted == nil {
-				require.NoError(t, actual)
-			} else {
+			switch {
+			case tc.wanted != nil:
 				require.EqualError(t, actual, tc.wanted.Error())
+			case tc.wantedPrefix != "":
+				require.ErrorContains(t, actual, tc.wantedPrefix)
+			default:
+				require.NoError(t, actual)
 			}
 		})
 	}
@@ -304,6 +304,12 @@ func (s *WorkerService) ContainerDependencies() map[string]ContainerDependency {
 	return containerDependencies(aws.StringValue(s.Name), s.ImageConfig.Image, s.Logging, s.Sidecars)
 }
 
+// ContainerConfigs returns a map of ContainerConfig objects for the WorkerService
+// including its main container and additional sidecars.
+func (s *WorkerService) ContainerConfigs() map[string]ContainerConfig {
+	return containerConfigs(aws.StringValue(s.Name), s.ImageConfig.Image, nil, s.ImageConfig.HealthCheck, s.TaskConfig, s.Sidecars)
+}
+
 // Subscriptions returns a list of TopicSubscriotion objects which represent the SNS topics the service
 // receives messages from. This method also appends ".fifo" to the topics and returns a new set of subs.
 func (s *WorkerService) Subscriptions() []TopicSubscription {
@@ -69,6 +69,7 @@ type DynamicWorkload interface {
 	Validate() error
 	RequiredEnvironmentFeatures() []string
 	Load(sess *session.Session) error
+	LoadOffline(subnetIDs []string) error
 	Manifest() any
 }
 
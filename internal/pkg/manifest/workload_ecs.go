@@ -463,3 +463,54 @@ func containerDependencies(name string, img Image, lc Logging, sc map[string]*Si
 	}
 	return containerDependencies
 }
+
+// ContainerConfig represents the runtime configuration of a single container in a task
+// as defined in the workload manifest.
+type ContainerConfig struct {
+	Image       string // Empty if the image is built from a Dockerfile.
+	Port        string // Empty if the container doesn't expose a port.
+	Variables   map[string]Variable
+	EnvFile     string
+	Secrets     map[string]Secret
+	HealthCheck ContainerHealthCheck
+}
+
+// ContainerConfigs returns a map of ContainerConfig objects from workload manifest.
+func ContainerConfigs(unmarshaledManifest interface{}) map[string]ContainerConfig {
+	type containerConfigs interface {
+		ContainerConfigs() map[string]ContainerConfig
+	}
+	mf, ok := unmarshaledManifest.(containerConfigs)
+	if ok {
+		return mf.ContainerConfigs()
+	}
+	return nil
+}
+
+func containerConfigs(name string, img Image, port *uint16, hc ContainerHealthCheck, tc TaskConfig, sc map[string]*SidecarConfig) map[string]ContainerConfig {
+	configs := make(map[string]ContainerConfig, len(sc)+1)
+	main := ContainerConfig{
+		Image:       img.GetLocation(),
+		Variables:   tc.Variables,
+		EnvFile:     aws.StringValue(tc.EnvFile),
+		Secrets:     tc.Secrets,
+		HealthCheck: hc,
+	}
+	if port != nil {
+		main.Port = strconv.Itoa(int(aws.Uint16Value(port)))
+	}
+	configs[name] = main
+	for name, config := range sc {
+		uri, _ := config.ImageURI()
+		sidecarPort, _, _ := ParsePortMapping(config.Port)
+		configs[name] = ContainerConfig{
+			Image:       uri,
+			Port:        aws.StringValue(sidecarPort),
+			Variables:   config.Variables,
+			EnvFile:     aws.StringValue(config.EnvFile),
+			Secrets:     config.Secrets,
+			HealthCheck: config.HealthCheck,
+		}
+	}
+	return configs
+}
@@ -11,6 +11,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -25,6 +26,25 @@ const (
 	maxNumberOfLevelsChecked = 5
 )
 
+// Languages of CDK override projects.
+const (
+	CDKLanguageTypeScript = "typescript"
+	CDKLanguagePython     = "python"
+	CDKLanguageGo         = "go"
+)
+
+// CDKLanguages are the languages supported for CDK override projects.
+var CDKLanguages = []string{CDKLanguageTypeScript, CDKLanguagePython, CDKLanguageGo}
+
+const (
+	cdkToolkit           = "cdk"
+	pythonVirtualEnvDir  = ".venv"
+	pythonRequirements   = "requirements.txt"
+	pythonProject        = "pyproject.toml"
+	goModFile            = "go.mod"
+	cdkToolkitInstallURL = "https://docs.aws.amazon.com/cdk/v2/guide/cli.html"
+)
+
 // CDK is an Overrider that can transform a CloudFormation template with the Cloud Development Kit.
 type CDK struct {
 	rootAbsPath string // Absolute path to the overrides/ directory.
@@ -99,24 +119,190 @@ func WithCDK(root string, opts CDKOpts) *CDK {
 
 // Override returns the extended CloudFormation template body using the CDK.
 // In order to ensure the CDK transformations can be applied, Copilot first installs any CDK dependencies
-// as well as the toolkit itself.
+// as well as the toolkit itself for TypeScript projects.
+// Python and Go projects require the CDK toolkit to be installed separately.
 func (cdk *CDK) Override(body []byte) ([]byte, error) {
-	if err := cdk.install(); err != nil {
+	lang, err := cdk.language()
+	if err != nil {
 		return nil, err
 	}
-	out, err := cdk.transform(body)
+	// We assume that a node_modules/ dir is present with the CDK downloaded after running "npm install".
+	// This way clients don't need to install the CDK toolkit separately.
+	toolkit := filepath.Join("node_modules", ".bin", cdkToolkit)
+	switch lang {
+	case CDKLanguagePython:
+		err = cdk.installPython()
+	case CDKLanguageGo:
+		err = cdk.installGo()
+	default:
+		err = cdk.install()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if lang != CDKLanguageTypeScript {
+		if toolkit, err = cdk.lookPath(cdkToolkit, cdkToolkitInstallURL); err != nil {
+			return nil, err
+		}
+	}
+	synth := cdk.exec.Command(toolkit, "synth", "--no-version-reporting")
+	if lang == CDKLanguagePython {
+		if synth, err = cdk.pythonSynth(toolkit); err != nil {
+			return nil, err
+		}
+	}
+	out, err := cdk.transform(body, synth)
 	if err != nil {
 		return nil, err
 	}
 	return cdk.cleanUp(out)
 }
 
+// language returns the language of the CDK project based on its dependency files.
+// A project that is neither a Go nor a Python project is assumed to be a TypeScript project.
+func (cdk *CDK) language() (string, error) {
+	for _, candidate := range []struct {
+		lang  string
+		files []string
+	}{
+		{lang: CDKLanguageGo, files: []string{goModFile}},
+		{lang: CDKLanguagePython, files: []string{pythonRequirements, pythonProject}},
+	} {
+		for _, file := range candidate.files {
+			exists, err := afero.Exists(cdk.fs, filepath.Join(cdk.rootAbsPath, file))
+			if err != nil {
+				return "", fmt.Errorf("check if %s exists: %w", file, err)
+			}
+			if exists {
+				return candidate.lang, nil
+			}
+		}
+	}
+	return CDKLanguageTypeScript, nil
+}
+
 func (cdk *CDK) install() error {
 	manager, err := cdk.packageManager()
 	if err != nil {
 		return err
 	}
-	cmd := cdk.exec.Command(manager, "install")
+	return cdk.run(manager, "install")
+}
+
+// installPython installs the dependencies of a Python project with pip in a virtual environment,
+// or with poetry if the project doesn't have a requirements.txt file.
+func (cdk *CDK) installPython() error {
+	usesPip, err := cdk.usesPip()
+	if err != nil {
+		return err
+	}
+	if !usesPip {
+		poetry, err := cdk.lookPath("poetry", "https://python-poetry.org/docs/#installation")
+		if err != nil {
+			return err
+		}
+		return cdk.run(poetry, "install")
+	}
+	venv := newPythonVirtualEnv(runtime.GOOS)
+	venvExists, err := afero.Exists(cdk.fs, filepath.Join(cdk.rootAbsPath, pythonVirtualEnvDir))
+	if err != nil {
+		return fmt.Errorf("check if %s exists: %w", pythonVirtualEnvDir, err)
+	}
+	if !venvExists {
+		python, err := cdk.lookPath(venv.systemPython, "https://www.python.org/downloads/")
+		if err != nil {
+			return err
+		}
+		if err := cdk.run(python, "-m", "venv", pythonVirtualEnvDir); err != nil {
+			return err
+		}
+	}
+	return cdk.run(venv.python(), "-m", "pip", "install", "-r", pythonRequirements)
+}
+
+// pythonSynth returns the command to synthesize a Python project.
+// The "app" command of cdk.json runs with the python interpreter of the project:
+// the one of the virtual environment for pip, or the one of "poetry run" for poetry.
+func (cdk *CDK) pythonSynth(toolkit string) (*exec.Cmd, error) {
+	usesPip, err := cdk.usesPip()
+	if err != nil {
+		return nil, err
+	}
+	if !usesPip {
+		return cdk.exec.Command("poetry", "run", toolkit, "synth", "--no-version-reporting"), nil
+	}
+	cmd := cdk.exec.Command(toolkit, "synth", "--no-version-reporting")
+	env := cmd.Env
+	if env == nil {
+		env = os.Environ()
+	}
+	venv := newPythonVirtualEnv(runtime.GOOS)
+	binDir := filepath.Join(cdk.rootAbsPath, venv.binDir)
+	cmd.Env = append(env,
+		fmt.Sprintf("VIRTUAL_ENV=%s", filepath.Join(cdk.rootAbsPath, pythonVirtualEnvDir)),
+		fmt.Sprintf("PATH=%s%c%s", binDir, os.PathListSeparator, os.Getenv("PATH")))
+	return cmd, nil
+}
+
+func (cdk *CDK) usesPip() (bool, error) {
+	exists, err := afero.Exists(cdk.fs, filepath.Join(cdk.rootAbsPath, pythonRequirements))
+	if err != nil {
+		return false, fmt.Errorf("check if %s exists: %w", pythonRequirements, err)
+	}
+	return exists, nil
+}
+
+// pythonVirtualEnv holds the platform specific paths of a Python virtual environment.
+type pythonVirtualEnv struct {
+	systemPython string // Name of the interpreter used to create the virtual environment.
+	binDir       string // Directory of the executables of the virtual environment relative to the project.
+	executable   string // Name of the interpreter in binDir.
+}
+
+func newPythonVirtualEnv(goos string) pythonVirtualEnv {
+	if goos == "windows" {
+		return pythonVirtualEnv{
+			systemPython: "python",
+			binDir:       filepath.Join(pythonVirtualEnvDir, "Scripts"),
+			executable:   "python.exe",
+		}
+	}
+	return pythonVirtualEnv{
+		systemPython: "python3",
+		binDir:       filepath.Join(pythonVirtualEnvDir, "bin"),
+		executable:   "python",
+	}
+}
+
+func (venv pythonVirtualEnv) python() string {
+	return filepath.Join(venv.binDir, venv.executable)
+}
+
+// installGo resolves the dependencies of a Go project.
+func (cdk *CDK) installGo() error {
+	gobin, err := cdk.lookPath("go", "https://go.dev/doc/install")
+	if err != nil {
+		return err
+	}
+	return cdk.run(gobin, "mod", "tidy")
+}
+
+// lookPath returns the name of the executable if it's installed.
+func (cdk *CDK) lookPath(name, installURL string) (string, error) {
+	if _, err := cdk.exec.LookPath(name); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return "", &errExecutableUnavailable{
+				name:       name,
+				installURL: installURL,
+			}
+		}
+		return "", err
+	}
+	return name, nil
+}
+
+func (cdk *CDK) run(name string, args ...string) error {
+	cmd := cdk.exec.Command(name, args...)
 	cmd.Stdout = cdk.execWriter
 	cmd.Stderr = cdk.execWriter
 
@@ -126,7 +312,7 @@ func (cdk *CDK) install() error {
 	return nil
 }
 
-func (cdk *CDK) transform(body []byte) ([]byte, error) {
+func (cdk *CDK) transform(body []byte, cmd *exec.Cmd) ([]byte, error) {
 	buildPath := filepath.Join(cdk.rootAbsPath, ".build")
 	if err := cdk.fs.MkdirAll(buildPath, 0755); err != nil {
 		return nil, fmt.Errorf("create %s directory to store the CloudFormation template body: %w", buildPath, err)
@@ -136,9 +322,6 @@ func (cdk *CDK) transform(body []byte) ([]byte, error) {
 		return nil, fmt.Errorf("write CloudFormation template body content at %s: %w", inputPath, err)
 	}
 
-	// We assume that a node_modules/ dir is present with the CDK downloaded after running "npm install".
-	// This way clients don't need to install the CDK toolkit separately.
-	cmd := cdk.exec.Command(filepath.Join("node_modules", ".bin", "cdk"), "synth", "--no-version-reporting")
 	buf := new(bytes.Buffer)
 	cmd.Stdout = buf
 	cmd.Stderr = cdk.execWriter
@@ -269,9 +452,9 @@ func (cdk *CDK) packageManager() (string, error) {
 	return defaultPackageManager, nil
 }
 
-// ScaffoldWithCDK bootstraps a CDK application under dir/ to override the seed CloudFormation resources.
+// ScaffoldWithCDK bootstraps a CDK application in the language under dir/ to override the seed CloudFormation resources.
 // If the directory is not empty, then returns an error.
-func ScaffoldWithCDK(fs afero.Fs, dir string, seeds []template.CFNResource, requiresEnv bool) error {
+func ScaffoldWithCDK(fs afero.Fs, dir, lang string, seeds []template.CFNResource, requiresEnv bool) error {
 	// If the directory does not exist, [afero.IsEmpty] returns false and an error.
 	// Therefore, we only want to check if a directory is empty only if it also exists.
 	exists, _ := afero.Exists(fs, dir)
@@ -280,7 +463,16 @@ func ScaffoldWithCDK(fs afero.Fs, dir string, seeds []template.CFNResource, requ
 		return fmt.Errorf("directory %q is not empty", dir)
 	}
 
-	return templates.WalkOverridesCDKDir(seeds, writeFilesToDir(dir, fs), requiresEnv)
+	switch lang {
+	case CDKLanguagePython:
+		return templates.WalkOverridesCDKPythonDir(seeds, writeFilesToDir(dir, fs), requiresEnv)
+	case CDKLanguageGo:
+		return templates.WalkOverridesCDKGoDir(seeds, writeFilesToDir(dir, fs), requiresEnv)
+	case CDKLanguageTypeScript:
+		return templates.WalkOverridesCDKDir(seeds, writeFilesToDir(dir, fs), requiresEnv)
+	default:
+		return fmt.Errorf("unsupported CDK language %q", lang)
+	}
 }
 
 func writeFilesToDir(dir string, fs afero.Fs) template.WalkDirFunc {
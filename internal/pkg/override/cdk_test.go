@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -312,6 +313,148 @@ func TestCDK_Override(t *testing.T) {
 		require.Contains(t, buf.String(), "npm install")
 		require.Contains(t, string(out), fmt.Sprintf("%s synth --no-version-reporting", filepath.Join("node_modules", ".bin", "cdk")))
 	})
+	t.Run("should create a virtual environment, invoke pip install and cdk synth for a Python project", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, "requirements.txt", []byte("aws-cdk-lib"), 0644)
+		buf := new(strings.Builder)
+		cdk := WithCDK("", CDKOpts{
+			ExecWriter: buf,
+			FS:         fs,
+			LookPathFn: func(file string) (string, error) {
+				return "/bin/" + file, nil
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				return exec.Command("echo", fmt.Sprintf("Description: %s", strings.Join(append([]string{name}, args...), " ")))
+			},
+		})
+
+		// WHEN
+		out, err := cdk.Override(nil)
+
+		// THEN
+		venv := newPythonVirtualEnv(runtime.GOOS)
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), fmt.Sprintf("%s -m venv .venv", venv.systemPython))
+		require.Contains(t, buf.String(), fmt.Sprintf("%s -m pip install -r requirements.txt", venv.python()))
+		require.Contains(t, string(out), "cdk synth --no-version-reporting")
+	})
+	t.Run("should run cdk synth in the virtual environment of a Python project", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, "overrides/requirements.txt", []byte("aws-cdk-lib"), 0644)
+		cdk := WithCDK("overrides", CDKOpts{
+			FS: fs,
+			LookPathFn: func(file string) (string, error) {
+				return "/bin/" + file, nil
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				return exec.Command("sh", "-c", `echo "Description: $VIRTUAL_ENV"`)
+			},
+		})
+
+		// WHEN
+		out, err := cdk.Override(nil)
+
+		// THEN
+		require.NoError(t, err)
+		require.Contains(t, string(out), filepath.Join("overrides", ".venv"))
+	})
+	t.Run("should reuse the virtual environment of a Python project", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, "requirements.txt", []byte("aws-cdk-lib"), 0644)
+		_ = fs.MkdirAll(".venv", 0755)
+		buf := new(strings.Builder)
+		cdk := WithCDK("", CDKOpts{
+			ExecWriter: buf,
+			FS:         fs,
+			LookPathFn: func(file string) (string, error) {
+				return "/bin/" + file, nil
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				return exec.Command("echo", fmt.Sprintf("Description: %s", strings.Join(append([]string{name}, args...), " ")))
+			},
+		})
+
+		// WHEN
+		_, err := cdk.Override(nil)
+
+		// THEN
+		require.NoError(t, err)
+		require.NotContains(t, buf.String(), "venv .venv")
+	})
+	t.Run("should invoke poetry install for a Python project without a requirements.txt file", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, "pyproject.toml", []byte(""), 0644)
+		buf := new(strings.Builder)
+		cdk := WithCDK("", CDKOpts{
+			ExecWriter: buf,
+			FS:         fs,
+			LookPathFn: func(file string) (string, error) {
+				return "/bin/" + file, nil
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				return exec.Command("echo", fmt.Sprintf("Description: %s", strings.Join(append([]string{name}, args...), " ")))
+			},
+		})
+
+		// WHEN
+		out, err := cdk.Override(nil)
+
+		// THEN
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), "poetry install")
+		require.Contains(t, string(out), "poetry run cdk synth --no-version-reporting")
+	})
+	t.Run("should invoke go mod tidy and cdk synth for a Go project", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, "go.mod", []byte("module override"), 0644)
+		buf := new(strings.Builder)
+		cdk := WithCDK("", CDKOpts{
+			ExecWriter: buf,
+			FS:         fs,
+			LookPathFn: func(file string) (string, error) {
+				return "/bin/" + file, nil
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				return exec.Command("echo", fmt.Sprintf("Description: %s", strings.Join(append([]string{name}, args...), " ")))
+			},
+		})
+
+		// WHEN
+		out, err := cdk.Override(nil)
+
+		// THEN
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), "go mod tidy")
+		require.Contains(t, string(out), "cdk synth --no-version-reporting")
+	})
+	t.Run("should return an error if the CDK toolkit is not installed for a Go project", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, "go.mod", []byte("module override"), 0644)
+		cdk := WithCDK("", CDKOpts{
+			FS: fs,
+			LookPathFn: func(file string) (string, error) {
+				if file == "go" {
+					return "/bin/go", nil
+				}
+				return "", &exec.Error{Name: file, Err: exec.ErrNotFound}
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				return exec.Command("echo")
+			},
+		})
+
+		// WHEN
+		_, err := cdk.Override(nil)
+
+		// THEN
+		require.EqualError(t, err, `cannot find "cdk" to override with the Cloud Development Kit`)
+	})
 	t.Run("should return the transformed document with CDK metadata stripped and description updated", func(t *testing.T) {
 		buf := new(strings.Builder)
 		cdk := WithCDK("", CDKOpts{
@@ -402,7 +545,7 @@ func TestScaffoldWithCDK(t *testing.T) {
 		dir := filepath.Join("copilot", "frontend", "overrides")
 
 		// WHEN
-		err := ScaffoldWithCDK(fs, dir, []template.CFNResource{
+		err := ScaffoldWithCDK(fs, dir, CDKLanguageTypeScript, []template.CFNResource{
 			{
 				Type:      "AWS::ECS::Service",
 				LogicalID: "Service",
@@ -432,9 +575,81 @@ func TestScaffoldWithCDK(t *testing.T) {
 		_ = afero.WriteFile(fs, filepath.Join(dir, "cdk.json"), []byte("content"), 0644)
 
 		// WHEN
-		err := ScaffoldWithCDK(fs, dir, nil, true)
+		err := ScaffoldWithCDK(fs, dir, CDKLanguageTypeScript, nil, true)
 
 		// THEN
 		require.EqualError(t, err, fmt.Sprintf("directory %q is not empty", dir))
 	})
+	t.Run("scaffolds a Python project", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		dir := filepath.Join("copilot", "frontend", "overrides")
+
+		// WHEN
+		err := ScaffoldWithCDK(fs, dir, CDKLanguagePython, nil, true)
+
+		// THEN
+		require.NoError(t, err)
+		for _, name := range []string{"cdk.json", "requirements.txt", "app.py", "stack.py"} {
+			ok, _ := afero.Exists(fs, filepath.Join(dir, name))
+			require.True(t, ok, "%s should exist", name)
+		}
+	})
+	t.Run("scaffolds a Go project", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		dir := filepath.Join("copilot", "frontend", "overrides")
+
+		// WHEN
+		err := ScaffoldWithCDK(fs, dir, CDKLanguageGo, nil, false)
+
+		// THEN
+		require.NoError(t, err)
+		for _, name := range []string{"cdk.json", "go.mod", "override.go", "stack.go"} {
+			ok, _ := afero.Exists(fs, filepath.Join(dir, name))
+			require.True(t, ok, "%s should exist", name)
+		}
+	})
+	t.Run("should return an error for an unsupported language", func(t *testing.T) {
+		// WHEN
+		err := ScaffoldWithCDK(afero.NewMemMapFs(), "overrides", "java", nil, false)
+
+		// THEN
+		require.EqualError(t, err, `unsupported CDK language "java"`)
+	})
+}
+
+func TestNewPythonVirtualEnv(t *testing.T) {
+	testCases := map[string]struct {
+		goos string
+
+		wantedSystemPython string
+		wantedPython       string
+	}{
+		"linux": {
+			goos:               "linux",
+			wantedSystemPython: "python3",
+			wantedPython:       filepath.Join(".venv", "bin", "python"),
+		},
+		"darwin": {
+			goos:               "darwin",
+			wantedSystemPython: "python3",
+			wantedPython:       filepath.Join(".venv", "bin", "python"),
+		},
+		"windows": {
+			goos:               "windows",
+			wantedSystemPython: "python",
+			wantedPython:       filepath.Join(".venv", "Scripts", "python.exe"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			venv := newPythonVirtualEnv(tc.goos)
+
+			// THEN
+			require.Equal(t, tc.wantedSystemPython, venv.systemPython)
+			require.Equal(t, tc.wantedPython, venv.python())
+		})
+	}
 }
@@ -22,6 +22,20 @@ func (err *errPackageManagerUnavailable) RecommendActions() string {
 		"yarn", "https://yarnpkg.com/getting-started/install")
 }
 
+type errExecutableUnavailable struct {
+	name       string
+	installURL string
+}
+
+func (err *errExecutableUnavailable) Error() string {
+	return fmt.Sprintf("cannot find %q to override with the Cloud Development Kit", err.name)
+}
+
+// RecommendActions implements the cli.actionRecommender interface.
+func (err *errExecutableUnavailable) RecommendActions() string {
+	return fmt.Sprintf("Please follow the instructions to install %q: %q", err.name, err.installURL)
+}
+
 // ErrNotExist occurs when the path of the file associated with an Overrider does not exist.
 type ErrNotExist struct {
 	parent error
@@ -15,3 +15,11 @@ func TestErrPackageManagerUnavailable_RecommendActions(t *testing.T) {
 "yarn": "https://yarnpkg.com/getting-started/install"`,
 		new(errPackageManagerUnavailable).RecommendActions())
 }
+
+func TestErrExecutableUnavailable_RecommendActions(t *testing.T) {
+	require.Equal(t, `Please follow the instructions to install "poetry": "https://python-poetry.org/docs/#installation"`,
+		(&errExecutableUnavailable{
+			name:       "poetry",
+			installURL: "https://python-poetry.org/docs/#installation",
+		}).RecommendActions())
+}
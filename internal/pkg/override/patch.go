@@ -72,19 +72,11 @@ func (p *Patch) Override(body []byte) ([]byte, error) {
 	}
 
 	for i := range patches {
-		patch := patches[i] // needed because operations use pointer to patch.Value
-		var err error
-		switch patch.Operation {
-		case "add":
-			err = patch.applyAdd(&root)
-		case "remove":
-			err = patch.applyRemove(&root)
-		case "replace":
-			err = patch.applyReplace(&root)
-		default:
-			return nil, fmt.Errorf("unsupported operation %q: supported operations are %q, %q, and %q.", patch.Operation, "add", "remove", "replace")
+		patch := patches[i]
+		if !isSupportedOperation(patch.Operation) {
+			return nil, fmt.Errorf("unsupported operation %q: supported operations are %q, %q, %q, %q, %q, and %q.", patch.Operation, "add", "remove", "replace", "move", "copy", "test")
 		}
-		if err != nil {
+		if err := patch.applyAll(&root); err != nil {
 			return nil, fmt.Errorf("unable to apply the %q patch at index %d: %w", patch.Operation, i, err)
 		}
 	}
@@ -132,9 +124,43 @@ func addYAMLPatchDescription(body *yaml.Node) {
 type yamlPatch struct {
 	Operation string `yaml:"op"`
 
-	// Path is in JSON Pointer syntax: https://www.rfc-editor.org/rfc/rfc6901
+	// Path and From are in JSON Pointer syntax: https://www.rfc-editor.org/rfc/rfc6901
+	// Segments of Path can be the "*" wildcard to match every key of a map or item of a sequence.
 	Path  string    `yaml:"path"`
+	From  string    `yaml:"from"` // Only used by the "move" and "copy" operations.
 	Value yaml.Node `yaml:"value"`
+
+	// Resources selects the resources that Path and From are relative to.
+	Resources *resourceSelector `yaml:"resources"`
+	// Optional is true if it's not an error for Path to match nothing.
+	Optional bool `yaml:"optional"`
+}
+
+func isSupportedOperation(op string) bool {
+	switch op {
+	case "add", "remove", "replace", "move", "copy", "test":
+		return true
+	}
+	return false
+}
+
+// apply applies the patch to the single node that Path points to.
+func (p *yamlPatch) apply(root *yaml.Node) error {
+	switch p.Operation {
+	case "add":
+		return p.applyAdd(root)
+	case "remove":
+		return p.applyRemove(root)
+	case "replace":
+		return p.applyReplace(root)
+	case "move":
+		return p.applyMove(root)
+	case "copy":
+		return p.applyCopy(root)
+	case "test":
+		return p.applyTest(root)
+	}
+	return nil
 }
 
 func (p *yamlPatch) applyAdd(root *yaml.Node) error {
@@ -239,6 +265,115 @@ func (p *yamlPatch) applyReplace(root *yaml.Node) error {
 	return node.Encode(p.Value)
 }
 
+func (p *yamlPatch) applyMove(root *yaml.Node) error {
+	if p.From == "" {
+		return fmt.Errorf("from required")
+	}
+	from, to := p.fromPointer(), p.pointer()
+	if from.isProperPrefixOf(to) {
+		return fmt.Errorf("cannot move %q into one of its children %q", p.From, p.Path)
+	}
+	node, err := findNodeWithPointer(root, from, nil)
+	if err != nil {
+		return err
+	}
+	p.Value = *node
+
+	remove := yamlPatch{Path: p.From}
+	if err := remove.applyRemove(root); err != nil {
+		return err
+	}
+	return p.applyAdd(root)
+}
+
+func (p *yamlPatch) applyCopy(root *yaml.Node) error {
+	if p.From == "" {
+		return fmt.Errorf("from required")
+	}
+	node, err := findNodeWithPointer(root, p.fromPointer(), nil)
+	if err != nil {
+		return err
+	}
+	p.Value = *copyNode(node)
+	return p.applyAdd(root)
+}
+
+func (p *yamlPatch) applyTest(root *yaml.Node) error {
+	if p.Value.IsZero() {
+		return fmt.Errorf("value required")
+	}
+
+	node, err := findNodeWithPointer(root, p.pointer(), nil)
+	if err != nil {
+		return err
+	}
+	if !nodesEqual(node, &p.Value) {
+		out, err := yaml.Marshal(node)
+		if err != nil {
+			return fmt.Errorf("value at %q does not match the expected value", p.Path)
+		}
+		return fmt.Errorf("value at %q does not match the expected value, got:\n%s", p.Path, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// nodesEqual returns true if a and b have the same kind, tag, and value, recursively.
+// The style, position, and comments of the nodes are ignored, and so is the order of the keys in a mapping.
+func nodesEqual(a, b *yaml.Node) bool {
+	a, b = resolveNode(a), resolveNode(b)
+	if a.Kind != b.Kind || a.ShortTag() != b.ShortTag() || a.Value != b.Value || len(a.Content) != len(b.Content) {
+		return false
+	}
+	if a.Kind != yaml.MappingNode {
+		for i := range a.Content {
+			if !nodesEqual(a.Content[i], b.Content[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	for i := 0; i < len(a.Content); i += 2 {
+		matched := false
+		for j := 0; j < len(b.Content); j += 2 {
+			if nodesEqual(a.Content[i], b.Content[j]) {
+				matched = nodesEqual(a.Content[i+1], b.Content[j+1])
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveNode returns the node that an alias refers to, or the content of a document.
+func resolveNode(node *yaml.Node) *yaml.Node {
+	for {
+		switch {
+		case node.Kind == yaml.AliasNode && node.Alias != nil:
+			node = node.Alias
+		case node.Kind == yaml.DocumentNode && len(node.Content) == 1:
+			node = node.Content[0]
+		default:
+			return node
+		}
+	}
+}
+
+// copyNode returns a deep copy of node.
+func copyNode(node *yaml.Node) *yaml.Node {
+	cp := *node
+	if node.Content == nil {
+		return &cp
+	}
+	cp.Content = make([]*yaml.Node, len(node.Content))
+	for i := range node.Content {
+		cp.Content[i] = copyNode(node.Content[i])
+	}
+	return &cp
+}
+
 type pointer []string
 
 // parent returns a pointer to the parent of p.
@@ -249,6 +384,19 @@ func (p pointer) parent() pointer {
 	return p[:len(p)-1]
 }
 
+// isProperPrefixOf returns true if other points to a child of p.
+func (p pointer) isProperPrefixOf(other pointer) bool {
+	if len(p) >= len(other) {
+		return false
+	}
+	for i := range p {
+		if p[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (p pointer) finalKey() string {
 	if len(p) == 0 {
 		return ""
@@ -257,7 +405,25 @@ func (p pointer) finalKey() string {
 }
 
 func (y yamlPatch) pointer() pointer {
-	split := strings.Split(y.Path, "/")
+	return parsePointer(y.Path)
+}
+
+func (y yamlPatch) fromPointer() pointer {
+	return parsePointer(y.From)
+}
+
+// String returns the pointer in JSON Pointer syntax.
+func (p pointer) String() string {
+	escaped := make([]string, len(p))
+	for i := range p {
+		escaped[i] = strings.ReplaceAll(p[i], "~", "~0")
+		escaped[i] = strings.ReplaceAll(escaped[i], "/", "~1")
+	}
+	return strings.Join(escaped, jsonPointerSeparator)
+}
+
+func parsePointer(path string) pointer {
+	split := strings.Split(path, "/")
 	for i := range split {
 		// apply replacements as described https://www.rfc-editor.org/rfc/rfc6901#section-4
 		split[i] = strings.ReplaceAll(split[i], "~1", "/")
@@ -0,0 +1,156 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package override
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+const pointerWildcard = "*"
+
+// resourceSelector selects the resources of a CloudFormation template.
+type resourceSelector struct {
+	// Type of the resources, such as "AWS::IAM::Role". Shell patterns such as "AWS::Logs::*" are supported.
+	Type string `yaml:"type"`
+}
+
+// applyAll expands the patch to every node matched by its resource selector and wildcards, and applies it to each of them.
+func (p *yamlPatch) applyAll(root *yaml.Node) error {
+	patches, err := p.expand(root)
+	if err != nil {
+		return err
+	}
+	if len(patches) == 0 && !p.Optional {
+		if p.Resources != nil {
+			return fmt.Errorf("path %q does not match any node of the resources of type %q", p.Path, p.Resources.Type)
+		}
+		return fmt.Errorf("path %q does not match any node", p.Path)
+	}
+	// Apply in reverse order so that the indices of the remaining matches in a sequence aren't shifted.
+	for i := len(patches) - 1; i >= 0; i-- {
+		patch := patches[i] // needed because operations use pointer to patch.Value
+		if err := patch.apply(root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expand returns a patch for each node matched by the patch.
+// A patch without a resource selector or wildcards is returned as is.
+// Wildcards are only expanded in the path, since a wildcard in from couldn't be paired with the matches of the path.
+func (p *yamlPatch) expand(root *yaml.Node) ([]yamlPatch, error) {
+	if hasWildcard(p.fromPointer()) {
+		return nil, fmt.Errorf("from %q: wildcards are only supported in path", p.From)
+	}
+	if p.Resources == nil && !hasWildcard(p.pointer()) {
+		return []yamlPatch{*p}, nil
+	}
+	bases := []pointer{{""}}
+	if p.Resources != nil {
+		ids, err := p.Resources.match(root)
+		if err != nil {
+			return nil, err
+		}
+		bases = nil
+		for _, id := range ids {
+			bases = append(bases, pointer{"", "Resources", id})
+		}
+	}
+	var patches []yamlPatch
+	for _, base := range bases {
+		for _, ptr := range expandWildcards(root, nil, append(base, p.pointer()[1:]...)) {
+			patch := *p
+			patch.Path = ptr.String()
+			patch.Value = *copyNode(&p.Value)
+			patch.Resources = nil
+			if p.From != "" {
+				patch.From = append(append(pointer{}, base...), p.fromPointer()[1:]...).String()
+			}
+			patches = append(patches, patch)
+		}
+	}
+	return patches, nil
+}
+
+// match returns the logical IDs of the resources selected in the template.
+func (s *resourceSelector) match(root *yaml.Node) ([]string, error) {
+	if s.Type == "" {
+		return nil, fmt.Errorf("resources: type required")
+	}
+	if _, err := path.Match(s.Type, ""); err != nil {
+		return nil, fmt.Errorf("resources: invalid type pattern %q: %w", s.Type, err)
+	}
+	resources, err := findNodeWithPointer(root, pointer{"", "Resources"}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resources.Kind != yaml.MappingNode {
+		return nil, &errInvalidNodeKind{
+			pointer: pointer{"", "Resources"},
+			kind:    resources.Kind,
+		}
+	}
+	var ids []string
+	for i := 0; i < len(resources.Content); i += 2 {
+		resource := resources.Content[i+1]
+		if resource.Kind != yaml.MappingNode {
+			continue
+		}
+		idx, err := findInMap(resource, "Type", nil)
+		if err != nil {
+			continue
+		}
+		if ok, _ := path.Match(s.Type, resource.Content[idx+1].Value); ok {
+			ids = append(ids, resources.Content[i].Value)
+		}
+	}
+	return ids, nil
+}
+
+func hasWildcard(p pointer) bool {
+	for _, key := range p {
+		if key == pointerWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// expandWildcards returns the pointers obtained by replacing each wildcard of remaining
+// with the keys of the map, or the indices of the sequence, at that position.
+// Branches that don't exist in the document are left out.
+func expandWildcards(root *yaml.Node, traversed, remaining pointer) []pointer {
+	for i, key := range remaining {
+		if key != pointerWildcard {
+			continue
+		}
+		prefix := append(append(pointer{}, traversed...), remaining[:i]...)
+		node, err := findNodeWithPointer(root, prefix, nil)
+		if err != nil {
+			return nil
+		}
+		var keys []string
+		switch node.Kind {
+		case yaml.MappingNode:
+			for j := 0; j < len(node.Content); j += 2 {
+				keys = append(keys, node.Content[j].Value)
+			}
+		case yaml.SequenceNode:
+			for j := range node.Content {
+				keys = append(keys, strconv.Itoa(j))
+			}
+		}
+		var pointers []pointer
+		for _, k := range keys {
+			pointers = append(pointers, expandWildcards(root, append(append(pointer{}, prefix...), k), remaining[i+1:])...)
+		}
+		return pointers
+	}
+	return []pointer{append(append(pointer{}, traversed...), remaining...)}
+}
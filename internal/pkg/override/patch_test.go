@@ -398,6 +398,439 @@ a:
 a:
   b: value`,
 		},
+		"move a key in a map": {
+			yaml: `
+Resources:
+  TaskDef:
+    Properties:
+      Cpu: 256
+    Metadata:
+      Memory: 512`,
+			overrides: `
+- op: move
+  from: /Resources/TaskDef/Metadata/Memory
+  path: /Resources/TaskDef/Properties/Memory`,
+			expected: `
+Resources:
+  TaskDef:
+    Properties:
+      Cpu: 256
+      Memory: 512
+    Metadata: {}`,
+		},
+		"move an item within a sequence": {
+			yaml: `
+List:
+  - a
+  - b
+  - c`,
+			overrides: `
+- op: move
+  from: /List/0
+  path: /List/-`,
+			expected: `
+List:
+  - b
+  - c
+  - a`,
+		},
+		"copy a map": {
+			yaml: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      DelaySeconds: 5`,
+			overrides: `
+- op: copy
+  from: /Resources/Queue
+  path: /Resources/DeadLetterQueue
+- op: replace
+  path: /Resources/DeadLetterQueue/Properties/DelaySeconds
+  value: 0`,
+			expected: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      DelaySeconds: 5
+  DeadLetterQueue:
+    Type: AWS::SQS::Queue
+    Properties:
+      DelaySeconds: 0`,
+		},
+		"test passes before patching": {
+			yaml: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      Tags:
+        - Key: team
+          Value: api`,
+			overrides: `
+- op: test
+  path: /Resources/Service/Type
+  value: AWS::ECS::Service
+- op: test
+  path: /Resources/Service/Properties/Tags/0
+  value:
+    Value: api
+    Key: team
+- op: replace
+  path: /Resources/Service/Properties/Tags/0/Value
+  value: web`,
+			expected: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      Tags:
+        - Key: team
+          Value: web`,
+		},
+		"error if the test does not pass": {
+			yaml: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			overrides: `
+- op: test
+  path: /Resources/Service/Type
+  value: AWS::Lambda::Function`,
+			expectedErr: `unable to apply the "test" patch at index 0: value at "/Resources/Service/Type" does not match the expected value, got:
+AWS::ECS::Service`,
+		},
+		"test passes if the tags match": {
+			yaml: `
+Resources:
+  Service:
+    Properties:
+      Cluster: !Ref Cluster
+      TaskDefinition: !GetAtt TaskDefinition.Arn
+      ServiceName: "api"
+      Tags:
+        - Key: !Sub ${AWS::StackName}
+          Value: api`,
+			overrides: `
+- op: test
+  path: /Resources/Service/Properties/Cluster
+  value: !Ref Cluster
+- op: test
+  path: /Resources/Service/Properties/TaskDefinition
+  value: !GetAtt TaskDefinition.Arn
+- op: test
+  path: /Resources/Service/Properties/ServiceName
+  value: api
+- op: test
+  path: /Resources/Service/Properties/Tags
+  value:
+    - Value: 'api'
+      Key: !Sub "${AWS::StackName}"`,
+			expected: `
+Resources:
+  Service:
+    Properties:
+      Cluster: !Ref Cluster
+      TaskDefinition: !GetAtt TaskDefinition.Arn
+      ServiceName: "api"
+      Tags:
+        - Key: !Sub ${AWS::StackName}
+          Value: api`,
+		},
+		"error if only the tag differs from !Ref": {
+			yaml: `
+Resources:
+  Service:
+    Properties:
+      Cluster: !Ref Foo`,
+			overrides: `
+- op: test
+  path: /Resources/Service/Properties/Cluster
+  value: !Sub Foo`,
+			expectedErr: `unable to apply the "test" patch at index 0: value at "/Resources/Service/Properties/Cluster" does not match the expected value, got:
+!Ref Foo`,
+		},
+		"error if only the tag differs from !Sub": {
+			yaml: `
+Resources:
+  Service:
+    Properties:
+      Cluster: !Sub Foo`,
+			overrides: `
+- op: test
+  path: /Resources/Service/Properties/Cluster
+  value: !GetAtt Foo`,
+			expectedErr: `unable to apply the "test" patch at index 0: value at "/Resources/Service/Properties/Cluster" does not match the expected value, got:
+!Sub Foo`,
+		},
+		"error if only the tag differs from !GetAtt": {
+			yaml: `
+Resources:
+  Service:
+    Properties:
+      Cluster: !GetAtt Foo`,
+			overrides: `
+- op: test
+  path: /Resources/Service/Properties/Cluster
+  value: "Foo"`,
+			expectedErr: `unable to apply the "test" patch at index 0: value at "/Resources/Service/Properties/Cluster" does not match the expected value, got:
+!GetAtt Foo`,
+		},
+		"error if only the tag differs from a string": {
+			yaml: `
+Resources:
+  Service:
+    Properties:
+      Cluster: "Foo"`,
+			overrides: `
+- op: test
+  path: /Resources/Service/Properties/Cluster
+  value: !Ref Foo`,
+			expectedErr: `unable to apply the "test" patch at index 0: value at "/Resources/Service/Properties/Cluster" does not match the expected value, got:
+"Foo"`,
+		},
+		"error if only the tag of a nested value differs": {
+			yaml: `
+Resources:
+  Service:
+    Properties:
+      Tags:
+        - Key: team
+          Value: !Ref Team`,
+			overrides: `
+- op: test
+  path: /Resources/Service/Properties/Tags/0
+  value:
+    Key: team
+    Value: Team`,
+			expectedErr: `unable to apply the "test" patch at index 0: value at "/Resources/Service/Properties/Tags/0" does not match the expected value, got:
+Key: team
+Value: !Ref Team`,
+		},
+		"error if the value to test does not exist": {
+			yaml: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			overrides: `
+- op: test
+  path: /Resources/Queue
+  value: {}`,
+			expectedErr: `unable to apply the "test" patch at index 0: key "/Resources": "Queue" not found in map`,
+		},
+		"error on move without from": {
+			yaml: `
+a: b`,
+			overrides: `
+- op: move
+  path: /c`,
+			expectedErr: `unable to apply the "move" patch at index 0: from required`,
+		},
+		"error on move into a child": {
+			yaml: `
+a:
+  b: c`,
+			overrides: `
+- op: move
+  from: /a
+  path: /a/b/d`,
+			expectedErr: `unable to apply the "move" patch at index 0: cannot move "/a" into one of its children "/a/b/d"`,
+		},
+		"error on copy from a path that does not exist": {
+			yaml: `
+a:
+  b: c`,
+			overrides: `
+- op: copy
+  from: /a/d
+  path: /e`,
+			expectedErr: `unable to apply the "copy" patch at index 0: key "/a": "d" not found in map`,
+		},
+		"add to every resource of a type": {
+			yaml: `
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 30
+  FirelensLogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 7
+  Service:
+    Type: AWS::ECS::Service`,
+			overrides: `
+- op: add
+  resources:
+    type: AWS::Logs::LogGroup
+  path: /Properties/RetentionInDays
+  value: 90`,
+			expected: `
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 90
+  FirelensLogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 90
+  Service:
+    Type: AWS::ECS::Service`,
+		},
+		"wildcards in a path with a type pattern": {
+			yaml: `
+Resources:
+  TaskRole:
+    Type: AWS::IAM::Role
+    Properties:
+      Policies:
+        - PolicyName: a
+          PolicyDocument:
+            Version: "2012-10-17"
+        - PolicyName: b
+          PolicyDocument:
+            Version: "2012-10-17"
+  ExecutionPolicy:
+    Type: AWS::IAM::Policy
+    Properties:
+      Policies:
+        - PolicyName: c
+          PolicyDocument:
+            Version: "2012-10-17"
+  Queue:
+    Type: AWS::SQS::Queue`,
+			overrides: `
+- op: add
+  resources:
+    type: AWS::IAM::*
+  path: /Properties/Policies/*/PolicyDocument/Id
+  value: copilot`,
+			expected: `
+Resources:
+  TaskRole:
+    Type: AWS::IAM::Role
+    Properties:
+      Policies:
+        - PolicyName: a
+          PolicyDocument:
+            Version: "2012-10-17"
+            Id: copilot
+        - PolicyName: b
+          PolicyDocument:
+            Version: "2012-10-17"
+            Id: copilot
+  ExecutionPolicy:
+    Type: AWS::IAM::Policy
+    Properties:
+      Policies:
+        - PolicyName: c
+          PolicyDocument:
+            Version: "2012-10-17"
+            Id: copilot
+  Queue:
+    Type: AWS::SQS::Queue`,
+		},
+		"remove every item of a sequence with a wildcard": {
+			yaml: `
+Resources:
+  Service:
+    Properties:
+      Tags:
+        - a
+        - b
+        - c`,
+			overrides: `
+- op: remove
+  path: /Resources/Service/Properties/Tags/*`,
+			expected: `
+Resources:
+  Service:
+    Properties:
+      Tags: []`,
+		},
+		"test every resource of a type": {
+			yaml: `
+Resources:
+  A:
+    Type: AWS::SQS::Queue
+    Properties:
+      SqsManagedSseEnabled: true
+  B:
+    Type: AWS::SQS::Queue
+    Properties:
+      SqsManagedSseEnabled: false`,
+			overrides: `
+- op: test
+  resources:
+    type: AWS::SQS::Queue
+  path: /Properties/SqsManagedSseEnabled
+  value: true`,
+			expectedErr: `unable to apply the "test" patch at index 0: value at "/Resources/B/Properties/SqsManagedSseEnabled" does not match the expected value, got:
+false`,
+		},
+		"optional selector that matches nothing": {
+			yaml: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			overrides: `
+- op: remove
+  resources:
+    type: AWS::Logs::LogGroup
+  path: /Properties/RetentionInDays
+  optional: true`,
+			expected: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+		},
+		"error if a selector matches nothing": {
+			yaml: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			overrides: `
+- op: remove
+  resources:
+    type: AWS::Logs::LogGroup
+  path: /Properties/RetentionInDays`,
+			expectedErr: `unable to apply the "remove" patch at index 0: path "/Properties/RetentionInDays" does not match any node of the resources of type "AWS::Logs::LogGroup"`,
+		},
+		"error if a wildcard matches nothing": {
+			yaml: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			overrides: `
+- op: replace
+  path: /Resources/Service/Properties/*
+  value: 1`,
+			expectedErr: `unable to apply the "replace" patch at index 0: path "/Resources/Service/Properties/*" does not match any node`,
+		},
+		"error on a wildcard in from": {
+			yaml: `
+Resources:
+  Service:
+    Properties:
+      Tags:
+        - Key: a`,
+			overrides: `
+- op: copy
+  from: /Resources/Service/Properties/Tags/*
+  path: /Resources/Service/Properties/Labels`,
+			expectedErr: `unable to apply the "copy" patch at index 0: from "/Resources/Service/Properties/Tags/*": wildcards are only supported in path`,
+		},
+		"error on a selector without a type": {
+			yaml: `
+Resources: {}`,
+			overrides: `
+- op: remove
+  resources: {}
+  path: /Properties`,
+			expectedErr: `unable to apply the "remove" patch at index 0: resources: type required`,
+		},
 		"error on invalid patch file format": {
 			overrides: `
 op: add
@@ -411,7 +844,7 @@ value: new`,
 - op: unsupported
   path: /
   value: new`,
-			expectedErr: `unsupported operation "unsupported": supported operations are "add", "remove", and "replace".`,
+			expectedErr: `unsupported operation "unsupported": supported operations are "add", "remove", "replace", "move", "copy", and "test".`,
 		},
 		"error in map following path": {
 			yaml: `
@@ -15,11 +15,61 @@ import (
 // Tree represents a difference tree between two YAML documents.
 type Tree struct {
 	root diffNode
+
+	// The documents that are compared.
+	old, new *yaml.Node
 }
 
-func (t Tree) Write(w io.Writer) error {
+// WriteOption is a functional option to configure how a Tree is written.
+type WriteOption func(*writeOpts)
+
+type writeOpts struct {
+	json    bool
+	summary bool
+	layers  []Layer
+}
+
+// WithJSON writes the tree as a JSON array of changes instead of the human-readable diff.
+func WithJSON() WriteOption {
+	return func(opts *writeOpts) {
+		opts.json = true
+	}
+}
+
+// WithSummary writes a summary of the changes to CloudFormation resources after the human-readable diff.
+func WithSummary() WriteOption {
+	return func(opts *writeOpts) {
+		opts.summary = true
+	}
+}
+
+// Write writes the human-readable representation of the tree to w, or its JSON representation if WithJSON is set.
+// Nothing is written if there are no differences.
+func (t Tree) Write(w io.Writer, opts ...WriteOption) error {
+	var o writeOpts
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.json {
+		jw := &jsonTreeWriter{t, w, o.layers}
+		return jw.write()
+	}
 	tw := &treeWriter{t, w}
-	return tw.write()
+	if err := tw.write(); err != nil {
+		return err
+	}
+	if t.root == nil {
+		return nil
+	}
+	if len(o.layers) > 0 {
+		if err := t.writeLayers(w, o.layers); err != nil {
+			return err
+		}
+	}
+	if !o.summary {
+		return nil
+	}
+	return t.writeSummary(w)
 }
 
 // diffNode is the interface to represents the difference between two *yaml.Node.
@@ -83,6 +133,28 @@ type seqItemNode struct {
 	keyNode
 }
 
+// ignoredNode represents items of a sequence whose differences are ignored.
+// It counts the ignored items of the old and new sequences, so that the indices of the next items stay right.
+type ignoredNode struct {
+	from, to int
+}
+
+func (n *ignoredNode) children() []diffNode {
+	return nil
+}
+
+func (n *ignoredNode) key() string {
+	return ""
+}
+
+func (n *ignoredNode) newYAML() *yaml.Node {
+	return nil
+}
+
+func (n *ignoredNode) oldYAML() *yaml.Node {
+	return nil
+}
+
 // From is the YAML document that another YAML document is compared against.
 type From []byte
 
@@ -90,8 +162,9 @@ type From []byte
 // overriders designed for CFN documents, including:
 // 1. An ignorer that ignores diffs under "Metadata.Manifest".
 // 2. An overrider that is able to compare intrinsic functions with full/short form correctly.
-func (from From) ParseWithCFNOverriders(to []byte) (Tree, error) {
-	return from.Parse(to,
+// The differences matched by the ignore rules are removed from the tree.
+func (from From) ParseWithCFNOverriders(to []byte, rules ...IgnoreRule) (Tree, error) {
+	tree, err := from.Parse(to,
 		&ignorer{
 			curr: &ignoreSegment{
 				key: "Metadata",
@@ -102,6 +175,15 @@ func (from From) ParseWithCFNOverriders(to []byte) (Tree, error) {
 		},
 		&getAttConverter{},
 		&intrinsicFuncMapTagConverter{})
+	if err != nil || tree.root == nil || len(rules) == 0 {
+		return tree, err
+	}
+	root, err := prune(tree.root, tree.old, tree.new, nil, rules)
+	if err != nil {
+		return Tree{}, fmt.Errorf("ignore differences: %w", err)
+	}
+	tree.root = root
+	return tree, nil
 }
 
 // Parse constructs a diff tree that represent the differences of a YAML document against the From document.
@@ -134,6 +216,8 @@ func (from From) Parse(to []byte, overriders ...overrider) (Tree, error) {
 	}
 	return Tree{
 		root: root,
+		old:  &fromNode,
+		new:  &toNode,
 	}, nil
 }
 
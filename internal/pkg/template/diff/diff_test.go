@@ -339,7 +339,7 @@ Dog: (pleased) "ikr"`, t),
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
 				require.NoError(t, err)
-				require.True(t, equalTree(got, Tree{tc.wanted()}, t), "should get the expected tree")
+				require.True(t, equalTree(got, Tree{root: tc.wanted()}, t), "should get the expected tree")
 			}
 		})
 	}
@@ -431,7 +431,7 @@ Metadata:
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
 				require.NoError(t, err)
-				require.True(t, equalTree(got, Tree{tc.wanted()}, t), "should get the expected tree")
+				require.True(t, equalTree(got, Tree{root: tc.wanted()}, t), "should get the expected tree")
 			}
 		})
 	}
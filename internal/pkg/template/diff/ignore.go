@@ -0,0 +1,358 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const ignorePathWildcard = "*"
+
+// IgnoreRule matches the paths of the differences to ignore.
+// Paths are JSON pointers such as "/Resources/Service/Properties/DesiredCount".
+type IgnoreRule struct {
+	tokens []string       // Reference tokens of the path, where "*" matches any token.
+	regexp *regexp.Regexp // Matched against the whole path.
+}
+
+// IgnorePath returns a rule that ignores the differences under the path, for example "/Resources/*/Properties/TemplateURL".
+// The leading "/" is optional, and a "*" matches any key or sequence index.
+func IgnorePath(path string) IgnoreRule {
+	return IgnoreRule{
+		tokens: strings.Split(strings.TrimPrefix(path, "/"), "/"),
+	}
+}
+
+// IgnoreRegexp returns a rule that ignores the differences under the paths matched by the regular expression,
+// for example `^/Resources/TaskDefinition/Properties/ContainerDefinitions/\d+/Image$`.
+func IgnoreRegexp(expr string) (IgnoreRule, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return IgnoreRule{}, fmt.Errorf("compile regular expression %q: %w", expr, err)
+	}
+	return IgnoreRule{
+		regexp: re,
+	}, nil
+}
+
+// ParseIgnoreRules parses the rules of a diff ignore file in the format:
+//
+//	paths:
+//	  - /Resources/*/Properties/TemplateURL
+//	regexes:
+//	  - ^/Resources/TaskDefinition/Properties/ContainerDefinitions/\d+/Image$
+func ParseIgnoreRules(in []byte) ([]IgnoreRule, error) {
+	var file struct {
+		Paths   []string `yaml:"paths"`
+		Regexes []string `yaml:"regexes"`
+	}
+	if err := yaml.Unmarshal(in, &file); err != nil {
+		return nil, fmt.Errorf("unmarshal ignore rules: %w", err)
+	}
+	rules := make([]IgnoreRule, 0, len(file.Paths)+len(file.Regexes))
+	for _, path := range file.Paths {
+		rules = append(rules, IgnorePath(path))
+	}
+	for _, expr := range file.Regexes {
+		rule, err := IgnoreRegexp(expr)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// matches returns true if the rule matches the path made of the reference tokens.
+func (r IgnoreRule) matches(tokens []string) bool {
+	if r.regexp != nil {
+		if len(tokens) == 0 {
+			return r.regexp.MatchString("")
+		}
+		return r.regexp.MatchString("/" + strings.Join(tokens, "/"))
+	}
+	if len(tokens) != len(r.tokens) {
+		return false
+	}
+	for i, token := range tokens {
+		if r.tokens[i] != ignorePathWildcard && r.tokens[i] != token {
+			return false
+		}
+	}
+	return true
+}
+
+// ignored returns true if any of the rules matches the path made of the reference tokens.
+func ignored(tokens []string, rules []IgnoreRule) bool {
+	for _, rule := range rules {
+		if rule.matches(tokens) {
+			return true
+		}
+	}
+	return false
+}
+
+// prune returns the diff node without the differences under the paths matched by the rules,
+// or nil if none of its differences are left.
+// The from and to nodes are the old and new YAML values of the diff node, if they can be found.
+//
+// The items of a sequence are diffed again with the ignored paths removed from both sides,
+// so that items are paired by matching elements rather than by their position.
+func prune(node diffNode, from, to *yaml.Node, tokens []string, rules []IgnoreRule) (diffNode, error) {
+	if ignored(tokens, rules) {
+		return nil, nil
+	}
+	if len(node.children()) == 0 {
+		return node, nil
+	}
+	var children []diffNode
+	var err error
+	if from != nil && to != nil && from.Kind == yaml.SequenceNode && to.Kind == yaml.SequenceNode {
+		children, err = pruneSequence(from, to, tokens, rules)
+	} else {
+		children, err = pruneChildren(node, from, to, tokens, rules)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !hasDiff(children) {
+		return nil, nil
+	}
+	if item, ok := node.(*seqItemNode); ok {
+		return &seqItemNode{
+			keyNode{
+				keyValue:   item.key(),
+				childNodes: children,
+			},
+		}, nil
+	}
+	return &keyNode{
+		keyValue:   node.key(),
+		childNodes: children,
+	}, nil
+}
+
+// pruneChildren prunes the children of a diff node one by one.
+func pruneChildren(node diffNode, from, to *yaml.Node, tokens []string, rules []IgnoreRule) ([]diffNode, error) {
+	var children []diffNode
+	var indices seqIndices
+	for _, child := range node.children() {
+		if unchanged, ok := child.(*unchangedNode); ok {
+			indices.skip(unchanged)
+			children = appendUnchanged(children, unchanged.unchangedCount())
+			continue
+		}
+		var childFrom, childTo *yaml.Node
+		if !isSeqItem(child) {
+			childFrom, childTo = mappingValue(from, child.key()), mappingValue(to, child.key())
+		}
+		added, removed := child.oldYAML() == nil && child.newYAML() != nil, child.newYAML() == nil && child.oldYAML() != nil
+		pruned, err := prune(child, childFrom, childTo, append(tokens[:len(tokens):len(tokens)], indices.token(child)), rules)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case pruned != nil:
+			children = append(children, pruned)
+		case !isSeqItem(child):
+		case added:
+			children = append(children, &ignoredNode{to: 1})
+		case removed:
+			children = append(children, &ignoredNode{from: 1})
+		default:
+			// The ignored item is left in the sequence, so it is now unchanged.
+			children = appendUnchanged(children, 1)
+		}
+	}
+	return children, nil
+}
+
+// pruneSequence diffs the items of two sequences that aren't ignored, with their ignored paths removed.
+// The ignored items are kept in the returned nodes as ignoredNode so that the indices of the other items are unchanged.
+func pruneSequence(from, to *yaml.Node, tokens []string, rules []IgnoreRule) ([]diffNode, error) {
+	fromItems, fromIndices := prunedItems(from, tokens, rules)
+	toItems, toIndices := prunedItems(to, tokens, rules)
+	overriders := func() []overrider {
+		return []overrider{&getAttConverter{}, &intrinsicFuncMapTagConverter{}}
+	}
+	var parseErr error
+	equal := make(map[string]bool)
+	lcsIndices := longestCommonSubsequence(fromItems, toItems, func(idxFrom, idxTo int) bool {
+		if eq, ok := equal[cacheKey(idxFrom, idxTo)]; ok {
+			return eq
+		}
+		diff, err := parse(&fromItems[idxFrom], &toItems[idxTo], "", overriders()...)
+		if err != nil {
+			parseErr = err
+		}
+		equal[cacheKey(idxFrom, idxTo)] = err == nil && diff == nil
+		return err == nil && diff == nil
+	})
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	var children []diffNode
+	var nextFrom, nextTo int // Indices of the next items in the original sequences.
+	skipTo := func(fromIdx, toIdx int) {
+		if fromIdx > nextFrom || toIdx > nextTo {
+			children = append(children, &ignoredNode{from: fromIdx - nextFrom, to: toIdx - nextTo})
+			nextFrom, nextTo = fromIdx, toIdx
+		}
+	}
+	inspector := newLCSStateMachine(fromItems, toItems, lcsIndices)
+	for action := inspector.action(); action != actionDone; action = inspector.action() {
+		switch action {
+		case actionMatch:
+			fromIdx, toIdx := fromIndices[inspector.fromIndex()], toIndices[inspector.toIndex()]
+			skipTo(fromIdx, toIdx)
+			children = appendUnchanged(children, 1)
+			nextFrom, nextTo = fromIdx+1, toIdx+1
+		case actionMod:
+			fromIdx, toIdx := fromIndices[inspector.fromIndex()], toIndices[inspector.toIndex()]
+			skipTo(fromIdx, toIdx)
+			fromItem, toItem := from.Content[fromIdx], to.Content[toIdx]
+			diff, err := parse(fromItem, toItem, "", overriders()...)
+			if err != nil {
+				return nil, err
+			}
+			nextFrom, nextTo = fromIdx+1, toIdx+1
+			if diff == nil {
+				children = appendUnchanged(children, 1)
+				break
+			}
+			item := &seqItemNode{
+				keyNode{
+					childNodes: diff.children(),
+					oldV:       diff.oldYAML(),
+					newV:       diff.newYAML(),
+				},
+			}
+			pruned, err := prune(item, fromItem, toItem, append(tokens[:len(tokens):len(tokens)], strconv.Itoa(toIdx)), rules)
+			if err != nil {
+				return nil, err
+			}
+			if pruned == nil {
+				children = appendUnchanged(children, 1)
+			} else {
+				children = append(children, pruned)
+			}
+		case actionDel:
+			fromIdx := fromIndices[inspector.fromIndex()]
+			skipTo(fromIdx, nextTo)
+			children = append(children, &seqItemNode{
+				keyNode{
+					oldV: from.Content[fromIdx],
+				},
+			})
+			nextFrom++
+		case actionInsert:
+			toIdx := toIndices[inspector.toIndex()]
+			skipTo(nextFrom, toIdx)
+			children = append(children, &seqItemNode{
+				keyNode{
+					newV: to.Content[toIdx],
+				},
+			})
+			nextTo++
+		}
+		inspector.next()
+	}
+	skipTo(len(from.Content), len(to.Content))
+	return children, nil
+}
+
+// prunedItems returns the items of a sequence that aren't ignored with their ignored paths removed,
+// along with the index of each item in the sequence.
+func prunedItems(seq *yaml.Node, tokens []string, rules []IgnoreRule) ([]yaml.Node, []int) {
+	var items []yaml.Node
+	var indices []int
+	for i, item := range seq.Content {
+		itemTokens := append(tokens[:len(tokens):len(tokens)], strconv.Itoa(i))
+		if ignored(itemTokens, rules) {
+			continue
+		}
+		items = append(items, *pruneYAML(item, itemTokens, rules))
+		indices = append(indices, i)
+	}
+	return items, indices
+}
+
+// pruneYAML returns a copy of the YAML node without the values under the paths matched by the rules.
+func pruneYAML(node *yaml.Node, tokens []string, rules []IgnoreRule) *yaml.Node {
+	pruned := *node
+	switch node.Kind {
+	case yaml.MappingNode:
+		pruned.Content = nil
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			valueTokens := append(tokens[:len(tokens):len(tokens)], escapePointerToken(node.Content[i].Value))
+			if ignored(valueTokens, rules) {
+				continue
+			}
+			pruned.Content = append(pruned.Content, node.Content[i], pruneYAML(node.Content[i+1], valueTokens, rules))
+		}
+	case yaml.SequenceNode:
+		pruned.Content = nil
+		for i, item := range node.Content {
+			itemTokens := append(tokens[:len(tokens):len(tokens)], strconv.Itoa(i))
+			if ignored(itemTokens, rules) {
+				continue
+			}
+			pruned.Content = append(pruned.Content, pruneYAML(item, itemTokens, rules))
+		}
+	}
+	return &pruned
+}
+
+// mappingValue returns the value of the key in a mapping or document node, or nil if there is none.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil {
+		return nil
+	}
+	if node.Kind == yaml.DocumentNode && len(node.Content) == 1 {
+		node = node.Content[0]
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// hasDiff returns true if any of the nodes is a difference, rather than unchanged or ignored items.
+func hasDiff(nodes []diffNode) bool {
+	for _, node := range nodes {
+		switch node.(type) {
+		case *unchangedNode, *ignoredNode:
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// appendUnchanged appends count unchanged items to the nodes, merging them with the last node if it is also unchanged.
+func appendUnchanged(nodes []diffNode, count int) []diffNode {
+	if len(nodes) > 0 {
+		if last, ok := nodes[len(nodes)-1].(*unchangedNode); ok {
+			nodes[len(nodes)-1] = &unchangedNode{count: last.unchangedCount() + count}
+			return nodes
+		}
+	}
+	return append(nodes, &unchangedNode{count: count})
+}
+
+func isSeqItem(node diffNode) bool {
+	_, ok := node.(*seqItemNode)
+	return ok
+}
@@ -0,0 +1,277 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIgnoreRules(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedMatches    []string
+		wantedNotMatches []string
+		wantedError      error
+	}{
+		"paths and regexes": {
+			in: `
+paths:
+  - /Resources/*/Properties/TemplateURL
+  - Outputs
+regexes:
+  - ^/Resources/TaskDefinition/Properties/ContainerDefinitions/\d+/Image$`,
+			wantedMatches: []string{
+				"/Resources/AddonsStack/Properties/TemplateURL",
+				"/Outputs",
+				"/Resources/TaskDefinition/Properties/ContainerDefinitions/1/Image",
+			},
+			wantedNotMatches: []string{
+				"/Resources/AddonsStack/Properties",
+				"/Resources/AddonsStack/Properties/TemplateURL/Fn::Sub",
+				"/Resources/TaskDefinition/Properties/ContainerDefinitions/sidecar/Image",
+			},
+		},
+		"empty file": {},
+		"error on invalid regex": {
+			in: `
+regexes:
+  - "[a-z"`,
+			wantedError: errors.New("compile regular expression \"[a-z\": error parsing regexp: missing closing ]: `[a-z`"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			rules, err := ParseIgnoreRules([]byte(tc.in))
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			matches := func(path string) bool {
+				for _, rule := range rules {
+					if rule.matches(strings.Split(strings.TrimPrefix(path, "/"), "/")) {
+						return true
+					}
+				}
+				return false
+			}
+			for _, path := range tc.wantedMatches {
+				require.True(t, matches(path), "should match %s", path)
+			}
+			for _, path := range tc.wantedNotMatches {
+				require.False(t, matches(path), "should not match %s", path)
+			}
+		})
+	}
+}
+
+func Test_Integration_ParseWithCFNOverriders_IgnoreRules(t *testing.T) {
+	testCases := map[string]struct {
+		old   string
+		curr  string
+		rules []IgnoreRule
+
+		wanted string
+	}{
+		"ignore a path with wildcards": {
+			old: `
+Resources:
+  AddonsStack:
+    Type: AWS::CloudFormation::Stack
+    Properties:
+      TemplateURL: https://bucket.s3.amazonaws.com/old.yml
+  Service:
+    Properties:
+      DesiredCount: 1`,
+			curr: `
+Resources:
+  AddonsStack:
+    Type: AWS::CloudFormation::Stack
+    Properties:
+      TemplateURL: https://bucket.s3.amazonaws.com/new.yml
+  Service:
+    Properties:
+      DesiredCount: 2`,
+			rules: []IgnoreRule{IgnorePath("/Resources/*/Properties/TemplateURL")},
+			wanted: `
+~ Resources/Service/Properties:
+    ~ DesiredCount: 1 -> 2
+`,
+		},
+		"ignored items of a sequence become unchanged": {
+			old: `
+Containers:
+  - Name: main
+    Image: main@sha256:old
+  - Name: sidecar
+    Image: sidecar@sha256:old
+  - Name: logs
+    Essential: false`,
+			curr: `
+Containers:
+  - Name: main
+    Image: main@sha256:new
+  - Name: sidecar
+    Image: sidecar@sha256:new
+  - Name: logs
+    Essential: true`,
+			rules: []IgnoreRule{mustIgnoreRegexp(t, `^/Containers/\d+/Image$`)},
+			wanted: `
+~ Containers:
+    (2 unchanged items)
+    ~ - (changed item)
+      ~ Essential: false -> true
+`,
+		},
+		"items are paired by matching elements without their ignored paths": {
+			old: `
+Containers:
+  - Name: init
+    Image: init@sha256:old
+  - Name: main
+    Image: main@sha256:old`,
+			curr: `
+Containers:
+  - Name: main
+    Image: main@sha256:new
+  - Name: sidecar
+    Image: sidecar@sha256:new`,
+			rules: []IgnoreRule{mustIgnoreRegexp(t, `^/Containers/\d+/Image$`)},
+			wanted: `
+~ Containers:
+    - - Name: init
+    -   Image: init@sha256:old
+    (1 unchanged item)
+    + - Name: sidecar
+    +   Image: sidecar@sha256:new
+`,
+		},
+		"ignored insertions are removed": {
+			old: `
+Ports: [80]
+Name: old`,
+			curr: `
+Ports: [80, 443]
+Name: new`,
+			rules: []IgnoreRule{IgnorePath("Ports/*")},
+			wanted: `
+~ Name: old -> new
+`,
+		},
+		"ignore all differences": {
+			old: `
+Metadata:
+  Version: v1`,
+			curr: `
+Metadata:
+  Version: v2`,
+			rules: []IgnoreRule{IgnorePath("/Metadata")},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			tree, err := From(tc.old).ParseWithCFNOverriders([]byte(tc.curr), tc.rules...)
+
+			// THEN
+			require.NoError(t, err)
+			buf := strings.Builder{}
+			require.NoError(t, tree.Write(&buf))
+			require.Equal(t, strings.TrimPrefix(tc.wanted, "\n"), buf.String())
+		})
+	}
+}
+
+func TestTree_Changes_IgnoreRules(t *testing.T) {
+	testCases := map[string]struct {
+		old   string
+		curr  string
+		rules []IgnoreRule
+
+		wanted []Change
+	}{
+		"indices of the items after an ignored insertion are kept": {
+			old:   `Ports: [80]`,
+			curr:  `Ports: [80, 443, 8080]`,
+			rules: []IgnoreRule{IgnorePath("/Ports/1")},
+			wanted: []Change{
+				{Path: "/Ports/2", Kind: ChangeKindAdded, New: 8080},
+			},
+		},
+		"indices of the items paired without their ignored paths": {
+			old: `
+Containers:
+  - Name: init
+    Image: init@sha256:old
+  - Name: main
+    Image: main@sha256:old`,
+			curr: `
+Containers:
+  - Name: main
+    Image: main@sha256:new
+  - Name: sidecar
+    Image: sidecar@sha256:new`,
+			rules: []IgnoreRule{mustIgnoreRegexp(t, `^/Containers/\d+/Image$`)},
+			wanted: []Change{
+				{Path: "/Containers/0", Kind: ChangeKindRemoved, Old: map[string]interface{}{"Name": "init", "Image": "init@sha256:old"}},
+				{Path: "/Containers/1", Kind: ChangeKindAdded, New: map[string]interface{}{"Name": "sidecar", "Image": "sidecar@sha256:new"}},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tree, err := From(tc.old).ParseWithCFNOverriders([]byte(tc.curr), tc.rules...)
+			require.NoError(t, err)
+
+			// WHEN
+			got, err := tree.Changes()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestTree_Summary_IgnoreRules(t *testing.T) {
+	// GIVEN
+	tree, err := From(`
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1`).ParseWithCFNOverriders([]byte(`
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 2`), IgnorePath("/Resources/Bucket"))
+	require.NoError(t, err)
+
+	// WHEN
+	got, err := tree.Summary()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []ResourceChange{
+		{LogicalID: "Service", Type: "AWS::ECS::Service", Action: ResourceActionUpdate, Risk: RiskLow},
+	}, got)
+}
+
+func mustIgnoreRegexp(t *testing.T, expr string) IgnoreRule {
+	rule, err := IgnoreRegexp(expr)
+	require.NoError(t, err)
+	return rule
+}
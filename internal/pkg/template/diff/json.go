@@ -0,0 +1,227 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kinds of changes between two YAML documents.
+const (
+	ChangeKindAdded    = "added"
+	ChangeKindRemoved  = "removed"
+	ChangeKindModified = "modified"
+)
+
+// Change is a difference between two YAML documents at a path.
+type Change struct {
+	// Path is the JSON pointer to the changed value, for example "/Resources/Service/Properties/DesiredCount".
+	// Items of a sequence are identified by their index in the new document, or in the old document if they are removed.
+	Path string      `json:"path"`
+	Kind string      `json:"kind"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+	// Layer is the name of the layer that introduced the change, if the tree is written with layers.
+	Layer string `json:"layer,omitempty"`
+}
+
+// jsonTreeWriter writes the JSON representation of a diff tree.
+type jsonTreeWriter struct {
+	tree   Tree
+	writer io.Writer
+	layers []Layer
+}
+
+// write writes the changes of the diff tree as a JSON array.
+func (s *jsonTreeWriter) write() error {
+	if s.tree.root == nil {
+		return nil // Return without writing anything.
+	}
+	changes, err := s.tree.Changes()
+	if err != nil {
+		return err
+	}
+	if err := attributeLayers(changes, s.layers); err != nil {
+		return err
+	}
+	enc := json.NewEncoder(s.writer)
+	enc.SetIndent("", "  ")
+	return enc.Encode(changes)
+}
+
+// Changes returns the list of changes in the diff tree in the order they appear in the documents.
+func (t Tree) Changes() ([]Change, error) {
+	changes := []Change{}
+	if t.root == nil {
+		return changes, nil
+	}
+	if err := collectChanges(t.root, "", &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func collectChanges(node diffNode, path string, changes *[]Change) error {
+	if len(node.children()) == 0 {
+		change, err := leafChange(node, path)
+		if err != nil {
+			return err
+		}
+		*changes = append(*changes, change)
+		return nil
+	}
+	var indices seqIndices
+	for _, child := range node.children() {
+		switch skipped := child.(type) {
+		case *unchangedNode:
+			indices.skip(skipped)
+			continue
+		case *ignoredNode:
+			indices.ignore(skipped)
+			continue
+		}
+		if err := collectChanges(child, path+"/"+indices.token(child), changes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// seqIndices tracks the indices of the items of a sequence while iterating over the children of its diff node.
+type seqIndices struct {
+	from, to int
+}
+
+// skip moves the indices past unchanged items.
+func (s *seqIndices) skip(node *unchangedNode) {
+	s.from += node.unchangedCount()
+	s.to += node.unchangedCount()
+}
+
+// ignore moves the indices past ignored items.
+func (s *seqIndices) ignore(node *ignoredNode) {
+	s.from += node.from
+	s.to += node.to
+}
+
+// token returns the JSON pointer reference token of a child node: its escaped key, or for a sequence item,
+// its index in the new sequence, or in the old sequence if it is removed.
+func (s *seqIndices) token(node diffNode) string {
+	if _, ok := node.(*seqItemNode); !ok {
+		return escapePointerToken(node.key())
+	}
+	idx := s.to
+	switch {
+	case node.newYAML() == nil && node.oldYAML() != nil:
+		idx = s.from
+		s.from++
+	case node.oldYAML() == nil && node.newYAML() != nil:
+		s.to++
+	default:
+		s.from++
+		s.to++
+	}
+	return strconv.Itoa(idx)
+}
+
+func leafChange(node diffNode, path string) (Change, error) {
+	oldV, err := jsonValue(node.oldYAML())
+	if err != nil {
+		return Change{}, fmt.Errorf("convert old value at %q: %w", path, err)
+	}
+	newV, err := jsonValue(node.newYAML())
+	if err != nil {
+		return Change{}, fmt.Errorf("convert new value at %q: %w", path, err)
+	}
+	change := Change{
+		Path: path,
+		Kind: ChangeKindModified,
+		Old:  oldV,
+		New:  newV,
+	}
+	switch {
+	case node.oldYAML() == nil:
+		change.Kind = ChangeKindAdded
+	case node.newYAML() == nil:
+		change.Kind = ChangeKindRemoved
+	}
+	return change, nil
+}
+
+// jsonValue converts a YAML node to a value that can be marshaled to JSON.
+// Intrinsic functions in short form, such as "!Ref Service", are converted to their full form, such as {"Ref": "Service"}.
+func jsonValue(node *yaml.Node) (interface{}, error) {
+	if node == nil {
+		return nil, nil
+	}
+	var val interface{}
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return jsonValue(node.Content[0])
+	case yaml.AliasNode:
+		return jsonValue(node.Alias)
+	case yaml.SequenceNode:
+		items := make([]interface{}, len(node.Content))
+		for i, item := range node.Content {
+			v, err := jsonValue(item)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = v
+		}
+		val = items
+	case yaml.MappingNode:
+		m := make(map[string]interface{}, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			v, err := jsonValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			m[node.Content[i].Value] = v
+		}
+		val = m
+	default:
+		scalar := node
+		if isCustomTag(node.Tag) {
+			scalar = stripTag(node)
+		}
+		if err := scalar.Decode(&val); err != nil {
+			return nil, fmt.Errorf("decode %q: %w", node.Value, err)
+		}
+	}
+	if _, ok := intrinsicFunctionShortNames[node.Tag]; ok {
+		return map[string]interface{}{
+			intrinsicFuncFullName(strings.TrimPrefix(node.Tag, "!")): val,
+		}, nil
+	}
+	return val, nil
+}
+
+// isCustomTag returns true if the tag is neither empty nor one of the standard YAML tags like "!!str".
+func isCustomTag(tag string) bool {
+	return tag != "" && !strings.HasPrefix(tag, "!!")
+}
+
+// intrinsicFuncFullName returns the full-form name of an intrinsic function, for example "Fn::GetAtt" for "GetAtt".
+func intrinsicFuncFullName(name string) string {
+	if _, ok := intrinsicFunctionFullNames[name]; ok {
+		return name
+	}
+	return "Fn::" + name
+}
+
+// escapePointerToken escapes a key to be used as a JSON pointer reference token.
+// See https://www.rfc-editor.org/rfc/rfc6901#section-3.
+func escapePointerToken(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
@@ -0,0 +1,144 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree_Write_WithJSON(t *testing.T) {
+	testCases := map[string]struct {
+		old  string
+		curr string
+
+		wanted string
+	}{
+		"no changes": {
+			old:    `Mary: 168`,
+			curr:   `Mary: 168`,
+			wanted: "",
+		},
+		"modify, add and remove keyed values": {
+			old: `
+Resources:
+  Service:
+    Properties:
+      DesiredCount: 1
+      LaunchType: FARGATE
+  Role/Policy~1: {}`,
+			curr: `
+Resources:
+  Service:
+    Properties:
+      DesiredCount: 2
+      Tags:
+        - Key: team
+          Value: payments
+  Role/Policy~1: {}`,
+			wanted: `[
+  {"path": "/Resources/Service/Properties/DesiredCount", "kind": "modified", "old": 1, "new": 2},
+  {"path": "/Resources/Service/Properties/LaunchType", "kind": "removed", "old": "FARGATE"},
+  {"path": "/Resources/Service/Properties/Tags", "kind": "added", "new": [{"Key": "team", "Value": "payments"}]}
+]`,
+		},
+		"sequence items are identified by index": {
+			old: `
+Ports:
+  - 80
+  - 443
+  - 8080
+  - 9090`,
+			curr: `
+Ports:
+  - 80
+  - 8080
+  - 8443
+  - 9090
+  - 10000`,
+			wanted: `[
+  {"path": "/Ports/1", "kind": "removed", "old": 443},
+  {"path": "/Ports/2", "kind": "added", "new": 8443},
+  {"path": "/Ports/4", "kind": "added", "new": 10000}
+]`,
+		},
+		"modified items of a sequence": {
+			old: `
+Statement:
+  - Effect: Allow
+    Action: s3:GetObject
+  - Effect: Allow
+    Action: sqs:SendMessage`,
+			curr: `
+Statement:
+  - Effect: Allow
+    Action: s3:GetObject
+  - Effect: Deny
+    Action: sqs:SendMessage`,
+			wanted: `[
+  {"path": "/Statement/1/Effect", "kind": "modified", "old": "Allow", "new": "Deny"}
+]`,
+		},
+		"escape keys": {
+			old: `
+Outputs:
+  a/b~c: 1`,
+			curr: `
+Outputs:
+  a/b~c: 2`,
+			wanted: `[
+  {"path": "/Outputs/a~1b~0c", "kind": "modified", "old": 1, "new": 2}
+]`,
+		},
+		"intrinsic functions in short form are written in full form": {
+			old: `
+Value: !Ref Service`,
+			curr: `
+Value: !GetAtt Service.Name`,
+			wanted: `[
+  {"path": "/Value", "kind": "modified", "old": {"Ref": "Service"}, "new": {"Fn::GetAtt": "Service.Name"}}
+]`,
+		},
+		"compare intrinsic functions in different forms": {
+			old: `
+Value:
+  Fn::Sub: ${AWS::Region}`,
+			curr: `
+Value: !Sub ${AWS::AccountId}`,
+			wanted: `[
+  {"path": "/Value/Fn::Sub", "kind": "modified", "old": "${AWS::Region}", "new": "${AWS::AccountId}"}
+]`,
+		},
+		"a new document": {
+			curr: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue`,
+			wanted: `[
+  {"path": "", "kind": "added", "new": {"Resources": {"Queue": {"Type": "AWS::SQS::Queue"}}}}
+]`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tree, err := From(tc.old).ParseWithCFNOverriders([]byte(tc.curr))
+			require.NoError(t, err)
+			buf := strings.Builder{}
+
+			// WHEN
+			err = tree.Write(&buf, WithJSON())
+
+			// THEN
+			require.NoError(t, err)
+			if tc.wanted == "" {
+				require.Empty(t, buf.String())
+				return
+			}
+			require.JSONEq(t, tc.wanted, buf.String())
+		})
+	}
+}
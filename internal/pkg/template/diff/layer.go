@@ -0,0 +1,104 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Layer holds the differences made to a document by one of the steps that produced it, such as an overrider.
+type Layer struct {
+	Name string // Name of the layer, for example the path to an overrides directory.
+	Tree Tree   // Differences from the input to the output of the layer.
+}
+
+// WithLayers attributes each change of the tree to the last of the layers that changed the same path, or a path above or under it.
+// The human-readable diff is followed by the changes introduced by each layer, while the changes in JSON have a "layer" field.
+func WithLayers(layers ...Layer) WriteOption {
+	return func(opts *writeOpts) {
+		opts.layers = layers
+	}
+}
+
+// attributeLayers sets the layer of each change that was introduced by one of the layers.
+func attributeLayers(changes []Change, layers []Layer) error {
+	layerPaths := make([][]string, len(layers))
+	for i, layer := range layers {
+		layerChanges, err := layer.Tree.Changes()
+		if err != nil {
+			return fmt.Errorf("list changes of layer %s: %w", layer.Name, err)
+		}
+		for _, change := range layerChanges {
+			layerPaths[i] = append(layerPaths[i], change.Path)
+		}
+	}
+	for i := range changes {
+		for j := len(layers) - 1; j >= 0; j-- {
+			if hasRelatedPath(changes[i].Path, layerPaths[j]) {
+				changes[i].Layer = layers[j].Name
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// hasRelatedPath returns true if one of the paths is equal to, above or under the path.
+func hasRelatedPath(path string, paths []string) bool {
+	for _, p := range paths {
+		if p == path || strings.HasPrefix(p, path+"/") || strings.HasPrefix(path, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// writeLayers writes the changes of the tree grouped by the layer that introduced them.
+// Changes that weren't introduced by any of the layers are not written.
+func (t Tree) writeLayers(w io.Writer, layers []Layer) error {
+	changes, err := t.Changes()
+	if err != nil {
+		return err
+	}
+	if err := attributeLayers(changes, layers); err != nil {
+		return err
+	}
+	byLayer := make(map[string][]Change)
+	for _, change := range changes {
+		if change.Layer != "" {
+			byLayer[change.Layer] = append(byLayer[change.Layer], change)
+		}
+	}
+	if len(byLayer) == 0 {
+		return nil
+	}
+	var b strings.Builder
+	b.WriteString("\nChanges introduced by overrides:\n")
+	for _, layer := range layers {
+		if len(byLayer[layer.Name]) == 0 {
+			continue
+		}
+		b.WriteString(layer.Name + ":\n")
+		for _, change := range byLayer[layer.Name] {
+			b.WriteString("    " + formatLayerChange(change) + "\n")
+		}
+	}
+	_, err = w.Write([]byte(b.String()))
+	return err
+}
+
+func formatLayerChange(change Change) string {
+	switch change.Kind {
+	case ChangeKindAdded:
+		return color.Green.Sprintf("%s %s", prefixAdd, change.Path)
+	case ChangeKindRemoved:
+		return color.Red.Sprintf("%s %s", prefixDel, change.Path)
+	default:
+		return color.Yellow.Sprintf("%s %s", prefixMod, change.Path)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree_Write_WithLayers(t *testing.T) {
+	const (
+		deployed = `
+Resources:
+  TaskDefinition:
+    Properties:
+      Cpu: 256
+      Memory: 512
+  Service:
+    Properties:
+      DesiredCount: 1`
+		generated = `
+Resources:
+  TaskDefinition:
+    Properties:
+      Cpu: 256
+      Memory: 1024
+  Service:
+    Properties:
+      DesiredCount: 2`
+		afterShared = `
+Resources:
+  TaskDefinition:
+    Properties:
+      Cpu: 512
+      Memory: 1024
+  Service:
+    Properties:
+      DesiredCount: 2
+      PropagateTags: SERVICE`
+		afterWorkload = `
+Resources:
+  TaskDefinition:
+    Properties:
+      Cpu: 1024
+      Memory: 1024
+  Service:
+    Properties:
+      DesiredCount: 2
+      PropagateTags: SERVICE
+  Queue:
+    Type: AWS::SQS::Queue`
+	)
+	parse := func(from, to string) Tree {
+		tree, err := From(from).ParseWithCFNOverriders([]byte(to))
+		require.NoError(t, err)
+		return tree
+	}
+	tree := parse(deployed, afterWorkload)
+	layers := []Layer{
+		{Name: "shared/overrides", Tree: parse(generated, afterShared)},
+		{Name: "copilot/api/overrides", Tree: parse(afterShared, afterWorkload)},
+	}
+
+	t.Run("human-readable diff lists the changes of each layer", func(t *testing.T) {
+		// WHEN
+		buf := strings.Builder{}
+		err := tree.Write(&buf, WithLayers(layers...))
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, `~ Resources:
+    + Queue:
+    +     Type: AWS::SQS::Queue
+    ~ Service/Properties:
+        ~ DesiredCount: 1 -> 2
+        + PropagateTags: SERVICE
+    ~ TaskDefinition/Properties:
+        ~ Cpu: 256 -> 1024
+        ~ Memory: 512 -> 1024
+
+Changes introduced by overrides:
+shared/overrides:
+    + /Resources/Service/Properties/PropagateTags
+copilot/api/overrides:
+    + /Resources/Queue
+    ~ /Resources/TaskDefinition/Properties/Cpu
+`, buf.String())
+	})
+	t.Run("JSON changes have the layer that introduced them", func(t *testing.T) {
+		// WHEN
+		buf := strings.Builder{}
+		err := tree.Write(&buf, WithJSON(), WithLayers(layers...))
+
+		// THEN
+		require.NoError(t, err)
+		require.JSONEq(t, `[
+  {"path": "/Resources/Queue", "kind": "added", "new": {"Type": "AWS::SQS::Queue"}, "layer": "copilot/api/overrides"},
+  {"path": "/Resources/Service/Properties/DesiredCount", "kind": "modified", "old": 1, "new": 2},
+  {"path": "/Resources/Service/Properties/PropagateTags", "kind": "added", "new": "SERVICE", "layer": "shared/overrides"},
+  {"path": "/Resources/TaskDefinition/Properties/Cpu", "kind": "modified", "old": 256, "new": 1024, "layer": "copilot/api/overrides"},
+  {"path": "/Resources/TaskDefinition/Properties/Memory", "kind": "modified", "old": 512, "new": 1024}
+]`, buf.String())
+	})
+	t.Run("changes that aren't introduced by a layer are not attributed", func(t *testing.T) {
+		// WHEN
+		buf := strings.Builder{}
+		err := parse(deployed, generated).Write(&buf, WithLayers(layers...))
+
+		// THEN
+		require.NoError(t, err)
+		require.NotContains(t, buf.String(), "Changes introduced by overrides")
+	})
+}
@@ -553,7 +553,7 @@ MyOrCondition:
 			require.NoError(t, err)
 			got.Write(os.Stdout)
 			if tc.wanted != nil {
-				require.True(t, equalTree(got, Tree{tc.wanted()}, t), "should get the expected tree")
+				require.True(t, equalTree(got, Tree{root: tc.wanted()}, t), "should get the expected tree")
 			} else {
 				require.True(t, equalTree(got, Tree{}, t), "should get the expected tree")
 			}
@@ -0,0 +1,286 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"gopkg.in/yaml.v3"
+)
+
+// ResourceAction is the action that a deployment takes on a CloudFormation resource.
+type ResourceAction string
+
+// Actions on CloudFormation resources.
+const (
+	ResourceActionAdd     ResourceAction = "add"
+	ResourceActionUpdate  ResourceAction = "update"
+	ResourceActionReplace ResourceAction = "replace"
+	ResourceActionRemove  ResourceAction = "remove"
+)
+
+// Risk is the risk of a change to a CloudFormation resource.
+type Risk int
+
+// Risks of changes to CloudFormation resources, from the least to the most risky.
+const (
+	// RiskLow is the risk of adding a resource or updating it in place.
+	RiskLow Risk = iota
+	// RiskMedium is the risk of replacing or removing a resource that doesn't hold data.
+	RiskMedium
+	// RiskHigh is the risk of replacing or removing a resource whose data would be lost.
+	RiskHigh
+)
+
+// String returns the name of the risk.
+func (r Risk) String() string {
+	switch r {
+	case RiskMedium:
+		return "medium"
+	case RiskHigh:
+		return "high"
+	default:
+		return "low"
+	}
+}
+
+const (
+	cfnResourcesKey  = "Resources"
+	cfnTypeKey       = "Type"
+	cfnPropertiesKey = "Properties"
+)
+
+// replacementProperties are the properties of resource types whose updates are known to force a replacement.
+// See "Update requires: Replacement" in https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-template-resource-type-ref.html.
+var replacementProperties = map[string][]string{
+	"AWS::DynamoDB::Table":                      {"KeySchema", "LocalSecondaryIndexes", "TableName"},
+	"AWS::EC2::SecurityGroup":                   {"GroupDescription", "GroupName", "VpcId"},
+	"AWS::ECR::Repository":                      {"EncryptionConfiguration", "RepositoryName"},
+	"AWS::ECS::Cluster":                         {"ClusterName"},
+	"AWS::ECS::Service":                         {"Cluster", "LaunchType", "Role", "SchedulingStrategy", "ServiceName"},
+	"AWS::EFS::AccessPoint":                     {"FileSystemId", "PosixUser", "RootDirectory"},
+	"AWS::EFS::FileSystem":                      {"AvailabilityZoneName", "Encrypted", "KmsKeyId", "PerformanceMode"},
+	"AWS::ElasticLoadBalancingV2::LoadBalancer": {"Name", "Scheme"},
+	"AWS::ElasticLoadBalancingV2::TargetGroup":  {"Name", "Port", "Protocol", "ProtocolVersion", "TargetType", "VpcId"},
+	"AWS::IAM::Role":                            {"Path", "RoleName"},
+	"AWS::Logs::LogGroup":                       {"LogGroupName"},
+	"AWS::RDS::DBCluster":                       {"DBClusterIdentifier", "DatabaseName", "EngineMode", "KmsKeyId", "MasterUsername", "StorageEncrypted"},
+	"AWS::RDS::DBInstance":                      {"DBInstanceIdentifier", "DBName", "KmsKeyId", "StorageEncrypted"},
+	"AWS::S3::Bucket":                           {"BucketName"},
+	"AWS::SNS::Topic":                           {"FifoTopic", "TopicName"},
+	"AWS::SQS::Queue":                           {"FifoQueue", "QueueName"},
+}
+
+// statefulResourceTypes are the resource types whose data is lost when they are deleted.
+var statefulResourceTypes = map[string]struct{}{
+	"AWS::DynamoDB::Table":               exists,
+	"AWS::ECR::Repository":               exists,
+	"AWS::EFS::FileSystem":               exists,
+	"AWS::ElastiCache::CacheCluster":     exists,
+	"AWS::ElastiCache::ReplicationGroup": exists,
+	"AWS::Logs::LogGroup":                exists,
+	"AWS::OpenSearchService::Domain":     exists,
+	"AWS::RDS::DBCluster":                exists,
+	"AWS::RDS::DBInstance":               exists,
+	"AWS::S3::Bucket":                    exists,
+	"AWS::SQS::Queue":                    exists,
+}
+
+// retainPolicies are the deletion and update replace policies that keep the data of a resource.
+var retainPolicies = map[string]struct{}{
+	"Retain":               exists,
+	"RetainExceptOnCreate": exists,
+	"Snapshot":             exists,
+}
+
+// ResourceChange is the change to a CloudFormation resource.
+type ResourceChange struct {
+	LogicalID string
+	Type      string
+	Action    ResourceAction
+	// ReplacedBy holds the changed properties that force the replacement of the resource.
+	ReplacedBy []string
+	Risk       Risk
+}
+
+// Summary returns the changes to the CloudFormation resources in the tree sorted by logical ID.
+// Resources whose differences are all ignored, such as "Metadata.Manifest" or by ignore rules, are not included.
+func (t Tree) Summary() ([]ResourceChange, error) {
+	if t.root == nil {
+		return nil, nil
+	}
+	oldResources, err := cfnResources(t.old)
+	if err != nil {
+		return nil, fmt.Errorf("read old resources: %w", err)
+	}
+	newResources, err := cfnResources(t.new)
+	if err != nil {
+		return nil, fmt.Errorf("read new resources: %w", err)
+	}
+	ids := unionOfKeys(oldResources, newResources)
+	sort.Strings(ids)
+	var changes []ResourceChange
+	for _, id := range ids {
+		node, changed := t.resourceDiff(id)
+		if !changed {
+			continue
+		}
+		oldRes, newRes := oldResources[id], newResources[id]
+		change := ResourceChange{
+			LogicalID: id,
+		}
+		switch {
+		case oldRes == nil:
+			change.Type, change.Action = newRes.Type, ResourceActionAdd
+		case newRes == nil:
+			change.Type, change.Action = oldRes.Type, ResourceActionRemove
+			change.Risk = removalRisk(oldRes.Type, oldRes.DeletionPolicy.Value)
+		default:
+			change.Type, change.Action = newRes.Type, ResourceActionUpdate
+			change.ReplacedBy = replacedBy(oldRes, newRes, node)
+			if len(change.ReplacedBy) > 0 {
+				change.Action = ResourceActionReplace
+				change.Risk = removalRisk(oldRes.Type, oldRes.UpdateReplacePolicy.Value)
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// resourceDiff returns the diff node of the resource with the logical ID if there is one,
+// and false if the resource has no differences left in the tree.
+func (t Tree) resourceDiff(id string) (diffNode, bool) {
+	if len(t.root.children()) == 0 {
+		return nil, true // The whole document is added or removed.
+	}
+	resources := childNode(t.root, cfnResourcesKey)
+	if resources == nil {
+		return nil, false
+	}
+	if len(resources.children()) == 0 {
+		return nil, true // All resources are added or removed.
+	}
+	node := childNode(resources, id)
+	return node, node != nil
+}
+
+// cfnResource holds the fields of a CloudFormation resource needed to summarize its changes.
+type cfnResource struct {
+	Type                string               `yaml:"Type"`
+	DeletionPolicy      yaml.Node            `yaml:"DeletionPolicy"` // A node since the policy can be a reference to a parameter.
+	UpdateReplacePolicy yaml.Node            `yaml:"UpdateReplacePolicy"`
+	Properties          map[string]yaml.Node `yaml:"Properties"`
+}
+
+// cfnResources returns the resources of a CloudFormation template by logical ID.
+func cfnResources(doc *yaml.Node) (map[string]*cfnResource, error) {
+	if doc == nil || doc.Kind == 0 {
+		return nil, nil
+	}
+	var tmpl struct {
+		Resources map[string]*cfnResource `yaml:"Resources"`
+	}
+	if err := doc.Decode(&tmpl); err != nil {
+		return nil, err
+	}
+	return tmpl.Resources, nil
+}
+
+// replacedBy returns the changes of a resource that force its replacement.
+// The diff node of the resource is nil if the resource isn't in the tree on its own.
+func replacedBy(oldRes, newRes *cfnResource, node diffNode) []string {
+	if oldRes.Type != newRes.Type {
+		return []string{cfnTypeKey}
+	}
+	changed := make(map[string]bool)
+	if props := childNode(node, cfnPropertiesKey); props != nil && len(props.children()) > 0 {
+		for _, child := range props.children() {
+			changed[child.key()] = true
+		}
+	} else if props != nil || node == nil || len(node.children()) == 0 {
+		// The properties are entirely added or removed, so compare them one by one.
+		for _, key := range unionOfKeys(oldRes.Properties, newRes.Properties) {
+			oldV, newV := oldRes.Properties[key], newRes.Properties[key]
+			if diff, err := parse(&oldV, &newV, key, &getAttConverter{}, &intrinsicFuncMapTagConverter{}); err != nil || diff != nil {
+				changed[key] = true
+			}
+		}
+	}
+	var props []string
+	for _, prop := range replacementProperties[newRes.Type] {
+		if changed[prop] {
+			props = append(props, prop)
+		}
+	}
+	return props
+}
+
+// removalRisk returns the risk of deleting a resource of the type with the deletion policy.
+func removalRisk(typ, policy string) Risk {
+	if _, ok := statefulResourceTypes[typ]; !ok {
+		return RiskMedium
+	}
+	if _, ok := retainPolicies[policy]; ok {
+		return RiskMedium
+	}
+	return RiskHigh
+}
+
+// childNode returns the child of a diff node with the key, or nil if there is none.
+func childNode(node diffNode, key string) diffNode {
+	if node == nil {
+		return nil
+	}
+	for _, child := range node.children() {
+		if child.key() == key {
+			return child
+		}
+	}
+	return nil
+}
+
+// writeSummary writes the summary of the changes to the CloudFormation resources of the tree,
+// and warns about the stateful resources whose data would be lost.
+func (t Tree) writeSummary(w io.Writer) error {
+	changes, err := t.Summary()
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	var b strings.Builder
+	b.WriteString("\nResource changes:\n")
+	var lost []string
+	for _, change := range changes {
+		b.WriteString(formatResourceChange(change) + "\n")
+		if change.Risk == RiskHigh {
+			lost = append(lost, fmt.Sprintf("%s (%s)", change.LogicalID, change.Type))
+		}
+	}
+	if len(lost) > 0 {
+		b.WriteString(color.Red.Sprintf("\nWarning: the data of %s will be lost because the resources are removed or replaced.\n", strings.Join(lost, ", ")))
+	}
+	_, err = w.Write([]byte(b.String()))
+	return err
+}
+
+func formatResourceChange(change ResourceChange) string {
+	resource := fmt.Sprintf("%s (%s)", change.LogicalID, change.Type)
+	switch change.Action {
+	case ResourceActionAdd:
+		return color.Green.Sprintf("%s %s will be added", prefixAdd, resource)
+	case ResourceActionRemove:
+		return color.Red.Sprintf("%s %s will be removed", prefixDel, resource)
+	case ResourceActionReplace:
+		return color.Red.Sprintf("%s%s %s will be replaced because of changes to %s", prefixDel, prefixAdd, resource, strings.Join(change.ReplacedBy, ", "))
+	default:
+		return color.Yellow.Sprintf("%s %s will be updated in place", prefixMod, resource)
+	}
+}
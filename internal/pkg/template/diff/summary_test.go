@@ -0,0 +1,210 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree_Summary(t *testing.T) {
+	testCases := map[string]struct {
+		old  string
+		curr string
+
+		wanted []ResourceChange
+	}{
+		"no changes": {
+			old: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue`,
+			curr: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue`,
+		},
+		"ignored changes are not summarized": {
+			old: `
+Metadata:
+  Manifest: old
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+Outputs:
+  QueueURL: old`,
+			curr: `
+Metadata:
+  Manifest: new
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+Outputs:
+  QueueURL: new`,
+		},
+		"add and remove resources": {
+			old: `
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+  RetainedBucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: Retain
+  Role:
+    Type: AWS::IAM::Role`,
+			curr: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue`,
+			wanted: []ResourceChange{
+				{LogicalID: "Bucket", Type: "AWS::S3::Bucket", Action: ResourceActionRemove, Risk: RiskHigh},
+				{LogicalID: "Queue", Type: "AWS::SQS::Queue", Action: ResourceActionAdd, Risk: RiskLow},
+				{LogicalID: "RetainedBucket", Type: "AWS::S3::Bucket", Action: ResourceActionRemove, Risk: RiskMedium},
+				{LogicalID: "Role", Type: "AWS::IAM::Role", Action: ResourceActionRemove, Risk: RiskMedium},
+			},
+		},
+		"update in place and replace resources": {
+			old: `
+Resources:
+  LoadBalancer:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Properties:
+      Name: old
+      Scheme: internet-facing
+      SecurityGroups: [!Ref SG]
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+  FileSystem:
+    Type: AWS::EFS::FileSystem
+    Properties:
+      Encrypted: false
+  Logs:
+    Type: AWS::Logs::LogGroup
+    UpdateReplacePolicy: Retain
+    Properties:
+      LogGroupName: old`,
+			curr: `
+Resources:
+  LoadBalancer:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Properties:
+      Name: new
+      Scheme: internal
+      SecurityGroups: [!Ref SG]
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 2
+  FileSystem:
+    Type: AWS::EFS::FileSystem
+    Properties:
+      Encrypted: true
+  Logs:
+    Type: AWS::Logs::LogGroup
+    UpdateReplacePolicy: Retain
+    Properties:
+      LogGroupName: new`,
+			wanted: []ResourceChange{
+				{LogicalID: "FileSystem", Type: "AWS::EFS::FileSystem", Action: ResourceActionReplace, ReplacedBy: []string{"Encrypted"}, Risk: RiskHigh},
+				{LogicalID: "LoadBalancer", Type: "AWS::ElasticLoadBalancingV2::LoadBalancer", Action: ResourceActionReplace, ReplacedBy: []string{"Name", "Scheme"}, Risk: RiskMedium},
+				{LogicalID: "Logs", Type: "AWS::Logs::LogGroup", Action: ResourceActionReplace, ReplacedBy: []string{"LogGroupName"}, Risk: RiskMedium},
+				{LogicalID: "Service", Type: "AWS::ECS::Service", Action: ResourceActionUpdate, Risk: RiskLow},
+			},
+		},
+		"replace a resource whose properties are added": {
+			old: `
+Resources:
+  Repo:
+    Type: AWS::ECR::Repository`,
+			curr: `
+Resources:
+  Repo:
+    Type: AWS::ECR::Repository
+    Properties:
+      RepositoryName: app/svc
+      ImageScanningConfiguration:
+        ScanOnPush: true`,
+			wanted: []ResourceChange{
+				{LogicalID: "Repo", Type: "AWS::ECR::Repository", Action: ResourceActionReplace, ReplacedBy: []string{"RepositoryName"}, Risk: RiskHigh},
+			},
+		},
+		"replace a resource whose type changes": {
+			old: `
+Resources:
+  Storage:
+    Type: AWS::DynamoDB::Table`,
+			curr: `
+Resources:
+  Storage:
+    Type: AWS::S3::Bucket`,
+			wanted: []ResourceChange{
+				{LogicalID: "Storage", Type: "AWS::S3::Bucket", Action: ResourceActionReplace, ReplacedBy: []string{"Type"}, Risk: RiskHigh},
+			},
+		},
+		"all resources of a new template are added": {
+			curr: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue`,
+			wanted: []ResourceChange{
+				{LogicalID: "Queue", Type: "AWS::SQS::Queue", Action: ResourceActionAdd, Risk: RiskLow},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tree, err := From(tc.old).ParseWithCFNOverriders([]byte(tc.curr))
+			require.NoError(t, err)
+
+			// WHEN
+			got, err := tree.Summary()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestTree_Write_WithSummary(t *testing.T) {
+	// GIVEN
+	tree, err := From(`
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      ServiceName: old`).ParseWithCFNOverriders([]byte(`
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      ServiceName: new`))
+	require.NoError(t, err)
+	buf := strings.Builder{}
+
+	// WHEN
+	err = tree.Write(&buf, WithSummary())
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `~ Resources:
+    - Bucket:
+    -     Type: AWS::S3::Bucket
+    ~ Service/Properties:
+        ~ ServiceName: old -> new
+
+Resource changes:
+- Bucket (AWS::S3::Bucket) will be removed
+-+ Service (AWS::ECS::Service) will be replaced because of changes to ServiceName
+
+Warning: the data of Bucket (AWS::S3::Bucket) will be lost because the resources are removed or replaced.
+`, buf.String())
+}
@@ -52,6 +52,8 @@ func (s *treeWriter) writeTree(node diffNode, indent int) error {
 		content = process(content, indentByFn(indent))
 		_, err := s.writer.Write([]byte(color.Faint.Sprint(content + "\n")))
 		return err
+	case *ignoredNode:
+		return nil
 	case *seqItemNode:
 		formatter = &seqItemFormatter{indent}
 	default:
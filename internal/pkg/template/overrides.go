@@ -14,6 +14,12 @@ const (
 	cdkVersion              = "2.137.0"
 	cdkConstructsMinVersion = "10.0.0"
 	cdkTemplatesPath        = "overrides/cdk"
+	cdkPythonTemplatesPath  = "overrides/cdk-python"
+	cdkGoTemplatesPath      = "overrides/cdk-go"
+
+	// goTemplateFileExt is the extension of the Go project files that can't be embedded with their own name.
+	// A go.mod file can't be embedded, and .go files would otherwise be compiled with the package.
+	goTemplateFileExt = ".tmpl"
 
 	yamlPatchTemplatesPath = "overrides/yamlpatch"
 )
@@ -107,13 +113,29 @@ func (t CFNType) L1ConstructName() string {
 
 // WalkOverridesCDKDir walks through the overrides/cdk templates and calls fn for each parsed template file.
 func (t *Template) WalkOverridesCDKDir(resources []CFNResource, fn WalkDirFunc, requiresEnv bool) error {
+	return t.walkOverridesCDKDir(cdkTemplatesPath, resources, fn, requiresEnv)
+}
+
+// WalkOverridesCDKPythonDir walks through the overrides/cdk-python templates and calls fn for each parsed template file.
+func (t *Template) WalkOverridesCDKPythonDir(resources []CFNResource, fn WalkDirFunc, requiresEnv bool) error {
+	return t.walkOverridesCDKDir(cdkPythonTemplatesPath, resources, fn, requiresEnv)
+}
+
+// WalkOverridesCDKGoDir walks through the overrides/cdk-go templates and calls fn for each parsed template file.
+func (t *Template) WalkOverridesCDKGoDir(resources []CFNResource, fn WalkDirFunc, requiresEnv bool) error {
+	return t.walkOverridesCDKDir(cdkGoTemplatesPath, resources, func(name string, content *Content) error {
+		return fn(strings.TrimSuffix(name, goTemplateFileExt), content)
+	}, requiresEnv)
+}
+
+func (t *Template) walkOverridesCDKDir(path string, resources []CFNResource, fn WalkDirFunc, requiresEnv bool) error {
 	type metadata struct {
 		Version           string
 		ConstructsVersion string
 		Resources         cfnResources
 		RequiresEnv       bool
 	}
-	return t.walkDir(cdkTemplatesPath, cdkTemplatesPath, metadata{
+	return t.walkDir(path, path, metadata{
 		Version:           cdkVersion,
 		ConstructsVersion: cdkConstructsMinVersion,
 		Resources:         resources,
@@ -131,6 +153,10 @@ func (t *Template) WalkOverridesCDKDir(resources []CFNResource, fn WalkDirFunc,
 				}
 				return strings.ToLower(serviceName[:firstSmall]) + serviceName[firstSmall:]
 			},
+			// transform a CamelCase logical ID into a lower snake_case identifier.
+			"toLowerSnakeCase": func(logicalID string) string {
+				return strings.ToLower(ToSnakeCaseFunc(logicalID))
+			},
 		},
 	))
 }
@@ -239,3 +239,58 @@ transformHTTPListenerRule() {
 		}
 	}
 }
+
+func TestTemplate_WalkOverridesCDKPythonDir(t *testing.T) {
+	// GIVEN
+	tpl := New()
+	walked := make(map[string]string)
+	var names []string
+
+	// WHEN
+	err := tpl.WalkOverridesCDKPythonDir([]CFNResource{
+		{
+			Type:      "AWS::ElasticLoadBalancingV2::ListenerRule",
+			LogicalID: "HTTPListenerRuleWithDomain",
+		},
+	}, func(name string, content *Content) error {
+		walked[name] = content.String()
+		names = append(names, name)
+		return nil
+	}, true)
+
+	// THEN
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{".gitignore", "README.md", "app.py", "cdk.json", "requirements.txt", "stack.py"}, names)
+	require.Equal(t, "aws-cdk-lib==2.137.0\nconstructs>=10.0.0,<11.0.0\n", walked["requirements.txt"])
+	require.Contains(t, walked["stack.py"], "from aws_cdk import aws_elasticloadbalancingv2 as elbv2")
+	require.Contains(t, walked["stack.py"], `    def transform_http_listener_rule_with_domain(self) -> None:
+        http_listener_rule_with_domain: elbv2.CfnListenerRule = self.template.get_resource("HTTPListenerRuleWithDomain")`)
+	require.Contains(t, walked["app.py"], `env_name=os.environ.get("COPILOT_ENVIRONMENT_NAME", "")`)
+}
+
+func TestTemplate_WalkOverridesCDKGoDir(t *testing.T) {
+	// GIVEN
+	tpl := New()
+	walked := make(map[string]string)
+	var names []string
+
+	// WHEN
+	err := tpl.WalkOverridesCDKGoDir([]CFNResource{
+		{
+			Type:      "AWS::ECS::Service",
+			LogicalID: "Service",
+		},
+	}, func(name string, content *Content) error {
+		walked[name] = content.String()
+		names = append(names, name)
+		return nil
+	}, false)
+
+	// THEN
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{".gitignore", "README.md", "cdk.json", "go.mod", "override.go", "stack.go"}, names)
+	require.Contains(t, walked["go.mod"], "github.com/aws/aws-cdk-go/awscdk/v2 v2.137.0")
+	require.Contains(t, walked["stack.go"], `func (stack *TransformedStack) transformService() {
+	service := stack.Template.GetResource(jsii.String("Service"))`)
+	require.NotContains(t, walked["override.go"], "COPILOT_ENVIRONMENT_NAME")
+}
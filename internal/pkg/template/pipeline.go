@@ -49,6 +49,13 @@ func withPipelineParsingFuncs() ParseOption {
 				_, ok := source.(connectionName)
 				return ok
 			},
+			"isCodeStarHostConnection": func(source interface{}) bool {
+				type connectionHost interface {
+					Host() string
+				}
+				_, ok := source.(connectionHost)
+				return ok
+			},
 			"logicalIDSafe": ReplaceDashesFunc,
 			"alphanumeric":  StripNonAlphaNumFunc,
 		})
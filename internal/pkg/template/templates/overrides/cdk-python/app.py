@@ -0,0 +1,17 @@
+#!/usr/bin/env python3
+import os
+
+import aws_cdk as cdk
+
+from stack import TransformedStack
+
+app = cdk.App()
+TransformedStack(
+    app,
+    "Stack",
+    app_name=os.environ.get("COPILOT_APPLICATION_NAME", ""),
+    {{- if .RequiresEnv }}
+    env_name=os.environ.get("COPILOT_ENVIRONMENT_NAME", ""),
+    {{- end }}
+)
+app.synth()
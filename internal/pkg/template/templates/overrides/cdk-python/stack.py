@@ -0,0 +1,30 @@
+import os
+
+import aws_cdk as cdk
+from aws_cdk import cloudformation_include as cfn_inc
+{{- range $import := .Resources.Imports }}
+from aws_cdk import {{$import.ImportName}} as {{$import.ImportShortRename}}
+{{- end }}
+from constructs import Construct
+
+
+class TransformedStack(cdk.Stack):
+    def __init__(self, scope: Construct, id: str, *, app_name: str{{if .RequiresEnv}}, env_name: str{{end}}, **kwargs) -> None:
+        super().__init__(scope, id, **kwargs)
+        self.template = cfn_inc.CfnInclude(self, "Template",
+            template_file=os.path.join(".build", "in.yml"),
+        )
+        self.app_name = app_name
+        {{- if .RequiresEnv }}
+        self.env_name = env_name
+        {{- end }}
+
+        {{- range $resource := .Resources }}
+        self.transform_{{toLowerSnakeCase $resource.LogicalID}}()
+        {{- end }}
+    {{range $resource := .Resources}}
+    # TODO: implement me.
+    def transform_{{toLowerSnakeCase $resource.LogicalID}}(self) -> None:
+        {{toLowerSnakeCase $resource.LogicalID}}: {{$resource.Type.ImportShortRename}}.{{$resource.Type.L1ConstructName}} = self.template.get_resource("{{$resource.LogicalID}}")
+        raise NotImplementedError("not implemented")
+    {{end }}
@@ -613,6 +613,25 @@ func (cfg RollingUpdateRollbackConfig) HasCustomAlarms() bool {
 	return cfg.CPUUtilization != nil || cfg.MemoryUtilization != nil || cfg.MessagesDelayed != nil
 }
 
+// RollbackAlarmNames returns the names of the alarms that roll back the deployments of the service,
+// either the existing alarms or the ones that Copilot creates.
+func (cfg RollingUpdateRollbackConfig) RollbackAlarmNames(app, env, svc string) []string {
+	if len(cfg.AlarmNames) > 0 {
+		return cfg.AlarmNames
+	}
+	var names []string
+	if cfg.CPUUtilization != nil {
+		names = append(names, cfg.TruncateAlarmName(app, env, svc, "CopilotRollbackCPUAlarm"))
+	}
+	if cfg.MemoryUtilization != nil {
+		names = append(names, cfg.TruncateAlarmName(app, env, svc, "CopilotRollbackMemAlarm"))
+	}
+	if cfg.MessagesDelayed != nil {
+		names = append(names, cfg.TruncateAlarmName(app, env, svc, "CopilotRollbackMsgsDelayedAlarm"))
+	}
+	return names
+}
+
 // TruncateAlarmName ensures that alarm names don't exceed the 255 character limit.
 func (cfg RollingUpdateRollbackConfig) TruncateAlarmName(app, env, svc, alarmType string) string {
 	if len(app)+len(env)+len(svc)+len(alarmType) <= 255 {
@@ -457,6 +457,38 @@ func TestEnvControllerParameters(t *testing.T) {
 	}
 }
 
+func TestRollingUpdateRollbackConfig_RollbackAlarmNames(t *testing.T) {
+	testCases := map[string]struct {
+		config   RollingUpdateRollbackConfig
+		expected []string
+	}{
+		"no rollback alarms": {},
+		"existing alarms": {
+			config: RollingUpdateRollbackConfig{
+				AlarmNames: []string{"alarm1", "alarm2"},
+			},
+			expected: []string{"alarm1", "alarm2"},
+		},
+		"alarms created by Copilot": {
+			config: RollingUpdateRollbackConfig{
+				CPUUtilization:    aws.Float64(70),
+				MemoryUtilization: aws.Float64(80),
+				MessagesDelayed:   aws.Int(5),
+			},
+			expected: []string{
+				"phonetool-test-api-CopilotRollbackCPUAlarm",
+				"phonetool-test-api-CopilotRollbackMemAlarm",
+				"phonetool-test-api-CopilotRollbackMsgsDelayedAlarm",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.config.RollbackAlarmNames("phonetool", "test", "api"))
+		})
+	}
+}
+
 func TestRollingUpdateRollbackConfig_TruncateAlarmName(t *testing.T) {
 	testCases := map[string]struct {
 		config      RollingUpdateRollbackConfig
@@ -29,6 +29,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
@@ -49,6 +50,7 @@ const (
 
 	addonsDirName             = "addons"
 	overridesDirName          = "overrides"
+	diffIgnoreFileName        = "diffignore.yml"
 	pipelinesDirName          = "pipelines"
 	environmentsDirName       = "environments"
 	maximumParentDirsToSearch = 5
@@ -89,8 +91,16 @@ func TraverseUp(startDir string, maxLevels int, process TraverseUpProcessFn) (st
 
 // Summary is a description of what's associated with this workspace.
 type Summary struct {
-	Application string `yaml:"application"` // Name of the application.
-	Path        string `yaml:"-"`           // Absolute path to the summary file.
+	Application string           `yaml:"application"`         // Name of the application.
+	Overrides   SummaryOverrides `yaml:"overrides,omitempty"` // Shared overrides directories.
+	Path        string           `yaml:"-"`                   // Absolute path to the summary file.
+}
+
+// SummaryOverrides holds the overrides directories shared by all the workloads or environments of the workspace.
+// Relative paths are relative to the copilot/ directory.
+type SummaryOverrides struct {
+	Workloads    []string `yaml:"workloads,omitempty"`
+	Environments []string `yaml:"environments,omitempty"`
 }
 
 // Workspace typically represents a Git repository where the user has its infrastructure-as-code files as well as source files.
@@ -272,38 +282,55 @@ type PipelineManifest struct {
 
 // ListPipelines returns all pipelines in the workspace.
 func (ws *Workspace) ListPipelines() ([]PipelineManifest, error) {
+	paths, err := ws.ListPipelineManifestPaths()
+	if err != nil {
+		return nil, err
+	}
 	var manifests []PipelineManifest
-
-	addManifest := func(manifestPath string) {
+	for _, manifestPath := range paths {
 		manifest, err := ws.ReadPipelineManifest(manifestPath)
-		switch {
-		case errors.Is(err, ErrNoPipelineInWorkspace):
-			// no file at manifestPath, ignore it
-			return
-		case err != nil:
+		if err != nil {
 			ws.logger("Unable to read pipeline manifest at '%s': %s\n", manifestPath, err)
-			return
+			continue
 		}
-
 		manifests = append(manifests, PipelineManifest{
 			Name: manifest.Name,
 			Path: manifestPath,
 		})
 	}
 
+	// sort manifests alphabetically by Name
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].Name < manifests[j].Name
+	})
+
+	return manifests, nil
+}
+
+// ListPipelineManifestPaths returns the absolute paths to the pipeline manifest files in the workspace,
+// including the ones that can't be unmarshaled.
+func (ws *Workspace) ListPipelineManifestPaths() ([]string, error) {
+	var paths []string
+
 	// add the legacy pipeline
-	addManifest(ws.pipelineManifestLegacyPath())
+	legacyPath := ws.pipelineManifestLegacyPath()
+	exists, err := ws.fs.Exists(legacyPath)
+	if err != nil {
+		return nil, fmt.Errorf("check if pipeline manifest exists at %q: %w", legacyPath, err)
+	}
+	if exists {
+		paths = append(paths, legacyPath)
+	}
 
 	// add each file that matches pipelinesDir/*/manifest.yml
 	pipelinesDir := ws.pipelinesDirPath()
 
-	exists, err := ws.fs.Exists(pipelinesDir)
+	exists, err = ws.fs.Exists(pipelinesDir)
 	switch {
 	case err != nil:
 		return nil, fmt.Errorf("check if pipelines directory exists at %q: %w", pipelinesDir, err)
 	case !exists:
-		// there is at most 1 manifest (the legacy one), so we don't need to sort
-		return manifests, nil
+		return paths, nil
 	}
 
 	files, err := ws.fs.ReadDir(pipelinesDir)
@@ -312,17 +339,19 @@ func (ws *Workspace) ListPipelines() ([]PipelineManifest, error) {
 	}
 
 	for _, dir := range files {
-		if dir.IsDir() {
-			addManifest(filepath.Join(pipelinesDir, dir.Name(), manifestFileName))
+		if !dir.IsDir() {
+			continue
+		}
+		manifestPath := filepath.Join(pipelinesDir, dir.Name(), manifestFileName)
+		exists, err := ws.fs.Exists(manifestPath)
+		if err != nil {
+			return nil, fmt.Errorf("check if pipeline manifest exists at %q: %w", manifestPath, err)
+		}
+		if exists {
+			paths = append(paths, manifestPath)
 		}
 	}
-
-	// sort manifests alphabetically by Name
-	sort.Slice(manifests, func(i, j int) bool {
-		return manifests[i].Name < manifests[j].Name
-	})
-
-	return manifests, nil
+	return paths, nil
 }
 
 // listWorkloads returns the name of all workloads (either services or jobs) in the workspace.
@@ -508,6 +537,16 @@ func (ws *Workspace) WorkloadAddonFilePath(wkldName, fName string) string {
 	return filepath.Join(wkldName, addonsDirName, fName)
 }
 
+// WorkloadManifestFileAbsPath returns the absolute path of the manifest file for a given workload.
+func (ws *Workspace) WorkloadManifestFileAbsPath(name string) string {
+	return filepath.Join(ws.CopilotDirAbs, name, manifestFileName)
+}
+
+// EnvManifestFileAbsPath returns the absolute path of the manifest file for a given environment.
+func (ws *Workspace) EnvManifestFileAbsPath(name string) string {
+	return filepath.Join(ws.CopilotDirAbs, environmentsDirName, name, manifestFileName)
+}
+
 // EnvAddonFilePath returns the path under the workspace of an addon file for environments.
 func (ws *Workspace) EnvAddonFilePath(fName string) string {
 	return filepath.Join(environmentsDirName, addonsDirName, fName)
@@ -528,6 +567,61 @@ func (ws *Workspace) PipelineOverridesPath(name string) string {
 	return filepath.Join(ws.CopilotDirAbs, pipelinesDirName, name, overridesDirName)
 }
 
+// SharedWorkloadOverridesPaths returns the paths to the overrides directories listed in the workspace summary
+// that apply to all workloads, in the order that they should be applied.
+// If the workspace isn't associated with an application, then there are no shared overrides directories.
+func (ws *Workspace) SharedWorkloadOverridesPaths() ([]string, error) {
+	summary, err := ws.Summary()
+	if err != nil {
+		var errNoAppAssociated *ErrNoAssociatedApplication
+		if errors.As(err, &errNoAppAssociated) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ws.absPaths(summary.Overrides.Workloads), nil
+}
+
+// SharedEnvOverridesPaths returns the paths to the overrides directories listed in the workspace summary
+// that apply to all environments, in the order that they should be applied.
+func (ws *Workspace) SharedEnvOverridesPaths() ([]string, error) {
+	summary, err := ws.Summary()
+	if err != nil {
+		var errNoAppAssociated *ErrNoAssociatedApplication
+		if errors.As(err, &errNoAppAssociated) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ws.absPaths(summary.Overrides.Environments), nil
+}
+
+func (ws *Workspace) absPaths(paths []string) []string {
+	var abs []string
+	for _, path := range paths {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(ws.CopilotDirAbs, path)
+		}
+		abs = append(abs, path)
+	}
+	return abs
+}
+
+// DiffIgnorePath returns the path to the diff ignore file that applies to all workloads and environments.
+func (ws *Workspace) DiffIgnorePath() string {
+	return filepath.Join(ws.CopilotDirAbs, diffIgnoreFileName)
+}
+
+// WorkloadDiffIgnorePath returns the path to the diff ignore file for a given workload.
+func (ws *Workspace) WorkloadDiffIgnorePath(name string) string {
+	return filepath.Join(ws.CopilotDirAbs, name, diffIgnoreFileName)
+}
+
+// EnvDiffIgnorePath returns the path to the diff ignore file for a given environment.
+func (ws *Workspace) EnvDiffIgnorePath(name string) string {
+	return filepath.Join(ws.CopilotDirAbs, environmentsDirName, name, diffIgnoreFileName)
+}
+
 // ListFiles returns a list of file paths to all the files under the dir.
 func (ws *Workspace) ListFiles(dirPath string) ([]string, error) {
 	var names []string
@@ -628,6 +722,37 @@ func (ws *Workspace) Rel(path string) (string, error) {
 	return filepath.Rel(filepath.Dir(ws.CopilotDirAbs), fullPath)
 }
 
+// ManifestKind is the kind of a manifest file in the workspace.
+type ManifestKind int
+
+// Kinds of manifest files in the workspace.
+const (
+	ManifestKindUnknown ManifestKind = iota
+	ManifestKindWorkload
+	ManifestKindEnvironment
+	ManifestKindPipeline
+)
+
+// ManifestKindOf returns the kind of the manifest file at path, relative to the workspace like the paths returned by Rel,
+// and the name of its workload or environment. It returns ManifestKindUnknown if path isn't a manifest file of the workspace.
+func ManifestKindOf(path string) (kind ManifestKind, name string) {
+	parts := strings.Split(filepath.ToSlash(path), "/")
+	if parts[0] != CopilotDirName {
+		return ManifestKindUnknown, ""
+	}
+	switch {
+	case len(parts) == 4 && parts[1] == environmentsDirName && parts[3] == manifestFileName:
+		return ManifestKindEnvironment, parts[2]
+	case len(parts) == 4 && parts[1] == pipelinesDirName && parts[3] == manifestFileName:
+		return ManifestKindPipeline, parts[2]
+	case len(parts) == 2 && parts[1] == legacyPipelineFileName:
+		return ManifestKindPipeline, ""
+	case len(parts) == 3 && parts[2] == manifestFileName && parts[1] != environmentsDirName && parts[1] != pipelinesDirName:
+		return ManifestKindWorkload, parts[1]
+	}
+	return ManifestKindUnknown, ""
+}
+
 // copilotDirPath tries to find the current app's copilot directory from the workspace working directory.
 func (ws *Workspace) copilotDirPath() (string, error) {
 	// Are we in the application's copilot directory already?
@@ -927,6 +927,57 @@ version: 1
 	}
 }
 
+func TestWorkspace_ListPipelineManifestPaths(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedPaths []string
+	}{
+		"no pipelines": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.Mkdir("/copilot", 0755)
+				return fs
+			},
+		},
+		"legacy pipeline and pipelines with a manifest": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.Mkdir("/copilot/pipelines/beta", 0755)
+				fs.Mkdir("/copilot/pipelines/prod", 0755)
+				fs.Mkdir("/copilot/pipelines/empty", 0755)
+				afero.WriteFile(fs, "/copilot/pipeline.yml", []byte("name: legacy"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/beta/manifest.yml", []byte("version: invalid"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/prod/manifest.yml", []byte("name: prod"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/empty/buildspec.yml", []byte(""), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/manifest.yml", []byte(""), 0644)
+				return fs
+			},
+			wantedPaths: []string{
+				filepath.FromSlash("/copilot/pipeline.yml"),
+				filepath.FromSlash("/copilot/pipelines/beta/manifest.yml"),
+				filepath.FromSlash("/copilot/pipelines/prod/manifest.yml"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ws := &Workspace{
+				CopilotDirAbs: "/copilot",
+				fs: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			paths, err := ws.ListPipelineManifestPaths()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPaths, paths)
+		})
+	}
+}
+
 func TestIsInGitRepository(t *testing.T) {
 	testCases := map[string]struct {
 		given  func() FileStat
@@ -996,6 +1047,16 @@ func TestWorkspace_WorkloadAddonFilePath(t *testing.T) {
 	require.Equal(t, filepath.FromSlash("webhook/addons/db.yml"), ws.WorkloadAddonFilePath("webhook", "db.yml"))
 }
 
+func TestWorkspace_WorkloadManifestFileAbsPath(t *testing.T) {
+	ws := &Workspace{CopilotDirAbs: "/copilot"}
+	require.Equal(t, filepath.FromSlash("/copilot/webhook/manifest.yml"), ws.WorkloadManifestFileAbsPath("webhook"))
+}
+
+func TestWorkspace_EnvManifestFileAbsPath(t *testing.T) {
+	ws := &Workspace{CopilotDirAbs: "/copilot"}
+	require.Equal(t, filepath.FromSlash("/copilot/environments/test/manifest.yml"), ws.EnvManifestFileAbsPath("test"))
+}
+
 func TestWorkspace_EnvAddonFilePath(t *testing.T) {
 	ws := &Workspace{}
 	require.Equal(t, filepath.FromSlash("environments/addons/db.yml"), ws.EnvAddonFilePath("db.yml"))
@@ -1031,6 +1092,55 @@ func TestWorkspace_WorkloadOverridesPath(t *testing.T) {
 	require.Equal(t, filepath.Join("copilot", "frontend", "overrides"), ws.WorkloadOverridesPath("frontend"))
 }
 
+func TestWorkspace_DiffIgnorePaths(t *testing.T) {
+	// GIVEN
+	defer func() { getWd = os.Getwd }()
+
+	getWd = func() (dir string, err error) {
+		return ".", nil
+	}
+	fs := afero.NewMemMapFs()
+	ws, err := Create("demo", fs)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("copilot", "diffignore.yml"), ws.DiffIgnorePath())
+	require.Equal(t, filepath.Join("copilot", "frontend", "diffignore.yml"), ws.WorkloadDiffIgnorePath("frontend"))
+	require.Equal(t, filepath.Join("copilot", "environments", "test", "diffignore.yml"), ws.EnvDiffIgnorePath("test"))
+}
+
+func TestWorkspace_SharedOverridesPaths(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	_ = fs.MkdirAll("test/copilot", 0755)
+	_ = afero.WriteFile(fs, "test/copilot/.workspace", []byte(`application: demo
+overrides:
+  workloads:
+    - shared/overrides
+    - /platform/overrides
+  environments:
+    - ../platform/env-overrides
+`), 0644)
+	ws := Workspace{
+		CopilotDirAbs: filepath.Join("test", CopilotDirName),
+		workingDirAbs: "test",
+		fs:            &afero.Afero{Fs: fs},
+	}
+
+	// WHEN
+	wlPaths, wlErr := ws.SharedWorkloadOverridesPaths()
+	envPaths, envErr := ws.SharedEnvOverridesPaths()
+
+	// THEN
+	require.NoError(t, wlErr)
+	require.Equal(t, []string{
+		filepath.Join("test", "copilot", "shared", "overrides"),
+		"/platform/overrides",
+	}, wlPaths)
+	require.NoError(t, envErr)
+	require.Equal(t, []string{filepath.Join("test", "platform", "env-overrides")}, envPaths)
+}
+
 func TestWorkspace_EnvAddonFileAbsPath(t *testing.T) {
 	mockWorkingDirAbs := "/app"
 	testCases := map[string]struct {
@@ -1688,3 +1798,50 @@ func TestWorkspace_write(t *testing.T) {
 		})
 	}
 }
+
+func TestManifestKindOf(t *testing.T) {
+	testCases := map[string]struct {
+		inPath string
+
+		wantedKind ManifestKind
+		wantedName string
+	}{
+		"workload manifest": {
+			inPath:     "copilot/api/manifest.yml",
+			wantedKind: ManifestKindWorkload,
+			wantedName: "api",
+		},
+		"environment manifest": {
+			inPath:     "copilot/environments/test/manifest.yml",
+			wantedKind: ManifestKindEnvironment,
+			wantedName: "test",
+		},
+		"pipeline manifest": {
+			inPath:     "copilot/pipelines/release/manifest.yml",
+			wantedKind: ManifestKindPipeline,
+			wantedName: "release",
+		},
+		"legacy pipeline manifest": {
+			inPath:     "copilot/pipeline.yml",
+			wantedKind: ManifestKindPipeline,
+		},
+		"file of a workload that isn't its manifest": {
+			inPath:     "copilot/api/addons/table.yml",
+			wantedKind: ManifestKindUnknown,
+		},
+		"manifest outside of the copilot directory": {
+			inPath:     "api/manifest.yml",
+			wantedKind: ManifestKindUnknown,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			kind, name := ManifestKindOf(filepath.FromSlash(tc.inPath))
+
+			// THEN
+			require.Equal(t, tc.wantedKind, kind)
+			require.Equal(t, tc.wantedName, name)
+		})
+	}
+}